@@ -0,0 +1,29 @@
+package checkpoint
+
+// Key identifies the run a checkpoint belongs to. FromSHA and ToSHA should be
+// resolved commits rather than symbolic names, as branches move between runs.
+type Key struct {
+	ProjectID int64
+	BlobType  string
+	FromSHA   string
+	ToSHA     string
+}
+
+// Checkpoint records how far a run got. Only file changes and commits whose
+// documents were all confirmed by the Submitter are counted, in the order the
+// repository streams them. The last path and commit let a resumed run check
+// that it is skipping the same work.
+type Checkpoint struct {
+	Changes    int    `json:"changes"`
+	LastPath   string `json:"last_path,omitempty"`
+	Commits    int    `json:"commits"`
+	LastCommit string `json:"last_commit,omitempty"`
+}
+
+// Store persists checkpoints between runs
+type Store interface {
+	// Load returns nil if there is no checkpoint for the key
+	Load(key Key) (*Checkpoint, error)
+	Save(key Key, checkpoint *Checkpoint) error
+	Delete(key Key) error
+}
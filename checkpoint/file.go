@@ -0,0 +1,80 @@
+package checkpoint
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// FileStore keeps each checkpoint in a JSON file of its own in Dir
+type FileStore struct {
+	Dir string
+}
+
+func NewFileStore(dir string) (*FileStore, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, fmt.Errorf("Couldn't create checkpoint directory: %s", err)
+	}
+
+	return &FileStore{Dir: dir}, nil
+}
+
+func (s *FileStore) path(key Key) string {
+	name := fmt.Sprintf("%d-%s-%s-%s.json", key.ProjectID, key.BlobType, key.FromSHA, key.ToSHA)
+
+	return filepath.Join(s.Dir, name)
+}
+
+func (s *FileStore) Load(key Key) (*Checkpoint, error) {
+	data, err := ioutil.ReadFile(s.path(key))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var checkpoint Checkpoint
+	if err := json.Unmarshal(data, &checkpoint); err != nil {
+		return nil, fmt.Errorf("Couldn't parse checkpoint %s: %s", s.path(key), err)
+	}
+
+	return &checkpoint, nil
+}
+
+// Save replaces the checkpoint atomically, so a run dying halfway through
+// never leaves a truncated file behind
+func (s *FileStore) Save(key Key, checkpoint *Checkpoint) error {
+	data, err := json.Marshal(checkpoint)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := ioutil.TempFile(s.Dir, ".checkpoint-")
+	if err != nil {
+		return err
+	}
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+
+	return os.Rename(tmp.Name(), s.path(key))
+}
+
+func (s *FileStore) Delete(key Key) error {
+	if err := os.Remove(s.path(key)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package checkpoint_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/gitlab-elasticsearch-indexer/checkpoint"
+)
+
+var key = checkpoint.Key{
+	ProjectID: 667,
+	BlobType:  "blob",
+	FromSHA:   "4b825dc642cb6eb9a060e54bf8d69288fbee4904",
+	ToSHA:     "b83d6e391c22777fca1ed3012fce84f633d7fed0",
+}
+
+func newFileStore(t *testing.T) (*checkpoint.FileStore, func()) {
+	dir, err := ioutil.TempDir("", "checkpoints")
+	require.NoError(t, err)
+
+	store, err := checkpoint.NewFileStore(filepath.Join(dir, "nested"))
+	require.NoError(t, err)
+
+	return store, func() { os.RemoveAll(dir) }
+}
+
+func TestFileStoreRoundTrip(t *testing.T) {
+	store, cleanup := newFileStore(t)
+	defer cleanup()
+
+	saved := &checkpoint.Checkpoint{Changes: 2, LastPath: "foo/bar", Commits: 1, LastCommit: key.ToSHA}
+	require.NoError(t, store.Save(key, saved))
+
+	loaded, err := store.Load(key)
+	require.NoError(t, err)
+	require.Equal(t, saved, loaded)
+
+	other := key
+	other.BlobType = "wiki_blob"
+	loaded, err = store.Load(other)
+	require.NoError(t, err)
+	require.Nil(t, loaded)
+
+	require.NoError(t, store.Delete(key))
+	require.NoError(t, store.Delete(key))
+
+	loaded, err = store.Load(key)
+	require.NoError(t, err)
+	require.Nil(t, loaded)
+
+	files, err := ioutil.ReadDir(store.Dir)
+	require.NoError(t, err)
+	require.Empty(t, files)
+}
+
+func TestFileStoreCorruptCheckpoint(t *testing.T) {
+	store, cleanup := newFileStore(t)
+	defer cleanup()
+
+	require.NoError(t, store.Save(key, &checkpoint.Checkpoint{}))
+
+	files, err := filepath.Glob(filepath.Join(store.Dir, "*.json"))
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	require.NoError(t, ioutil.WriteFile(files[0], []byte("{"), 0600))
+
+	_, err = store.Load(key)
+	require.Error(t, err)
+}
@@ -0,0 +1,348 @@
+package elastic
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/olivere/elastic"
+	log "github.com/sirupsen/logrus"
+)
+
+// WriteAlias is the alias documents are written to when Config.Aliases is
+// set. IndexName is then the read alias.
+func WriteAlias(alias string) string {
+	return alias + "-write"
+}
+
+// VersionedIndexName names the index created at t behind an alias, e.g.
+// "gitlab-production-20200102-150405"
+func VersionedIndexName(alias string, t time.Time) string {
+	return alias + "-" + t.UTC().Format("20060102-150405")
+}
+
+// ReindexResult counts the documents copied by Reindex
+type ReindexResult struct {
+	Total     int64
+	Created   int64
+	Conflicts int64
+	Failures  []string
+}
+
+// CreateVersionedIndex creates a new index for the IndexName alias with
+// IndexMapping, and returns its name. No alias points at it yet.
+func (c *Client) CreateVersionedIndex(ctx context.Context) (string, error) {
+	name := VersionedIndexName(c.IndexName, time.Now())
+	if err := c.createIndexNamed(ctx, name, RenderedMapping()); err != nil {
+		return "", fmt.Errorf("Couldn't create index %s: %s", name, err)
+	}
+
+	return name, nil
+}
+
+// AliasedIndices returns the indices an alias points at, which is empty if
+// the alias doesn't exist
+func (c *Client) AliasedIndices(ctx context.Context, alias string) ([]string, error) {
+	res, err := c.Client.PerformRequest(ctx, elastic.PerformRequestOptions{
+		Method: "GET",
+		Path:   "/_alias/" + alias,
+	})
+	if elastic.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Couldn't get alias %s: %s", alias, err)
+	}
+
+	var indices map[string]json.RawMessage
+	if err := json.Unmarshal(res.Body, &indices); err != nil {
+		return nil, fmt.Errorf("Couldn't get alias %s: %s", alias, err)
+	}
+
+	var names []string
+	for name := range indices {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names, nil
+}
+
+// PointAliases moves the aliases from the indices they point at to index, in
+// a single request so searches and writes never see a missing alias
+func (c *Client) PointAliases(ctx context.Context, index string, aliases ...string) error {
+	var actions []map[string]interface{}
+
+	for _, alias := range aliases {
+		indices, err := c.AliasedIndices(ctx, alias)
+		if err != nil {
+			return err
+		}
+
+		for _, old := range indices {
+			if old != index {
+				actions = append(actions, map[string]interface{}{
+					"remove": map[string]string{"index": old, "alias": alias},
+				})
+			}
+		}
+
+		actions = append(actions, map[string]interface{}{
+			"add": map[string]string{"index": index, "alias": alias},
+		})
+	}
+
+	res, err := c.Client.PerformRequest(ctx, elastic.PerformRequestOptions{
+		Method: "POST",
+		Path:   "/_aliases",
+		Body:   map[string]interface{}{"actions": actions},
+	})
+	if err != nil {
+		return fmt.Errorf("Couldn't point %s at %s: %s", strings.Join(aliases, ", "), index, err)
+	}
+
+	var ack elastic.AliasResult
+	if err := json.Unmarshal(res.Body, &ack); err != nil {
+		return err
+	}
+
+	if !ack.Acknowledged {
+		return timeoutError
+	}
+
+	return nil
+}
+
+// ReindexPollInterval is how often Reindex checks whether the copy finished
+var ReindexPollInterval = time.Second
+
+// reindexTask is the status of a task started by _reindex, as returned by
+// the _tasks API
+type reindexTask struct {
+	Completed bool            `json:"completed"`
+	Error     json.RawMessage `json:"error"`
+	Response  struct {
+		Total            int64             `json:"total"`
+		Created          int64             `json:"created"`
+		VersionConflicts int64             `json:"version_conflicts"`
+		Failures         []json.RawMessage `json:"failures"`
+	} `json:"response"`
+}
+
+// Reindex copies every document of source to dest on the server. Copies keep
+// the version of their source as an external version, so they never replace
+// a document of dest with a newer version, or one deleted with a newer
+// version, which are counted as conflicts. The copy runs as a task, which is polled every ReindexPollInterval until it
+// finishes. If ctx is done first, the task is cancelled and the context's
+// error is returned.
+func (c *Client) Reindex(ctx context.Context, source, dest string) (*ReindexResult, error) {
+	res, err := c.Client.PerformRequest(ctx, elastic.PerformRequestOptions{
+		Method: "POST",
+		Path:   "/_reindex",
+		Params: map[string][]string{"wait_for_completion": {"false"}},
+		Body: map[string]interface{}{
+			"conflicts": "proceed",
+			"source":    map[string]interface{}{"index": source},
+			"dest":      map[string]interface{}{"index": dest, "version_type": "external"},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Couldn't reindex %s into %s: %s", source, dest, err)
+	}
+
+	var started struct {
+		Task string `json:"task"`
+	}
+
+	if err := json.Unmarshal(res.Body, &started); err != nil || started.Task == "" {
+		return nil, fmt.Errorf("Couldn't reindex %s into %s: no task in %s", source, dest, res.Body)
+	}
+
+	task, err := c.waitForTask(ctx, started.Task)
+	if err != nil && err == ctx.Err() {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Couldn't reindex %s into %s: %s", source, dest, err)
+	}
+
+	if len(task.Error) > 0 {
+		return nil, fmt.Errorf("Couldn't reindex %s into %s: %s", source, dest, task.Error)
+	}
+
+	out := task.Response
+	result := &ReindexResult{Total: out.Total, Created: out.Created, Conflicts: out.VersionConflicts}
+	for _, failure := range out.Failures {
+		result.Failures = append(result.Failures, string(failure))
+	}
+
+	return result, nil
+}
+
+// waitForTask polls a task until it completes. If ctx is done first, the
+// task is cancelled.
+func (c *Client) waitForTask(ctx context.Context, id string) (*reindexTask, error) {
+	ticker := time.NewTicker(ReindexPollInterval)
+	defer ticker.Stop()
+
+	for {
+		res, err := c.Client.PerformRequest(ctx, elastic.PerformRequestOptions{
+			Method: "GET",
+			Path:   "/_tasks/" + id,
+		})
+		if err != nil && ctx.Err() == nil {
+			return nil, fmt.Errorf("Couldn't get task %s: %s", id, err)
+		}
+
+		if err == nil {
+			var task reindexTask
+			if err := json.Unmarshal(res.Body, &task); err != nil {
+				return nil, fmt.Errorf("Couldn't get task %s: %s", id, err)
+			}
+
+			if task.Completed {
+				return &task, nil
+			}
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			c.cancelTask(id)
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// cancelTask asks the server to stop a task. ctx may be done already, so it
+// isn't used.
+func (c *Client) cancelTask(id string) {
+	_, err := c.Client.PerformRequest(context.Background(), elastic.PerformRequestOptions{
+		Method: "POST",
+		Path:   "/_tasks/" + id + "/_cancel",
+	})
+	if err != nil {
+		log.WithError(err).Errorf("Couldn't cancel task %s", id)
+	}
+}
+
+// putIndexSettings updates the dynamic settings of index. A nil value
+// resets a setting to its default.
+func (c *Client) putIndexSettings(ctx context.Context, index string, settings map[string]interface{}) error {
+	res, err := c.Client.PerformRequest(ctx, elastic.PerformRequestOptions{
+		Method: "PUT",
+		Path:   "/" + index + "/_settings",
+		Body:   map[string]interface{}{"index": settings},
+	})
+	if err != nil {
+		return fmt.Errorf("Couldn't update the settings of %s: %s", index, err)
+	}
+
+	var ack elastic.AliasResult
+	if err := json.Unmarshal(res.Body, &ack); err != nil {
+		return err
+	}
+
+	if !ack.Acknowledged {
+		return timeoutError
+	}
+
+	return nil
+}
+
+// CreateAliasedIndex creates the first versioned index behind the IndexName
+// read alias and its write alias, and returns its name
+func (c *Client) CreateAliasedIndex(ctx context.Context) (string, error) {
+	indices, err := c.AliasedIndices(ctx, c.IndexName)
+	if err != nil {
+		return "", err
+	}
+
+	if len(indices) > 0 {
+		return "", fmt.Errorf("Alias %s already points at %s", c.IndexName, strings.Join(indices, ", "))
+	}
+
+	name, err := c.CreateVersionedIndex(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return name, c.PointAliases(ctx, name, c.IndexName, WriteAlias(c.IndexName))
+}
+
+// CreateConfiguredIndex creates the index the client writes to with the
+// current mapping, and returns its name. With Config.Aliases, that is the
+// first versioned index behind the aliases, see CreateAliasedIndex, as
+// IndexName must stay free for the read alias.
+func (c *Client) CreateConfiguredIndex(ctx context.Context) (string, error) {
+	if c.writeIndex != c.IndexName {
+		return c.CreateAliasedIndex(ctx)
+	}
+
+	if err := c.createIndexNamed(ctx, c.IndexName, RenderedMapping()); err != nil {
+		return "", fmt.Errorf("Couldn't create index %s: %s", c.IndexName, err)
+	}
+
+	return c.IndexName, nil
+}
+
+// ReindexTombstoneRetention is how long the new index of ReindexAliased
+// keeps the versions of deleted documents while it is filled, rather than the
+// default of a minute. It must exceed the time the copy takes.
+var ReindexTombstoneRetention = "24h"
+
+// ReindexAliased copies the index behind the aliases into a new versioned
+// index with the current mapping, then points the read alias at it.
+//
+// The write alias is pointed at the new index first, so writes never stop.
+// Copies don't replace documents written meanwhile with a newer version, see
+// Reindex. A document deleted meanwhile isn't copied back either, as long as
+// it was deleted with an external version: the new index keeps the version of
+// deleted documents for ReindexTombstoneRetention, and the copy is older.
+//
+// If copying fails, the read alias is left alone and the new index is
+// returned with the error.
+func (c *Client) ReindexAliased(ctx context.Context) (name string, result *ReindexResult, err error) {
+	current, err := c.AliasedIndices(ctx, c.IndexName)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if len(current) != 1 {
+		return "", nil, fmt.Errorf("Alias %s must point at exactly one index, got %v", c.IndexName, current)
+	}
+
+	name, err = c.CreateVersionedIndex(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if err := c.putIndexSettings(ctx, name, map[string]interface{}{"gc_deletes": ReindexTombstoneRetention}); err != nil {
+		return name, nil, err
+	}
+
+	// ctx may be done already
+	defer func() {
+		if resetErr := c.putIndexSettings(context.Background(), name, map[string]interface{}{"gc_deletes": nil}); resetErr != nil && err == nil {
+			err = resetErr
+		}
+	}()
+
+	if err := c.PointAliases(ctx, name, WriteAlias(c.IndexName)); err != nil {
+		return name, nil, err
+	}
+
+	result, err = c.Reindex(ctx, current[0], name)
+	if err != nil {
+		return name, nil, err
+	}
+
+	if len(result.Failures) > 0 {
+		return name, result, fmt.Errorf("%d documents couldn't be copied from %s", len(result.Failures), current[0])
+	}
+
+	return name, result, c.PointAliases(ctx, name, c.IndexName)
+}
@@ -0,0 +1,522 @@
+package elastic_test
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/gitlab-elasticsearch-indexer/elastic"
+)
+
+// aliasCluster pretends to be an Elasticsearch 7 cluster where aliases
+// point at indices, and records the requests it gets after startup. It keeps
+// the versions of documents, and of deleted ones, to check external
+// versioning.
+type aliasCluster struct {
+	mu       sync.Mutex
+	aliases  map[string]string
+	docs     map[string]map[string]*fakeDoc
+	settings map[string]map[string]interface{}
+	requests []string
+
+	// duringReindex is called when the reindex task is first polled, before
+	// the documents are copied
+	duringReindex func()
+	polls         int
+	source, dest  string
+}
+
+// fakeDoc is a document, or the tombstone of a deleted one
+type fakeDoc struct {
+	version int64
+	deleted bool
+}
+
+func aliasServer(t *testing.T, aliases map[string]string) (*httptest.Server, *aliasCluster) {
+	cluster := &aliasCluster{
+		aliases:  aliases,
+		docs:     make(map[string]map[string]*fakeDoc),
+		settings: make(map[string]map[string]interface{}),
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+
+		if r.URL.Path == "/" {
+			w.Write([]byte(`{"version":{"number":"7.17.9"}}`))
+			return
+		}
+
+		cluster.mu.Lock()
+		cluster.requests = append(cluster.requests, r.Method+" "+r.URL.Path+" "+string(body))
+		cluster.mu.Unlock()
+
+		switch {
+		case r.Method == "GET" && strings.HasPrefix(r.URL.Path, "/_alias/"):
+			alias := strings.TrimPrefix(r.URL.Path, "/_alias/")
+
+			cluster.mu.Lock()
+			index, ok := cluster.aliases[alias]
+			cluster.mu.Unlock()
+
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				w.Write([]byte(`{"error":"alias [` + alias + `] missing","status":404}`))
+				return
+			}
+
+			w.Write([]byte(`{"` + index + `":{"aliases":{"` + alias + `":{}}}}`))
+		case r.URL.Path == "/_aliases":
+			cluster.updateAliases(t, body)
+			w.Write([]byte(`{"acknowledged":true}`))
+		case r.Method == "PUT" && strings.HasSuffix(r.URL.Path, "/_settings"):
+			var settings struct {
+				Index map[string]interface{} `json:"index"`
+			}
+			require.NoError(t, json.Unmarshal(body, &settings))
+
+			cluster.mu.Lock()
+			index := strings.Split(r.URL.Path, "/")[1]
+			if cluster.settings[index] == nil {
+				cluster.settings[index] = make(map[string]interface{})
+			}
+			for key, value := range settings.Index {
+				if value == nil {
+					delete(cluster.settings[index], key)
+				} else {
+					cluster.settings[index][key] = value
+				}
+			}
+			cluster.mu.Unlock()
+
+			w.Write([]byte(`{"acknowledged":true}`))
+		case r.URL.Path == "/_reindex":
+			require.Equal(t, "false", r.URL.Query().Get("wait_for_completion"))
+
+			var reindex struct {
+				Source struct {
+					Index string `json:"index"`
+				} `json:"source"`
+				Dest struct {
+					Index string `json:"index"`
+				} `json:"dest"`
+			}
+			require.NoError(t, json.Unmarshal(body, &reindex))
+
+			cluster.mu.Lock()
+			cluster.source, cluster.dest = reindex.Source.Index, reindex.Dest.Index
+			cluster.mu.Unlock()
+
+			w.Write([]byte(`{"task":"node:1"}`))
+		case r.URL.Path == "/_tasks/node:1":
+			cluster.mu.Lock()
+			cluster.polls++
+			polls := cluster.polls
+			cluster.mu.Unlock()
+
+			if polls == 1 {
+				if cluster.duringReindex != nil {
+					cluster.duringReindex()
+				}
+
+				w.Write([]byte(`{"completed":false}`))
+				return
+			}
+
+			w.Write(cluster.copyDocs(t))
+		case strings.HasSuffix(r.URL.Path, "/_bulk"):
+			w.Write(cluster.bulk(t, body))
+		default:
+			w.Write([]byte(`{"acknowledged":true}`))
+		}
+	}))
+
+	return srv, cluster
+}
+
+// index returns the documents of an index, or of the index an alias points
+// at. It must be called with mu held.
+func (c *aliasCluster) index(name string) map[string]*fakeDoc {
+	if aliased, ok := c.aliases[name]; ok {
+		name = aliased
+	}
+
+	if c.docs[name] == nil {
+		c.docs[name] = make(map[string]*fakeDoc)
+	}
+
+	return c.docs[name]
+}
+
+func (c *aliasCluster) updateAliases(t *testing.T, body []byte) {
+	var request struct {
+		Actions []map[string]struct {
+			Index string `json:"index"`
+			Alias string `json:"alias"`
+		} `json:"actions"`
+	}
+	require.NoError(t, json.Unmarshal(body, &request))
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, action := range request.Actions {
+		for op, target := range action {
+			if op == "add" {
+				c.aliases[target.Alias] = target.Index
+			} else if c.aliases[target.Alias] == target.Index {
+				delete(c.aliases, target.Alias)
+			}
+		}
+	}
+}
+
+// copyDocs copies the documents of the reindex task with external
+// versioning, which skips documents with the same or a newer version, even
+// deleted ones
+func (c *aliasCluster) copyDocs(t *testing.T) []byte {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var total, created, conflicts int
+	dest := c.index(c.dest)
+
+	for id, doc := range c.index(c.source) {
+		if doc.deleted {
+			continue
+		}
+
+		total++
+		if existing, ok := dest[id]; ok && existing.version >= doc.version {
+			conflicts++
+			continue
+		}
+
+		dest[id] = &fakeDoc{version: doc.version}
+		created++
+	}
+
+	out, err := json.Marshal(map[string]interface{}{
+		"completed": true,
+		"response": map[string]interface{}{
+			"total":             total,
+			"created":           created,
+			"version_conflicts": conflicts,
+			"failures":          []interface{}{},
+		},
+	})
+	require.NoError(t, err)
+
+	return out
+}
+
+// bulk applies index and delete operations with external_gte versioning.
+// Deleting a missing document leaves a tombstone, as Elasticsearch does.
+func (c *aliasCluster) bulk(t *testing.T, body []byte) []byte {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var items []map[string]interface{}
+	failed := false
+
+	lines := strings.Split(strings.TrimSpace(string(body)), "\n")
+	for i := 0; i < len(lines); i++ {
+		var action map[string]struct {
+			Index   string `json:"_index"`
+			ID      string `json:"_id"`
+			Version int64  `json:"version"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(lines[i]), &action))
+
+		for op, meta := range action {
+			if op != "delete" {
+				i++ // Skip the document
+			}
+
+			docs := c.index(meta.Index)
+			existing, ok := docs[meta.ID]
+
+			status := http.StatusOK
+			switch {
+			case ok && meta.Version < existing.version:
+				status = http.StatusConflict
+			case op == "delete":
+				if !ok || existing.deleted {
+					status = http.StatusNotFound
+				}
+				docs[meta.ID] = &fakeDoc{version: meta.Version, deleted: true}
+			default:
+				docs[meta.ID] = &fakeDoc{version: meta.Version}
+			}
+
+			result := map[string]interface{}{"_index": meta.Index, "_id": meta.ID, "status": status}
+			if status == http.StatusConflict {
+				failed = true
+				result["error"] = map[string]string{"type": "version_conflict_engine_exception"}
+			}
+
+			items = append(items, map[string]interface{}{op: result})
+		}
+	}
+
+	out, err := json.Marshal(map[string]interface{}{"errors": failed, "items": items})
+	require.NoError(t, err)
+
+	return out
+}
+
+// steps returns the requests other than GETs, without their body
+func (c *aliasCluster) steps() []string {
+	var steps []string
+	for _, req := range c.requests {
+		if !strings.HasPrefix(req, "GET ") {
+			steps = append(steps, regexp.MustCompile(` (\{.*)?\z`).ReplaceAllString(req, ""))
+		}
+	}
+
+	return steps
+}
+
+func newAliasTestClient(t *testing.T, srv *httptest.Server, aliases bool) *elastic.Client {
+	config, err := elastic.ReadConfig(strings.NewReader(`{"url":["` + srv.URL + `"],"index_name":"gitlab-test","max_bulk_concurrency":1}`))
+	require.NoError(t, err)
+	config.ProjectID = projectID
+	config.Aliases = aliases
+
+	client, err := elastic.NewClient(config)
+	require.NoError(t, err)
+
+	return client
+}
+
+func TestVersionedIndexName(t *testing.T) {
+	at := time.Date(2020, 1, 2, 15, 4, 5, 0, time.UTC)
+
+	require.Equal(t, "gitlab-test-20200102-150405", elastic.VersionedIndexName("gitlab-test", at))
+	require.Equal(t, "gitlab-test-write", elastic.WriteAlias("gitlab-test"))
+}
+
+func TestCreateAliasedIndex(t *testing.T) {
+	srv, cluster := aliasServer(t, map[string]string{})
+	defer srv.Close()
+
+	client := newAliasTestClient(t, srv, false)
+	defer client.Close()
+
+	name, err := client.CreateAliasedIndex(context.Background())
+	require.NoError(t, err)
+	require.Regexp(t, `\Agitlab-test-\d{8}-\d{6}\z`, name)
+
+	require.Len(t, cluster.requests, 5)
+	require.Equal(t, "GET /_alias/gitlab-test ", cluster.requests[0])
+	require.True(t, strings.HasPrefix(cluster.requests[1], "PUT /"+name+" "))
+	require.Equal(t, `POST /_aliases {"actions":[{"add":{"alias":"gitlab-test","index":"`+name+`"}},{"add":{"alias":"gitlab-test-write","index":"`+name+`"}}]}`, cluster.requests[4])
+}
+
+func TestCreateAliasedIndexRefusesExistingAlias(t *testing.T) {
+	srv, _ := aliasServer(t, map[string]string{"gitlab-test": "gitlab-test-20200102-150405"})
+	defer srv.Close()
+
+	client := newAliasTestClient(t, srv, false)
+	defer client.Close()
+
+	_, err := client.CreateAliasedIndex(context.Background())
+	require.EqualError(t, err, "Alias gitlab-test already points at gitlab-test-20200102-150405")
+}
+
+func TestCreateConfiguredIndex(t *testing.T) {
+	srv, cluster := aliasServer(t, map[string]string{})
+	defer srv.Close()
+
+	client := newAliasTestClient(t, srv, false)
+	defer client.Close()
+
+	name, err := client.CreateConfiguredIndex(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "gitlab-test", name)
+	require.Equal(t, []string{"PUT /gitlab-test"}, cluster.steps())
+
+	// With aliases, the index name is left for the read alias
+	aliased := newAliasTestClient(t, srv, true)
+	defer aliased.Close()
+
+	name, err = aliased.CreateConfiguredIndex(context.Background())
+	require.NoError(t, err)
+	require.Regexp(t, `\Agitlab-test-\d{8}-\d{6}\z`, name)
+	require.Equal(t, []string{"PUT /gitlab-test", "PUT /" + name, "POST /_aliases"}, cluster.steps())
+}
+
+// oldIndexCluster serves an index behind both aliases with two documents
+func oldIndexCluster(t *testing.T) (*httptest.Server, *aliasCluster, string) {
+	old := "gitlab-test-20200102-150405"
+	srv, cluster := aliasServer(t, map[string]string{"gitlab-test": old, "gitlab-test-write": old})
+
+	cluster.docs[old] = map[string]*fakeDoc{
+		projectIDString + "_foo": {version: 1},
+		projectIDString + "_bar": {version: 1},
+	}
+
+	return srv, cluster, old
+}
+
+func TestReindexAliased(t *testing.T) {
+	defer setReindexPollInterval(time.Millisecond)()
+
+	srv, cluster, old := oldIndexCluster(t)
+	defer srv.Close()
+
+	client := newAliasTestClient(t, srv, false)
+	defer client.Close()
+
+	var tombstones interface{}
+	cluster.duringReindex = func() {
+		cluster.mu.Lock()
+		defer cluster.mu.Unlock()
+
+		for index, settings := range cluster.settings {
+			if index != old {
+				tombstones = settings["gc_deletes"]
+			}
+		}
+	}
+
+	name, result, err := client.ReindexAliased(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, &elastic.ReindexResult{Total: 2, Created: 2}, result)
+
+	// The write alias moves before documents are copied, and the read alias
+	// after. Deletes are kept while copying.
+	require.Equal(t, []string{
+		"PUT /" + name,
+		"PUT /" + name + "/_settings",
+		"POST /_aliases",
+		"POST /_reindex",
+		"POST /_aliases",
+		"PUT /" + name + "/_settings",
+	}, cluster.steps())
+
+	var settings, swaps []string
+	var reindex string
+	for _, req := range cluster.requests {
+		switch {
+		case strings.HasPrefix(req, "PUT /"+name+"/_settings"):
+			settings = append(settings, req)
+		case strings.HasPrefix(req, "POST /_aliases"):
+			swaps = append(swaps, req)
+		case strings.HasPrefix(req, "POST /_reindex"):
+			reindex = req
+		}
+	}
+
+	require.Equal(t, []string{
+		`PUT /` + name + `/_settings {"index":{"gc_deletes":"24h"}}`,
+		`PUT /` + name + `/_settings {"index":{"gc_deletes":null}}`,
+	}, settings)
+	require.Equal(t, "24h", tombstones)
+	require.Empty(t, cluster.settings[name])
+
+	require.Equal(t, []string{
+		`POST /_aliases {"actions":[{"remove":{"alias":"gitlab-test-write","index":"` + old + `"}},{"add":{"alias":"gitlab-test-write","index":"` + name + `"}}]}`,
+		`POST /_aliases {"actions":[{"remove":{"alias":"gitlab-test","index":"` + old + `"}},{"add":{"alias":"gitlab-test","index":"` + name + `"}}]}`,
+	}, swaps)
+	require.Equal(t, `POST /_reindex {"conflicts":"proceed","dest":{"index":"`+name+`","version_type":"external"},"source":{"index":"`+old+`"}}`, reindex)
+	require.Equal(t, map[string]string{"gitlab-test": name, "gitlab-test-write": name}, cluster.aliases)
+}
+
+func TestReindexAliasedKeepsWritesAndDeletesDuringCopy(t *testing.T) {
+	defer setReindexPollInterval(time.Millisecond)()
+
+	srv, cluster, old := oldIndexCluster(t)
+	defer srv.Close()
+
+	client := newAliasTestClient(t, srv, false)
+	defer client.Close()
+
+	writer := newAliasTestClient(t, srv, true)
+	defer writer.Close()
+	writer.SetDocumentVersion(2)
+
+	// Both documents are written to the new index before the copy reaches
+	// them: one is deleted, which isn't found yet, and one updated
+	var writeErr error
+	cluster.duringReindex = func() {
+		writer.Remove(context.Background(), projectIDString+"_foo")
+		writer.Index(context.Background(), projectIDString+"_bar", map[string]interface{}{})
+		writeErr = writer.Flush(context.Background())
+	}
+
+	name, result, err := client.ReindexAliased(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, writeErr)
+	require.Equal(t, elastic.BulkSummary{Succeeded: 2}, writer.Summary())
+
+	// Neither is replaced by its older copy
+	require.Equal(t, &elastic.ReindexResult{Total: 2, Conflicts: 2}, result)
+	require.Equal(t, map[string]*fakeDoc{
+		projectIDString + "_foo": {version: 2, deleted: true},
+		projectIDString + "_bar": {version: 2},
+	}, cluster.docs[name])
+	require.Len(t, cluster.docs[old], 2)
+}
+
+func TestReindexAliasedCancelsTask(t *testing.T) {
+	defer setReindexPollInterval(time.Hour)()
+
+	srv, cluster, old := oldIndexCluster(t)
+	defer srv.Close()
+
+	client := newAliasTestClient(t, srv, false)
+	defer client.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cluster.duringReindex = cancel
+
+	name, _, err := client.ReindexAliased(ctx)
+	require.Equal(t, context.Canceled, err)
+
+	// The read alias is left alone, and deletes are no longer kept
+	require.Equal(t, []string{
+		"PUT /" + name,
+		"PUT /" + name + "/_settings",
+		"POST /_aliases",
+		"POST /_reindex",
+		"POST /_tasks/node:1/_cancel",
+		"PUT /" + name + "/_settings",
+	}, cluster.steps())
+	require.Equal(t, map[string]string{"gitlab-test": old, "gitlab-test-write": name}, cluster.aliases)
+	require.Empty(t, cluster.settings[name])
+}
+
+func setReindexPollInterval(interval time.Duration) func() {
+	old := elastic.ReindexPollInterval
+	elastic.ReindexPollInterval = interval
+
+	return func() { elastic.ReindexPollInterval = old }
+}
+
+func TestAliasesWriteToWriteAlias(t *testing.T) {
+	srv, cluster := aliasServer(t, map[string]string{})
+	defer srv.Close()
+
+	client := newAliasTestClient(t, srv, true)
+	defer client.Close()
+
+	client.Index(context.Background(), projectIDString+"_foo", map[string]interface{}{})
+	require.NoError(t, client.Flush(context.Background()))
+
+	require.Len(t, cluster.requests, 1)
+	require.Contains(t, cluster.requests[0], `"_index":"gitlab-test-write"`)
+	require.Equal(t, "gitlab-test", client.IndexName)
+}
@@ -0,0 +1,91 @@
+package elastic
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/session"
+)
+
+const roleSessionName = "gitlab-elasticsearch-indexer"
+
+// ResolveAWSCredentials returns Credentials object
+//
+// Order of resolution
+//  1. Static Credentials - As configured in Indexer config
+//  2. The credential chain of the AWS SDK, with the shared config enabled:
+//     environment variables, web identity tokens, the shared credentials
+//     and config files with source_profile, credential_process and SSO
+//     profiles, ECS task roles and EC2 instance roles. The aws_profile
+//     profile is used, or AWS_PROFILE or "default".
+//
+// If aws_role_arn is configured, the resolved credentials are then used to
+// assume that role. The Endpoint of aws_config, if any, is the endpoint of
+// the EC2 instance metadata service.
+func ResolveAWSCredentials(config *Config, aws_config *aws.Config) *credentials.Credentials {
+	sess, err := newAWSSession(config, aws_config)
+	if err != nil {
+		return credentials.NewCredentials(&failedProvider{err: err})
+	}
+
+	creds := sess.Config.Credentials
+	if config.AccessKey != "" && config.SecretKey != "" {
+		creds = credentials.NewStaticCredentials(config.AccessKey, config.SecretKey, "")
+	}
+
+	if config.RoleARN == "" {
+		return creds
+	}
+
+	return stscreds.NewCredentials(sess.Copy(&aws.Config{Credentials: creds}), config.RoleARN, func(p *stscreds.AssumeRoleProvider) {
+		p.RoleSessionName = roleSessionName
+	})
+}
+
+func newAWSSession(config *Config, aws_config *aws.Config) (*session.Session, error) {
+	sessionConfig := aws_config.Copy()
+	sessionConfig.Endpoint = nil
+	sessionConfig.CredentialsChainVerboseErrors = aws.Bool(true)
+
+	if config.Region != "" {
+		sessionConfig.Region = aws.String(config.Region)
+	}
+
+	if config.STSEndpoint != "" {
+		sessionConfig.EndpointResolver = stsEndpointResolver(config.STSEndpoint)
+	}
+
+	return session.NewSessionWithOptions(session.Options{
+		Config:            *sessionConfig,
+		Profile:           config.Profile,
+		SharedConfigState: session.SharedConfigEnable,
+		EC2IMDSEndpoint:   aws.StringValue(aws_config.Endpoint),
+	})
+}
+
+// stsEndpointResolver sends STS requests, for web identities and assuming
+// roles, to endpoint
+func stsEndpointResolver(endpoint string) endpoints.Resolver {
+	return endpoints.ResolverFunc(func(service, region string, opts ...func(*endpoints.Options)) (endpoints.ResolvedEndpoint, error) {
+		if service != endpoints.StsServiceID {
+			return endpoints.DefaultResolver().EndpointFor(service, region, opts...)
+		}
+
+		return endpoints.ResolvedEndpoint{URL: endpoint, SigningRegion: region}, nil
+	})
+}
+
+// failedProvider fails to retrieve credentials when they can't be resolved
+// at all
+type failedProvider struct {
+	err error
+}
+
+func (p *failedProvider) Retrieve() (credentials.Value, error) {
+	return credentials.Value{}, p.err
+}
+
+func (p *failedProvider) IsExpired() bool {
+	return true
+}
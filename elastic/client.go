@@ -1,20 +1,22 @@
 package elastic
 
 import (
+	"bufio"
 	"context"
 	"fmt"
-	"github.com/aws/aws-sdk-go/aws/credentials/endpointcreds"
-	"github.com/aws/aws-sdk-go/aws/defaults"
-	"log"
-	"net/http"
+	"io"
 	"os"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/credentials"
 	v4 "github.com/aws/aws-sdk-go/aws/signer/v4"
 	"github.com/deoxxa/aws_signing_client"
 	"github.com/olivere/elastic"
+	log "github.com/sirupsen/logrus"
+
+	"gitlab.com/gitlab-org/gitlab-elasticsearch-indexer/logging"
 )
 
 var (
@@ -22,16 +24,55 @@ var (
 )
 
 type Client struct {
-	IndexName  string
-	ProjectID  int64
-	Client     *elastic.Client
-	bulk       *elastic.BulkProcessor
-	bulkFailed bool
+	IndexName string
+	ProjectID int64
+
+	// writeIndex is where documents are written, which differs from
+	// IndexName when aliases are used
+	writeIndex string
+
+	Client    *elastic.Client
+	bulk      *elastic.BulkProcessor
+	confirmed func(ids []string)
+	version   int64
+	retry     *retryPolicy
+	server    serverVersion
+	dialect   dialect
+
+	// mu guards the results of bulk requests, which are committed by several
+	// workers
+	mu            sync.Mutex
+	bulkFailed    bool
+	succeeded     int
+	retried       int
+	skipped       int
+	failures      []BulkFailure
+	deadLetter    *bufio.Writer
+	deadLetterErr error
+
+	// started holds the start of the bulk requests in flight, by execution
+	started map[int64]time.Time
+
+	// retrying counts the bulk requests whose rejected items are being
+	// retried. retryDone is signalled when it drops to 0.
+	retrying  int
+	retryDone *sync.Cond
 }
 
 // FromEnv creates an Elasticsearch client from the `ELASTIC_CONNECTION_INFO`
 // environment variable
 func FromEnv(projectID int64) (*Client, error) {
+	config, err := ConfigFromEnv(projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewClient(config)
+}
+
+// ConfigFromEnv reads the configuration from the `ELASTIC_CONNECTION_INFO`
+// environment variable
+func ConfigFromEnv(projectID int64) (*Config, error) {
 	data := strings.NewReader(os.Getenv("ELASTIC_CONNECTION_INFO"))
 
 	config, err := ReadConfig(data)
@@ -40,40 +81,106 @@ func FromEnv(projectID int64) (*Client, error) {
 	}
 
 	if config.IndexName == "" {
-		railsEnv := os.Getenv("RAILS_ENV")
-		indexName := "gitlab"
-		if railsEnv != "" {
-			indexName = indexName + "-" + railsEnv
-		}
-		config.IndexName = indexName
+		config.IndexName = DefaultIndexName()
 	}
 
 	config.ProjectID = projectID
 
-	return NewClient(config)
+	return config, nil
+}
+
+// DefaultIndexName is the index GitLab uses in the current `RAILS_ENV`
+func DefaultIndexName() string {
+	railsEnv := os.Getenv("RAILS_ENV")
+	indexName := "gitlab"
+	if railsEnv != "" {
+		indexName = indexName + "-" + railsEnv
+	}
+
+	return indexName
 }
 
 func (c *Client) afterCallback(executionId int64, requests []elastic.BulkableRequest, response *elastic.BulkResponse, err error) {
+	logger := log.WithField(logging.BulkExecutionID, executionId)
+
 	if err != nil {
-		c.bulkFailed = true
-		log.Printf("bulk request %v: error: %v", executionId, err)
+		logger.WithError(err).Error("Bulk request failed")
 	}
 
-	// bulk response can be nil in some cases, we must check first
-	if response != nil && response.Errors {
-		numFailed := len(response.Failed())
-		if numFailed > 0 {
-			c.bulkFailed = true
-			total := numFailed + len(response.Succeeded())
+	outcome := &bulkOutcome{}
+	var retry []elastic.BulkableRequest
 
-			log.Printf("bulk request %v: failed to insert %v/%v documents ", executionId, numFailed, total)
+	// bulk response can be nil in some cases, we must check first
+	if response != nil {
+		retry = c.collect(requests, response, 0, outcome)
+	} else if err != nil {
+		for _, req := range requests {
+			op, id := requestAction(req)
+			outcome.fail(BulkFailure{ID: id, Op: op, Reason: err.Error()}, req)
 		}
 	}
+
+	c.commit(executionId, outcome, err, len(retry) == 0)
+
+	if len(retry) > 0 {
+		c.startRetrying()
+		go c.retryItems(executionId, retry)
+	}
+}
+
+// commit records the outcome of a bulk request, or of a retry of its
+// rejected items. final is false while rejected items are left to retry.
+func (c *Client) commit(executionId int64, outcome *bulkOutcome, err error, final bool) {
+	logger := log.WithField(logging.BulkExecutionID, executionId)
+
+	for n := range outcome.failures {
+		outcome.failures[n].ExecutionID = executionId
+	}
+
+	if numFailed := len(outcome.failures); numFailed > 0 {
+		logger.WithFields(log.Fields{
+			"failed": numFailed,
+			"total":  numFailed + len(outcome.confirmed),
+		}).Warn("Bulk request failed to insert documents")
+	}
+
+	c.observeBulk(executionId, outcome, final)
+
+	if numSkipped := len(outcome.skipped); numSkipped > 0 {
+		logger.WithField("skipped", numSkipped).Info("Bulk request skipped documents with a newer version")
+	}
+
+	c.mu.Lock()
+
+	if err != nil || len(outcome.failures) > 0 {
+		c.bulkFailed = true
+	}
+
+	c.succeeded += len(outcome.confirmed)
+	c.retried += outcome.retried
+	c.skipped += len(outcome.skipped)
+	c.failures = append(c.failures, outcome.failures...)
+	for _, req := range outcome.failed {
+		c.writeDeadLetter(req)
+	}
+
+	c.mu.Unlock()
+
+	// Stale documents are done with as well
+	done := append(outcome.confirmed, outcome.skipped...)
+	if len(done) > 0 && c.confirmed != nil {
+		c.confirmed(done)
+	}
 }
 
 func NewClient(config *Config) (*Client, error) {
 	var opts []elastic.ClientOptionFunc
 
+	httpClient, err := newHTTPClient(config)
+	if err != nil {
+		return nil, err
+	}
+
 	// AWS settings have to come first or they override custom URL, etc
 	if config.AWS {
 		aws_config := &aws.Config{
@@ -81,12 +188,14 @@ func NewClient(config *Config) (*Client, error) {
 		}
 		credentials := ResolveAWSCredentials(config, aws_config)
 		signer := v4.NewSigner(credentials)
-		awsClient, err := aws_signing_client.New(signer, &http.Client{}, "es", config.Region)
+		awsClient, err := aws_signing_client.New(signer, httpClient, "es", config.Region)
 		if err != nil {
 			return nil, err
 		}
 
 		opts = append(opts, elastic.SetHttpClient(awsClient))
+	} else {
+		opts = append(opts, elastic.SetHttpClient(httpClient))
 	}
 
 	// Sniffer should look for HTTPS URLs if at-least-one initial URL is HTTPS
@@ -104,15 +213,36 @@ func NewClient(config *Config) (*Client, error) {
 		return nil, err
 	}
 
+	server, err := detectServer(client)
+	if err != nil {
+		return nil, err
+	}
+
 	wrappedClient := &Client{
-		IndexName: config.IndexName,
-		ProjectID: config.ProjectID,
-		Client:    client,
+		IndexName:  config.IndexName,
+		ProjectID:  config.ProjectID,
+		writeIndex: config.IndexName,
+		Client:     client,
+		retry:      newRetryPolicy(config),
+		server:     server,
+		dialect:    dialectFor(server),
+		started:    make(map[int64]time.Time),
 	}
 
+	wrappedClient.retryDone = sync.NewCond(&wrappedClient.mu)
+
+	if config.Aliases {
+		wrappedClient.writeIndex = WriteAlias(config.IndexName)
+	}
+
+	// Rejected items are retried by retryItems rather than the processor,
+	// which would only report the outcome of the last attempt
 	bulk, err := client.BulkProcessor().
 		Workers(config.BulkWorkers).
 		BulkSize(config.MaxBulkSize).
+		Backoff(wrappedClient.retry).
+		RetryItemStatusCodes().
+		Before(wrappedClient.beforeCallback).
 		After(wrappedClient.afterCallback).
 		Do(context.Background())
 
@@ -125,24 +255,59 @@ func NewClient(config *Config) (*Client, error) {
 	return wrappedClient, nil
 }
 
-// ResolveAWSCredentials returns Credentials object
-//
-// Order of resolution
-// 1.  Static Credentials - As configured in Indexer config
-// 2.  EC2 Instance Role Credentials
-func ResolveAWSCredentials(config *Config, aws_config *aws.Config) *credentials.Credentials {
-	ECSCredentialsURI, _ := os.LookupEnv("AWS_CONTAINER_CREDENTIALS_RELATIVE_URI")
-	endpoint := fmt.Sprintf("169.254.170.2%s", ECSCredentialsURI)
-	creds := endpointcreds.NewCredentialsClient(aws.Config{}, defaults.Handlers(), endpoint)
-	return creds
+// NotifyConfirmed calls f with the IDs of the documents in every successful
+// bulk response. It must be called before anything is indexed.
+func (c *Client) NotifyConfirmed(f func(ids []string)) {
+	c.confirmed = f
+}
+
+// SetDocumentVersion makes index and delete operations use external
+// versioning with version, e.g. the time of the commit being indexed. An
+// operation is then rejected if the document was written with a higher
+// version, e.g. by a run for a later push that finished first, and counted as
+// skipped rather than failed. It must be called before anything is indexed.
+func (c *Client) SetDocumentVersion(version int64) {
+	c.version = version
+}
+
+// ServerVersion returns the distribution and version of the cluster, e.g.
+// "opensearch 2.11.0"
+func (c *Client) ServerVersion() string {
+	return c.server.String()
 }
 
 func (c *Client) ParentID() int64 {
 	return c.ProjectID
 }
 
-func (c *Client) Flush() error {
-	err := c.bulk.Flush()
+// Flush commits all outstanding operations. If the context is done first,
+// the flush is abandoned and the context's error is returned. Operations that
+// haven't been committed yet are then never sent.
+func (c *Client) Flush(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		err := c.bulk.Flush()
+		c.waitRetries()
+		done <- err
+	}()
+
+	var err error
+	select {
+	case err = <-done:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	if dlErr := c.flushDeadLetter(); dlErr != nil {
+		return fmt.Errorf("Couldn't write dead letters: %s", dlErr)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
 
 	if err == nil && c.bulkFailed {
 		err = fmt.Errorf("Failed to perform all operations")
@@ -155,22 +320,56 @@ func (c *Client) Close() {
 	c.Client.Stop()
 }
 
-func (c *Client) Index(id string, thing interface{}) {
+// Index queues a document for indexing. Nothing is queued once the context
+// is done.
+func (c *Client) Index(ctx context.Context, id string, thing interface{}) {
+	if ctx.Err() != nil {
+		return
+	}
+
+	c.bulk.Add(newIndexRequest(c.dialect, c.writeIndex, c.ProjectID, c.version, id, thing))
+}
+
+// Versions are compared with external_gte, so running over the same commits
+// again, e.g. to retry failed operations, isn't rejected
+const versionType = "external_gte"
+
+// newIndexRequest uses external versioning if version isn't 0
+func newIndexRequest(d dialect, indexName string, projectID, version int64, id string, thing interface{}) *elastic.BulkIndexRequest {
 	req := elastic.NewBulkIndexRequest().
-		Index(c.IndexName).
-		Type("doc").
-		Routing(fmt.Sprintf("project_%v", c.ProjectID)).
+		Index(indexName).
+		Type(d.documentType()).
+		Routing(fmt.Sprintf("project_%v", projectID)).
 		Id(id).
 		Doc(thing)
 
-	c.bulk.Add(req)
+	if version != 0 {
+		req = req.Version(version).VersionType(versionType)
+	}
+
+	return req
+}
+
+// newDeleteRequest uses external versioning if version isn't 0
+func newDeleteRequest(d dialect, indexName string, projectID, version int64, id string) *elastic.BulkDeleteRequest {
+	req := elastic.NewBulkDeleteRequest().
+		Index(indexName).
+		Type(d.documentType()).
+		Routing(fmt.Sprintf("project_%v", projectID)).
+		Id(id)
+
+	if version != 0 {
+		req = req.Version(version).VersionType(versionType)
+	}
+
+	return req
 }
 
 // We only really use this for tests
 func (c *Client) Get(id string) (*elastic.GetResult, error) {
 	return c.Client.Get().
 		Index(c.IndexName).
-		Type("doc").
+		Type(c.dialect.endpointType()).
 		Routing(fmt.Sprintf("project_%v", c.ProjectID)).
 		Id(id).
 		Do(context.TODO())
@@ -184,12 +383,55 @@ func (c *Client) GetBlob(path string) (*elastic.GetResult, error) {
 	return c.Get(fmt.Sprintf("%v_%v", c.ProjectID, path))
 }
 
-func (c *Client) Remove(id string) {
-	req := elastic.NewBulkDeleteRequest().
-		Index(c.IndexName).
-		Type("doc").
+// Remove queues a document for removal. Nothing is queued once the context
+// is done.
+func (c *Client) Remove(ctx context.Context, id string) {
+	if ctx.Err() != nil {
+		return
+	}
+
+	c.bulk.Add(newDeleteRequest(c.dialect, c.writeIndex, c.ProjectID, c.version, id))
+}
+
+// EachDocumentID calls f with the ID of every document of the given type that
+// belongs to repoID, e.g. every blob of the project, using the scroll API.
+func (c *Client) EachDocumentID(ctx context.Context, docType, repoID string, f func(id string) error) error {
+	// Wiki blobs share the blob properties
+	field := docType
+	if docType == "wiki_blob" {
+		field = "blob"
+	}
+
+	query := elastic.NewBoolQuery().Filter(
+		elastic.NewTermQuery("type", docType),
+		elastic.NewTermQuery(field+".rid", repoID),
+	)
+
+	scroll := c.Client.Scroll(c.IndexName).
 		Routing(fmt.Sprintf("project_%v", c.ProjectID)).
-		Id(id)
+		Query(query).
+		FetchSource(false).
+		Size(1000)
+
+	if docType := c.dialect.documentType(); docType != "" {
+		scroll = scroll.Type(docType)
+	}
+
+	defer scroll.Clear(context.Background())
 
-	c.bulk.Add(req)
+	for {
+		result, err := scroll.Do(ctx)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("Couldn't list %s documents: %s", docType, err)
+		}
+
+		for _, hit := range result.Hits.Hits {
+			if err := f(hit.Id); err != nil {
+				return err
+			}
+		}
+	}
 }
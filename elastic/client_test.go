@@ -1,13 +1,17 @@
 package elastic_test
 
 import (
+	"bytes"
+	"context"
 	"crypto/tls"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"os"
 	"regexp"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -41,6 +45,8 @@ const credsFailRespTmpl = `{
 func initTestServer(expireOn string, failAssume bool) *httptest.Server {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
+		case "/latest/api/token":
+			fmt.Fprint(w, "imds-token")
 		case "/latest/meta-data/iam/security-credentials/":
 			fmt.Fprintln(w, "RoleName")
 		case "/latest/meta-data/iam/security-credentials/RoleName":
@@ -57,7 +63,97 @@ func initTestServer(expireOn string, failAssume bool) *httptest.Server {
 	return server
 }
 
+const stsRespTmpl = `<%[1]sResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <%[1]sResult>
+    <Credentials>
+      <AccessKeyId>%[2]s</AccessKeyId>
+      <SecretAccessKey>%[2]s_secret</SecretAccessKey>
+      <SessionToken>token</SessionToken>
+      <Expiration>%[3]s</Expiration>
+    </Credentials>
+  </%[1]sResult>
+</%[1]sResponse>`
+
+// awsEnv unsets every variable the AWS credential chain reads, so tests don't
+// depend on the environment they run in, then sets vars. The returned
+// function restores the environment.
+func awsEnv(vars map[string]string) func() {
+	names := []string{
+		"AWS_ACCESS_KEY_ID", "AWS_ACCESS_KEY", "AWS_SECRET_ACCESS_KEY", "AWS_SECRET_KEY", "AWS_SESSION_TOKEN",
+		"AWS_PROFILE", "AWS_SHARED_CREDENTIALS_FILE", "AWS_CONFIG_FILE",
+		"AWS_WEB_IDENTITY_TOKEN_FILE", "AWS_ROLE_ARN", "AWS_ROLE_SESSION_NAME", "AWS_EC2_METADATA_SERVICE_ENDPOINT",
+		"AWS_CONTAINER_CREDENTIALS_RELATIVE_URI", "AWS_CONTAINER_CREDENTIALS_FULL_URI",
+	}
+
+	saved := make(map[string]*string)
+	for _, name := range names {
+		if value, ok := os.LookupEnv(name); ok {
+			saved[name] = &value
+		} else {
+			saved[name] = nil
+		}
+
+		os.Unsetenv(name)
+	}
+
+	// Keep ~/.aws out of the way
+	os.Setenv("AWS_SHARED_CREDENTIALS_FILE", "/nonexistent/credentials")
+	os.Setenv("AWS_CONFIG_FILE", "/nonexistent/config")
+
+	for name, value := range vars {
+		os.Setenv(name, value)
+	}
+
+	return func() {
+		for name, value := range saved {
+			if value == nil {
+				os.Unsetenv(name)
+			} else {
+				os.Setenv(name, *value)
+			}
+		}
+	}
+}
+
+// initSTSServer answers STS requests with credentials whose access key is
+// the action, e.g. "AssumeRole", and records the last request
+func initSTSServer(t *testing.T, req **http.Request) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		*req = r
+
+		action := r.PostForm.Get("Action")
+		expiration := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+
+		w.Header().Set("Content-Type", "text/xml")
+		fmt.Fprintf(w, stsRespTmpl, action, action, expiration)
+	}))
+}
+
+func writeTempFile(t *testing.T, content string) string {
+	file, err := ioutil.TempFile("", "aws")
+	require.NoError(t, err)
+	defer file.Close()
+
+	_, err = file.WriteString(content)
+	require.NoError(t, err)
+
+	return file.Name()
+}
+
+func resolveAWSCredentials(t *testing.T, configJSON string, aws_config *aws.Config) (string, string) {
+	config, err := elastic.ReadConfig(strings.NewReader(configJSON))
+	require.NoError(t, err)
+
+	credsValue, err := elastic.ResolveAWSCredentials(config, aws_config).Get()
+	require.NoError(t, err)
+
+	return credsValue.AccessKeyID, credsValue.SecretAccessKey
+}
+
 func TestResolveAWSCredentialsStatic(t *testing.T) {
+	defer awsEnv(map[string]string{"AWS_ACCESS_KEY_ID": "env_access_key", "AWS_SECRET_ACCESS_KEY": "env_secret"})()
+
 	aws_config := &aws.Config{}
 	config, err := elastic.ReadConfig(strings.NewReader(
 		`{
@@ -75,7 +171,125 @@ func TestResolveAWSCredentialsStatic(t *testing.T) {
 	require.Equal(t, "static_secret_access_key", credsValue.SecretAccessKey, "Expect secret access key to match")
 }
 
+func TestResolveAWSCredentialsEnvironment(t *testing.T) {
+	defer awsEnv(map[string]string{"AWS_ACCESS_KEY_ID": "env_access_key", "AWS_SECRET_ACCESS_KEY": "env_secret"})()
+
+	accessKey, secretKey := resolveAWSCredentials(t, `{"aws":true,"aws_region":"us-east-1"}`, &aws.Config{})
+	require.Equal(t, "env_access_key", accessKey)
+	require.Equal(t, "env_secret", secretKey)
+}
+
+func TestResolveAWSCredentialsSharedCredentialsFile(t *testing.T) {
+	filename := writeTempFile(t, "[default]\naws_access_key_id = default_key\naws_secret_access_key = default_secret\n\n[custom]\naws_access_key_id = shared_key\naws_secret_access_key = shared_secret\n")
+	defer os.Remove(filename)
+	defer awsEnv(map[string]string{"AWS_SHARED_CREDENTIALS_FILE": filename})()
+
+	accessKey, secretKey := resolveAWSCredentials(t, `{"aws":true,"aws_region":"us-east-1","aws_profile":"custom"}`, &aws.Config{})
+	require.Equal(t, "shared_key", accessKey)
+	require.Equal(t, "shared_secret", secretKey)
+
+	accessKey, _ = resolveAWSCredentials(t, `{"aws":true,"aws_region":"us-east-1"}`, &aws.Config{})
+	require.Equal(t, "default_key", accessKey)
+}
+
+func TestResolveAWSCredentialsSharedConfigFile(t *testing.T) {
+	filename := writeTempFile(t, "[profile custom]\naws_access_key_id = config_key\naws_secret_access_key = config_secret\n")
+	defer os.Remove(filename)
+	defer awsEnv(map[string]string{"AWS_CONFIG_FILE": filename, "AWS_PROFILE": "custom"})()
+
+	accessKey, secretKey := resolveAWSCredentials(t, `{"aws":true,"aws_region":"us-east-1"}`, &aws.Config{})
+	require.Equal(t, "config_key", accessKey)
+	require.Equal(t, "config_secret", secretKey)
+}
+
+func TestResolveAWSCredentialsSourceProfile(t *testing.T) {
+	var req *http.Request
+	server := initSTSServer(t, &req)
+	defer server.Close()
+
+	filename := writeTempFile(t, "[profile base]\naws_access_key_id = base_key\naws_secret_access_key = base_secret\n\n[profile chained]\nrole_arn = arn:aws:iam::123456789012:role/indexer\nsource_profile = base\n")
+	defer os.Remove(filename)
+	defer awsEnv(map[string]string{"AWS_CONFIG_FILE": filename})()
+
+	accessKey, _ := resolveAWSCredentials(t, `{"aws":true,"aws_region":"us-east-1","aws_profile":"chained","aws_sts_endpoint":"`+server.URL+`"}`, &aws.Config{})
+	require.Equal(t, "AssumeRole", accessKey)
+
+	require.Equal(t, "arn:aws:iam::123456789012:role/indexer", req.PostForm.Get("RoleArn"))
+	require.Contains(t, req.Header.Get("Authorization"), "Credential=base_key/")
+}
+
+func TestResolveAWSCredentialsProcess(t *testing.T) {
+	script := writeTempFile(t, `echo '{"Version":1,"AccessKeyId":"process_key","SecretAccessKey":"process_secret"}'`)
+	defer os.Remove(script)
+
+	filename := writeTempFile(t, "[default]\ncredential_process = sh "+script+"\n")
+	defer os.Remove(filename)
+	defer awsEnv(map[string]string{"AWS_CONFIG_FILE": filename})()
+
+	accessKey, secretKey := resolveAWSCredentials(t, `{"aws":true,"aws_region":"us-east-1"}`, &aws.Config{})
+	require.Equal(t, "process_key", accessKey)
+	require.Equal(t, "process_secret", secretKey)
+}
+
+func TestResolveAWSCredentialsWebIdentity(t *testing.T) {
+	var req *http.Request
+	server := initSTSServer(t, &req)
+	defer server.Close()
+
+	tokenFile := writeTempFile(t, "web-identity-token")
+	defer os.Remove(tokenFile)
+	defer awsEnv(map[string]string{
+		"AWS_WEB_IDENTITY_TOKEN_FILE": tokenFile,
+		"AWS_ROLE_ARN":                "arn:aws:iam::123456789012:role/indexer",
+	})()
+
+	accessKey, secretKey := resolveAWSCredentials(t, `{"aws":true,"aws_region":"us-east-1","aws_sts_endpoint":"`+server.URL+`"}`, &aws.Config{})
+	require.Equal(t, "AssumeRoleWithWebIdentity", accessKey)
+	require.Equal(t, "AssumeRoleWithWebIdentity_secret", secretKey)
+
+	require.Equal(t, "web-identity-token", req.PostForm.Get("WebIdentityToken"))
+	require.Equal(t, "arn:aws:iam::123456789012:role/indexer", req.PostForm.Get("RoleArn"))
+}
+
+func TestResolveAWSCredentialsECSTaskRole(t *testing.T) {
+	server := initTestServer("2100-01-01T00:00:00Z", false)
+	defer server.Close()
+
+	defer awsEnv(map[string]string{
+		"AWS_CONTAINER_CREDENTIALS_FULL_URI": server.URL + "/latest/meta-data/iam/security-credentials/RoleName",
+	})()
+
+	accessKey, secretKey := resolveAWSCredentials(t, `{"aws":true,"aws_region":"us-east-1"}`, &aws.Config{})
+	require.Equal(t, "accessKey", accessKey)
+	require.Equal(t, "secret", secretKey)
+}
+
+func TestResolveAWSCredentialsAssumeRole(t *testing.T) {
+	var req *http.Request
+	server := initSTSServer(t, &req)
+	defer server.Close()
+
+	defer awsEnv(nil)()
+
+	accessKey, secretKey := resolveAWSCredentials(t, `{
+		"aws":true,
+		"aws_region":"us-east-1",
+		"aws_access_key":"static_access_key",
+		"aws_secret_access_key":"static_secret_access_key",
+		"aws_role_arn":"arn:aws:iam::123456789012:role/indexer",
+		"aws_sts_endpoint":"`+server.URL+`"
+	}`, &aws.Config{})
+
+	require.Equal(t, "AssumeRole", accessKey)
+	require.Equal(t, "AssumeRole_secret", secretKey)
+
+	require.Equal(t, "arn:aws:iam::123456789012:role/indexer", req.PostForm.Get("RoleArn"))
+	require.Contains(t, req.Header.Get("Authorization"), "Credential=static_access_key/")
+}
+
 func TestResolveAWSCredentialsEc2RoleProfile(t *testing.T) {
+	defer awsEnv(nil)()
+
 	server := initTestServer("2014-12-16T01:51:37Z", false)
 	defer server.Close()
 
@@ -139,6 +353,375 @@ func TestAWSConfiguration(t *testing.T) {
 	require.NotEqual(t, "", req.Header.Get("X-Amz-Date"))
 }
 
+func TestCancelledContextAbandonsBulkOperations(t *testing.T) {
+	var bulkRequests int
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/_bulk") {
+			bulkRequests++
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+
+	config, err := elastic.ReadConfig(strings.NewReader(`{"url":["` + srv.URL + `"]}`))
+	require.NoError(t, err)
+	config.ProjectID = projectID
+
+	client, err := elastic.NewClient(config)
+	require.NoError(t, err)
+	defer client.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	client.Index(ctx, projectIDString+"_foo", map[string]interface{}{})
+	client.Remove(ctx, projectIDString+"_bar")
+
+	require.Equal(t, context.Canceled, client.Flush(ctx))
+	require.NoError(t, client.Flush(context.Background()))
+	require.Equal(t, 0, bulkRequests)
+}
+
+func TestBulkResponsesConfirmDocuments(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if !strings.HasSuffix(r.URL.Path, "/_bulk") {
+			w.Write([]byte(`{}`))
+			return
+		}
+
+		w.Write([]byte(`{"errors":true,"items":[
+			{"index":{"_id":"` + projectIDString + `_foo","status":201}},
+			{"delete":{"_id":"` + projectIDString + `_bar","status":404}},
+			{"index":{"_id":"` + projectIDString + `_baz","status":400,"error":{"type":"mapper_parsing_exception"}}}
+		]}`))
+	}))
+	defer srv.Close()
+
+	config, err := elastic.ReadConfig(strings.NewReader(`{"url":["` + srv.URL + `"],"max_bulk_concurrency":1}`))
+	require.NoError(t, err)
+	config.ProjectID = projectID
+
+	client, err := elastic.NewClient(config)
+	require.NoError(t, err)
+	defer client.Close()
+
+	var confirmed []string
+	client.NotifyConfirmed(func(ids []string) { confirmed = append(confirmed, ids...) })
+
+	client.Index(context.Background(), projectIDString+"_foo", map[string]interface{}{})
+	client.Remove(context.Background(), projectIDString+"_bar")
+	client.Index(context.Background(), projectIDString+"_baz", map[string]interface{}{})
+
+	require.Error(t, client.Flush(context.Background()))
+	require.Equal(t, []string{projectIDString + "_foo", projectIDString + "_bar"}, confirmed)
+}
+
+func TestBulkFailuresAreReported(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if !strings.HasSuffix(r.URL.Path, "/_bulk") {
+			w.Write([]byte(`{}`))
+			return
+		}
+
+		w.Write([]byte(`{"errors":true,"items":[
+			{"index":{"_id":"` + projectIDString + `_foo","status":201}},
+			{"index":{"_id":"` + projectIDString + `_baz","status":400,"error":{"type":"strict_dynamic_mapping_exception","reason":"mapping set to strict"}}},
+			{"delete":{"_id":"` + projectIDString + `_bar","status":500,"error":{"type":"exception","reason":"boom"}}}
+		]}`))
+	}))
+	defer srv.Close()
+
+	config, err := elastic.ReadConfig(strings.NewReader(`{"url":["` + srv.URL + `"],"index_name":"gitlab-test","max_bulk_concurrency":1}`))
+	require.NoError(t, err)
+	config.ProjectID = projectID
+
+	client, err := elastic.NewClient(config)
+	require.NoError(t, err)
+	defer client.Close()
+
+	var deadLetter bytes.Buffer
+	client.SetDeadLetter(&deadLetter)
+
+	client.Index(context.Background(), projectIDString+"_foo", map[string]interface{}{})
+	client.Index(context.Background(), projectIDString+"_baz", map[string]interface{}{"invalid": true})
+	client.Remove(context.Background(), projectIDString+"_bar")
+
+	require.Error(t, client.Flush(context.Background()))
+
+	require.Equal(t, elastic.BulkSummary{
+		Succeeded: 1,
+		Failed:    2,
+		Failures: []elastic.BulkFailure{
+			{ID: projectIDString + "_baz", Op: "index", Status: 400, Type: "strict_dynamic_mapping_exception", Reason: "mapping set to strict", ExecutionID: 1},
+			{ID: projectIDString + "_bar", Op: "delete", Status: 500, Type: "exception", Reason: "boom", ExecutionID: 1},
+		},
+	}, client.Summary())
+
+	require.Equal(t, `{"index":{"_index":"gitlab-test","_id":"667_baz","_type":"doc","routing":"project_667"}}
+{"invalid":true}
+{"delete":{"_index":"gitlab-test","_type":"doc","_id":"667_bar","routing":"project_667"}}
+`, deadLetter.String())
+}
+
+func TestDeletingMissingDocumentsSucceeds(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if !strings.HasSuffix(r.URL.Path, "/_bulk") {
+			w.Write([]byte(`{}`))
+			return
+		}
+
+		w.Write([]byte(`{"errors":true,"items":[
+			{"delete":{"_id":"` + projectIDString + `_bar","status":404,"result":"not_found"}},
+			{"index":{"_id":"` + projectIDString + `_baz","status":404,"error":{"type":"index_not_found_exception","reason":"no such index"}}}
+		]}`))
+	}))
+	defer srv.Close()
+
+	config, err := elastic.ReadConfig(strings.NewReader(`{"url":["` + srv.URL + `"],"index_name":"gitlab-test","max_bulk_concurrency":1}`))
+	require.NoError(t, err)
+	config.ProjectID = projectID
+
+	client, err := elastic.NewClient(config)
+	require.NoError(t, err)
+	defer client.Close()
+
+	var deadLetter bytes.Buffer
+	client.SetDeadLetter(&deadLetter)
+
+	client.Remove(context.Background(), projectIDString+"_bar")
+	client.Index(context.Background(), projectIDString+"_baz", map[string]interface{}{})
+
+	require.Error(t, client.Flush(context.Background()))
+
+	// The document is gone either way, so only the index is a failure
+	summary := client.Summary()
+	require.Equal(t, 1, summary.Succeeded)
+	require.Equal(t, 1, summary.Failed)
+	require.Equal(t, "index", summary.Failures[0].Op)
+	require.NotContains(t, deadLetter.String(), `"delete"`)
+}
+
+func TestStaleWritesAreSkipped(t *testing.T) {
+	var body string
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if !strings.HasSuffix(r.URL.Path, "/_bulk") {
+			w.Write([]byte(`{}`))
+			return
+		}
+
+		data, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		body = string(data)
+
+		w.Write([]byte(`{"errors":true,"items":[
+			{"index":{"_id":"` + projectIDString + `_foo","status":201}},
+			{"index":{"_id":"` + projectIDString + `_baz","status":409,"error":{"type":"version_conflict_engine_exception","reason":"version conflict, current version [200] is higher than the one provided [100]"}}},
+			{"delete":{"_id":"` + projectIDString + `_bar","status":409,"error":{"type":"version_conflict_engine_exception","reason":"version conflict"}}}
+		]}`))
+	}))
+	defer srv.Close()
+
+	config, err := elastic.ReadConfig(strings.NewReader(`{"url":["` + srv.URL + `"],"index_name":"gitlab-test","max_bulk_concurrency":1}`))
+	require.NoError(t, err)
+	config.ProjectID = projectID
+
+	client, err := elastic.NewClient(config)
+	require.NoError(t, err)
+	defer client.Close()
+
+	var confirmed []string
+	client.NotifyConfirmed(func(ids []string) { confirmed = append(confirmed, ids...) })
+	client.SetDocumentVersion(100)
+
+	client.Index(context.Background(), projectIDString+"_foo", map[string]interface{}{})
+	client.Index(context.Background(), projectIDString+"_baz", map[string]interface{}{})
+	client.Remove(context.Background(), projectIDString+"_bar")
+
+	require.NoError(t, client.Flush(context.Background()))
+
+	require.Equal(t, elastic.BulkSummary{Succeeded: 1, Skipped: 2}, client.Summary())
+	require.ElementsMatch(t, []string{projectIDString + "_foo", projectIDString + "_baz", projectIDString + "_bar"}, confirmed)
+
+	require.Contains(t, body, `{"index":{"_index":"gitlab-test","_id":"667_foo","_type":"doc","routing":"project_667","version":100,"version_type":"external_gte"}}`)
+	require.Contains(t, body, `{"delete":{"_index":"gitlab-test","_type":"doc","_id":"667_bar","routing":"project_667","version":100,"version_type":"external_gte"}}`)
+}
+
+// retryServer rejects the _baz document with a 429 for the given number of
+// bulk requests, and records the bodies of the bulk requests
+func retryServer(t *testing.T, rejections int) (*elastic.Client, *[]string, func()) {
+	var bodies []string
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if !strings.HasSuffix(r.URL.Path, "/_bulk") {
+			w.Write([]byte(`{}`))
+			return
+		}
+
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		bodies = append(bodies, string(body))
+
+		bazStatus := `201`
+		if len(bodies) <= rejections {
+			bazStatus = `429,"error":{"type":"es_rejected_execution_exception","reason":"rejected execution"}`
+		}
+
+		if len(bodies) == 1 {
+			w.Write([]byte(`{"errors":true,"items":[
+				{"index":{"_id":"` + projectIDString + `_foo","status":201}},
+				{"index":{"_id":"` + projectIDString + `_baz","status":` + bazStatus + `}}
+			]}`))
+		} else {
+			w.Write([]byte(`{"errors":true,"items":[{"index":{"_id":"` + projectIDString + `_baz","status":` + bazStatus + `}}]}`))
+		}
+	}))
+
+	config, err := elastic.ReadConfig(strings.NewReader(`{"url":["` + srv.URL + `"],"max_bulk_concurrency":1,"retry_initial_delay_ms":1,"retry_max_delay_ms":2,"max_retries":2}`))
+	require.NoError(t, err)
+	config.ProjectID = projectID
+
+	client, err := elastic.NewClient(config)
+	require.NoError(t, err)
+
+	client.Index(context.Background(), projectIDString+"_foo", map[string]interface{}{})
+	client.Index(context.Background(), projectIDString+"_baz", map[string]interface{}{})
+
+	return client, &bodies, func() {
+		client.Close()
+		srv.Close()
+	}
+}
+
+func TestRejectedItemsAreRetried(t *testing.T) {
+	client, bodies, cleanup := retryServer(t, 2)
+	defer cleanup()
+
+	var confirmed []string
+	client.NotifyConfirmed(func(ids []string) { confirmed = append(confirmed, ids...) })
+
+	require.NoError(t, client.Flush(context.Background()))
+
+	require.Len(t, *bodies, 3)
+	for _, retried := range (*bodies)[1:] {
+		require.NotContains(t, retried, projectIDString+"_foo")
+		require.Contains(t, retried, projectIDString+"_baz")
+	}
+
+	require.Equal(t, []string{projectIDString + "_foo", projectIDString + "_baz"}, confirmed)
+	require.Equal(t, elastic.BulkSummary{Succeeded: 2, Retried: 1}, client.Summary())
+}
+
+func TestRetriesGiveUp(t *testing.T) {
+	client, bodies, cleanup := retryServer(t, 3)
+	defer cleanup()
+
+	require.Error(t, client.Flush(context.Background()))
+
+	require.Len(t, *bodies, 3)
+	require.Equal(t, elastic.BulkSummary{
+		Succeeded: 1,
+		Failed:    1,
+		Failures: []elastic.BulkFailure{
+			{ID: projectIDString + "_baz", Op: "index", Status: 429, Type: "es_rejected_execution_exception", Reason: "rejected execution", ExecutionID: 1},
+		},
+	}, client.Summary())
+}
+
+func TestRetriesCanBeDisabled(t *testing.T) {
+	var requests int
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if !strings.HasSuffix(r.URL.Path, "/_bulk") {
+			w.Write([]byte(`{}`))
+			return
+		}
+
+		requests++
+		w.Write([]byte(`{"errors":true,"items":[{"index":{"_id":"` + projectIDString + `_baz","status":429}}]}`))
+	}))
+	defer srv.Close()
+
+	config, err := elastic.ReadConfig(strings.NewReader(`{"url":["` + srv.URL + `"],"max_bulk_concurrency":1,"max_retries":0}`))
+	require.NoError(t, err)
+	config.ProjectID = projectID
+
+	client, err := elastic.NewClient(config)
+	require.NoError(t, err)
+	defer client.Close()
+
+	client.Index(context.Background(), projectIDString+"_baz", map[string]interface{}{})
+
+	require.Error(t, client.Flush(context.Background()))
+	require.Equal(t, 1, requests)
+}
+
+func TestRetriesDontBlockBulkWorkers(t *testing.T) {
+	var mu sync.Mutex
+	var ids []string
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if !strings.HasSuffix(r.URL.Path, "/_bulk") {
+			w.Write([]byte(`{}`))
+			return
+		}
+
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		id := projectIDString + "_foo"
+		if strings.Contains(string(body), projectIDString+"_baz") {
+			id = projectIDString + "_baz"
+		}
+		ids = append(ids, id)
+
+		status := `201`
+		if len(ids) == 1 {
+			status = `429,"error":{"type":"es_rejected_execution_exception","reason":"rejected execution"}`
+		}
+
+		w.Write([]byte(`{"errors":true,"items":[{"index":{"_id":"` + id + `","status":` + status + `}}]}`))
+	}))
+	defer srv.Close()
+
+	// Every document is sent on its own by the only worker, and _baz is only
+	// retried after 200ms
+	config, err := elastic.ReadConfig(strings.NewReader(`{"url":["` + srv.URL + `"],"max_bulk_size_bytes":1,"max_bulk_concurrency":1,"retry_initial_delay_ms":200}`))
+	require.NoError(t, err)
+	config.ProjectID = projectID
+
+	client, err := elastic.NewClient(config)
+	require.NoError(t, err)
+	defer client.Close()
+
+	client.Index(context.Background(), projectIDString+"_baz", map[string]interface{}{})
+	client.Index(context.Background(), projectIDString+"_foo", map[string]interface{}{})
+
+	require.NoError(t, client.Flush(context.Background()))
+	require.Equal(t, []string{projectIDString + "_baz", projectIDString + "_foo", projectIDString + "_baz"}, ids)
+	require.Equal(t, elastic.BulkSummary{Succeeded: 2, Retried: 1}, client.Summary())
+}
+
 func setupTestClient(t *testing.T) *elastic.Client {
 	config := os.Getenv("ELASTIC_CONNECTION_INFO")
 	if config == "" {
@@ -167,12 +750,12 @@ func TestElasticClientIndexAndRetrieval(t *testing.T) {
 	client := setupTestClientAndCreateIndex(t)
 
 	blobDoc := map[string]interface{}{}
-	client.Index(projectIDString+"_foo", blobDoc)
+	client.Index(context.Background(), projectIDString+"_foo", blobDoc)
 
 	commitDoc := map[string]interface{}{}
-	client.Index(projectIDString+"_0000", commitDoc)
+	client.Index(context.Background(), projectIDString+"_0000", commitDoc)
 
-	require.NoError(t, client.Flush())
+	require.NoError(t, client.Flush(context.Background()))
 
 	blob, err := client.GetBlob("foo")
 	require.NoError(t, err)
@@ -182,8 +765,8 @@ func TestElasticClientIndexAndRetrieval(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, true, commit.Found)
 
-	client.Remove(projectIDString + "_foo")
-	require.NoError(t, client.Flush())
+	client.Remove(context.Background(), projectIDString+"_foo")
+	require.NoError(t, client.Flush(context.Background()))
 
 	_, err = client.GetBlob("foo")
 	require.Error(t, err)
@@ -191,8 +774,44 @@ func TestElasticClientIndexAndRetrieval(t *testing.T) {
 	// indexing a doc with unexpected field will cause an ES strict_dynamic_mapping_exception
 	// for our IndexMapping
 	blobDocInvalid := map[string]interface{}{fmt.Sprintf("invalid-key-%d", time.Now().Unix()): ""}
-	client.Index(projectIDString+"_invalid", blobDocInvalid)
-	require.Error(t, client.Flush())
+	client.Index(context.Background(), projectIDString+"_invalid", blobDocInvalid)
+	require.Error(t, client.Flush(context.Background()))
+
+	require.NoError(t, client.DeleteIndex())
+}
+
+func TestElasticClientEachDocumentID(t *testing.T) {
+	client := setupTestClientAndCreateIndex(t)
+
+	blobDoc := func(docType, rid string) map[string]interface{} {
+		return map[string]interface{}{
+			"type":       docType,
+			"blob":       map[string]interface{}{"type": docType, "rid": rid},
+			"join_field": map[string]interface{}{"name": docType, "parent": "project_" + projectIDString},
+		}
+	}
+
+	client.Index(context.Background(), projectIDString+"_foo", blobDoc("blob", projectIDString))
+	client.Index(context.Background(), projectIDString+"_bar", blobDoc("blob", projectIDString))
+	client.Index(context.Background(), projectIDString+"_home.md", blobDoc("wiki_blob", "wiki_"+projectIDString))
+	client.Index(context.Background(), "1_foo", blobDoc("blob", "1"))
+	require.NoError(t, client.Flush(context.Background()))
+
+	_, err := client.Client.Refresh(client.IndexName).Do(context.Background())
+	require.NoError(t, err)
+
+	var ids []string
+	collect := func(id string) error {
+		ids = append(ids, id)
+		return nil
+	}
+
+	require.NoError(t, client.EachDocumentID(context.Background(), "blob", projectIDString, collect))
+	require.ElementsMatch(t, []string{projectIDString + "_foo", projectIDString + "_bar"}, ids)
+
+	ids = nil
+	require.NoError(t, client.EachDocumentID(context.Background(), "wiki_blob", "wiki_"+projectIDString, collect))
+	require.Equal(t, []string{projectIDString + "_home.md"}, ids)
 
 	require.NoError(t, client.DeleteIndex())
 }
@@ -204,9 +823,9 @@ func TestFlushErrorWithESActionRequestValidationException(t *testing.T) {
 	// so that the `err` param passed to `afterFunc` is not nil
 	client.IndexName = ""
 	blobDoc := map[string]interface{}{}
-	client.Index(projectIDString+"_foo", blobDoc)
+	client.Index(context.Background(), projectIDString+"_foo", blobDoc)
 
-	require.Error(t, client.Flush())
+	require.Error(t, client.Flush(context.Background()))
 }
 
 func TestElasticReadConfig(t *testing.T) {
@@ -222,6 +841,9 @@ func TestElasticReadConfig(t *testing.T) {
 	require.Equal(t, []string{"http://elasticsearch:9200"}, config.URL)
 	require.Equal(t, elastic.DefaultMaxBulkSize, config.MaxBulkSize)
 	require.Equal(t, elastic.DefaultBulkWorkers, config.BulkWorkers)
+	require.Equal(t, elastic.DefaultRetryInitialDelay, config.RetryInitialDelay)
+	require.Equal(t, elastic.DefaultRetryMaxDelay, config.RetryMaxDelay)
+	require.Equal(t, elastic.DefaultMaxRetries, *config.MaxRetries)
 }
 
 func TestElasticReadConfigCustomBulkSettings(t *testing.T) {
@@ -237,3 +859,25 @@ func TestElasticReadConfigCustomBulkSettings(t *testing.T) {
 	require.Equal(t, 6, config.BulkWorkers)
 
 }
+
+func TestElasticReadConfigCustomRetrySettings(t *testing.T) {
+	config, err := elastic.ReadConfig(strings.NewReader(
+		`{
+			"retry_initial_delay_ms": 50,
+			"retry_max_delay_ms": 1000,
+			"max_retries": -1
+		}`,
+	))
+	require.NoError(t, err)
+
+	require.Equal(t, 50, config.RetryInitialDelay)
+	require.Equal(t, 1000, config.RetryMaxDelay)
+	require.Equal(t, -1, *config.MaxRetries)
+}
+
+func TestElasticReadConfigZeroMaxRetries(t *testing.T) {
+	config, err := elastic.ReadConfig(strings.NewReader(`{"max_retries": 0}`))
+	require.NoError(t, err)
+
+	require.Equal(t, 0, *config.MaxRetries)
+}
@@ -0,0 +1,138 @@
+package elastic
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/olivere/elastic"
+)
+
+// serverVersion is what a cluster reports about itself at its root endpoint
+type serverVersion struct {
+	Distribution string
+	Number       string
+}
+
+func (v serverVersion) major() int {
+	major, _ := strconv.Atoi(strings.SplitN(v.Number, ".", 2)[0])
+
+	return major
+}
+
+func (v serverVersion) String() string {
+	if v.Number == "" {
+		return v.Distribution + " (unknown version)"
+	}
+
+	return v.Distribution + " " + v.Number
+}
+
+// detectServer asks the cluster for its version. Elasticsearch doesn't report
+// a distribution, while OpenSearch does.
+func detectServer(client *elastic.Client) (serverVersion, error) {
+	res, err := client.PerformRequest(context.Background(), elastic.PerformRequestOptions{
+		Method: "GET",
+		Path:   "/",
+	})
+	if err != nil {
+		return serverVersion{}, fmt.Errorf("Couldn't detect the server version: %s", err)
+	}
+
+	var root struct {
+		Version struct {
+			Distribution string `json:"distribution"`
+			Number       string `json:"number"`
+		} `json:"version"`
+	}
+
+	if err := json.Unmarshal(res.Body, &root); err != nil {
+		return serverVersion{}, fmt.Errorf("Couldn't detect the server version: %s", err)
+	}
+
+	version := serverVersion{Distribution: root.Version.Distribution, Number: root.Version.Number}
+	if version.Distribution == "" {
+		version.Distribution = "elasticsearch"
+	}
+
+	return version, nil
+}
+
+// dialect isolates the requests that differ between server generations, so
+// supporting a new one only needs a new implementation
+type dialect interface {
+	// documentType is the mapping type of bulk operations and searches, or
+	// empty if the server has no mapping types
+	documentType() string
+
+	// endpointType is the type in document endpoints, /<index>/<type>/<id>
+	endpointType() string
+
+	// indexBody turns the body of a create index request with typed
+	// mappings, like IndexMapping, into one the server accepts
+	indexBody(typed string) (string, error)
+}
+
+// dialectFor picks the dialect of a server. Versions that can't be parsed are
+// treated like Elasticsearch 6, which this indexer was written for.
+func dialectFor(version serverVersion) dialect {
+	if version.Distribution == "opensearch" || version.major() >= 7 {
+		return typelessDialect{}
+	}
+
+	return typedDialect{}
+}
+
+// typedDialect speaks to Elasticsearch 6, where documents have the "doc"
+// mapping type
+type typedDialect struct{}
+
+func (typedDialect) documentType() string {
+	return "doc"
+}
+
+func (typedDialect) endpointType() string {
+	return "doc"
+}
+
+func (typedDialect) indexBody(typed string) (string, error) {
+	return typed, nil
+}
+
+// typelessDialect speaks to Elasticsearch 7 and later, and OpenSearch, which
+// don't have mapping types
+type typelessDialect struct{}
+
+func (typelessDialect) documentType() string {
+	return ""
+}
+
+func (typelessDialect) endpointType() string {
+	return "_doc"
+}
+
+// indexBody moves the mapping of the "doc" type up to the mappings
+func (typelessDialect) indexBody(typed string) (string, error) {
+	var body map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(typed), &body); err != nil {
+		return "", err
+	}
+
+	var mappings map[string]json.RawMessage
+	if err := json.Unmarshal(body["mappings"], &mappings); err != nil {
+		return "", err
+	}
+
+	if doc, ok := mappings["doc"]; ok {
+		body["mappings"] = doc
+	}
+
+	out, err := json.Marshal(body)
+	if err != nil {
+		return "", err
+	}
+
+	return string(out), nil
+}
@@ -0,0 +1,128 @@
+package elastic_test
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/gitlab-elasticsearch-indexer/elastic"
+)
+
+type serverRequests struct {
+	bulk        string
+	getPath     string
+	createIndex map[string]interface{}
+}
+
+// versionServer pretends to be a cluster reporting the given root response
+func versionServer(t *testing.T, root string) (*httptest.Server, *serverRequests) {
+	requests := &serverRequests{}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+
+		switch {
+		case r.URL.Path == "/":
+			w.Write([]byte(root))
+			return
+		case strings.HasSuffix(r.URL.Path, "/_bulk"):
+			requests.bulk = string(body)
+		case r.Method == "GET":
+			requests.getPath = r.URL.Path
+			w.Write([]byte(`{"found":true}`))
+			return
+		case r.Method == "PUT":
+			require.NoError(t, json.Unmarshal(body, &requests.createIndex))
+			w.Write([]byte(`{"acknowledged":true}`))
+			return
+		}
+
+		w.Write([]byte(`{}`))
+	}))
+
+	return srv, requests
+}
+
+func TestServerGenerations(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		root     string
+		version  string
+		typeless bool
+	}{
+		{"unknown", `{}`, "elasticsearch (unknown version)", false},
+		{"elasticsearch 6", `{"version":{"number":"6.8.23"}}`, "elasticsearch 6.8.23", false},
+		{"elasticsearch 7", `{"version":{"number":"7.17.9"}}`, "elasticsearch 7.17.9", true},
+		{"elasticsearch 8", `{"version":{"number":"8.11.0","build_flavor":"default"}}`, "elasticsearch 8.11.0", true},
+		{"opensearch 1", `{"version":{"distribution":"opensearch","number":"1.3.14"}}`, "opensearch 1.3.14", true},
+		{"opensearch 2", `{"version":{"distribution":"opensearch","number":"2.11.0"}}`, "opensearch 2.11.0", true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			srv, requests := versionServer(t, tc.root)
+			defer srv.Close()
+
+			config, err := elastic.ReadConfig(strings.NewReader(`{"url":["` + srv.URL + `"],"index_name":"gitlab-test","max_bulk_concurrency":1}`))
+			require.NoError(t, err)
+			config.ProjectID = projectID
+
+			client, err := elastic.NewClient(config)
+			require.NoError(t, err)
+			defer client.Close()
+
+			require.Equal(t, tc.version, client.ServerVersion())
+
+			client.Index(context.Background(), projectIDString+"_foo", map[string]interface{}{})
+			client.Remove(context.Background(), projectIDString+"_bar")
+			require.NoError(t, client.Flush(context.Background()))
+
+			_, err = client.GetBlob("foo")
+			require.NoError(t, err)
+
+			require.NoError(t, client.CreateWorkingIndex())
+			mappings := requests.createIndex["mappings"].(map[string]interface{})
+
+			if tc.typeless {
+				require.NotContains(t, requests.bulk, `"_type"`)
+				require.Equal(t, "/gitlab-test/_doc/667_foo", requests.getPath)
+				require.Equal(t, "strict", mappings["dynamic"])
+				require.NotContains(t, mappings, "doc")
+			} else {
+				require.Contains(t, requests.bulk, `"_type":"doc"`)
+				require.Equal(t, "/gitlab-test/doc/667_foo", requests.getPath)
+				require.Contains(t, mappings, "doc")
+			}
+		})
+	}
+}
+
+func TestReplayAdaptsMappingType(t *testing.T) {
+	srv, requests := versionServer(t, `{"version":{"number":"8.11.0"}}`)
+	defer srv.Close()
+
+	config, err := elastic.ReadConfig(strings.NewReader(`{"url":["` + srv.URL + `"],"index_name":"gitlab-test"}`))
+	require.NoError(t, err)
+
+	client, err := elastic.NewClient(config)
+	require.NoError(t, err)
+	defer client.Close()
+
+	ndjson := `{"index":{"_index":"gitlab-test","_id":"667_foo","_type":"doc","routing":"project_667"}}
+{"type":"blob"}
+`
+
+	require.NoError(t, client.Replay(context.Background(), strings.NewReader(ndjson)))
+	require.NoError(t, client.Flush(context.Background()))
+
+	require.Equal(t, `{"index":{"_index":"gitlab-test","_id":"667_foo","routing":"project_667"}}
+{"type":"blob"}
+`, requests.bulk)
+}
@@ -10,6 +10,12 @@ const (
 	// increases round trips in larger or non-AWS clusters
 	DefaultMaxBulkSize = 10 * 1024 * 1024
 	DefaultBulkWorkers = 10
+
+	// Bulk requests and rejected items are retried after 200ms, 400ms, 800ms,
+	// 1.6s and 3.2s by default
+	DefaultRetryInitialDelay = 200
+	DefaultRetryMaxDelay     = 10 * 1000
+	DefaultMaxRetries        = 5
 )
 
 type Config struct {
@@ -20,8 +26,35 @@ type Config struct {
 	Region      string   `json:"aws_region"`
 	AccessKey   string   `json:"aws_access_key"`
 	SecretKey   string   `json:"aws_secret_access_key"`
-	MaxBulkSize int      `json:"max_bulk_size_bytes"`
-	BulkWorkers int      `json:"max_bulk_concurrency"`
+	Profile     string   `json:"aws_profile"`
+	RoleARN     string   `json:"aws_role_arn"`
+	STSEndpoint string   `json:"aws_sts_endpoint"`
+
+	// Only one of basic auth, an API key or a bearer token can be used. The
+	// API key is the base64 encoded "id:api_key".
+	Username    string `json:"username"`
+	Password    string `json:"password"`
+	APIKey      string `json:"api_key"`
+	BearerToken string `json:"bearer_token"`
+
+	// Paths of PEM files
+	CACert             string `json:"ca_cert"`
+	ClientCert         string `json:"client_cert"`
+	ClientKey          string `json:"client_key"`
+	InsecureSkipVerify bool   `json:"insecure_skip_verify"`
+
+	// Aliases makes IndexName the read alias, and writes go to the write
+	// alias, see WriteAlias
+	Aliases bool `json:"aliases"`
+
+	MaxBulkSize int `json:"max_bulk_size_bytes"`
+	BulkWorkers int `json:"max_bulk_concurrency"`
+
+	// Delays are in milliseconds. MaxRetries is DefaultMaxRetries if it is
+	// nil, and retries are disabled if it is 0 or negative.
+	RetryInitialDelay int  `json:"retry_initial_delay_ms"`
+	RetryMaxDelay     int  `json:"retry_max_delay_ms"`
+	MaxRetries        *int `json:"max_retries"`
 }
 
 func ReadConfig(r io.Reader) (*Config, error) {
@@ -39,5 +72,18 @@ func ReadConfig(r io.Reader) (*Config, error) {
 		out.BulkWorkers = DefaultBulkWorkers
 	}
 
+	if out.RetryInitialDelay == 0 {
+		out.RetryInitialDelay = DefaultRetryInitialDelay
+	}
+
+	if out.RetryMaxDelay == 0 {
+		out.RetryMaxDelay = DefaultRetryMaxDelay
+	}
+
+	if out.MaxRetries == nil {
+		maxRetries := DefaultMaxRetries
+		out.MaxRetries = &maxRetries
+	}
+
 	return &out, nil
 }
@@ -0,0 +1,126 @@
+package elastic
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/olivere/elastic"
+)
+
+// BulkFailure describes a bulk operation that Elasticsearch rejected
+type BulkFailure struct {
+	ID     string `json:"id"`
+	Op     string `json:"op"`
+	Status int    `json:"status"`
+	Type   string `json:"error_type,omitempty"`
+	Reason string `json:"reason"`
+
+	// ExecutionID is the bulk request the operation was part of
+	ExecutionID int64 `json:"bulk_execution_id"`
+}
+
+// BulkSummary counts the bulk operations committed so far. Retried counts the
+// operations that only succeeded after being retried, which are included in
+// Succeeded. Skipped counts the operations rejected because the document has
+// a higher version, see Client.SetDocumentVersion.
+type BulkSummary struct {
+	Succeeded int
+	Retried   int
+	Skipped   int
+	Failed    int
+	Failures  []BulkFailure
+}
+
+func itemSucceeded(op string, result *elastic.BulkResponseItem) bool {
+	if result.Status >= 200 && result.Status <= 299 {
+		return true
+	}
+
+	// Removing a document that doesn't exist is fine: it is gone either way.
+	return op == "delete" && result.Status == http.StatusNotFound
+}
+
+// staleWrite reports whether an operation with external versioning was
+// rejected because the document has a higher version
+func staleWrite(result *elastic.BulkResponseItem) bool {
+	return result.Status == http.StatusConflict &&
+		(result.Error == nil || result.Error.Type == "version_conflict_engine_exception")
+}
+
+// requestAction returns the operation and document ID of a bulk request
+func requestAction(req elastic.BulkableRequest) (string, string) {
+	lines, err := req.Source()
+	if err != nil || len(lines) == 0 {
+		return "", ""
+	}
+
+	var action map[string]bulkMetadata
+	if err := json.Unmarshal([]byte(lines[0]), &action); err != nil {
+		return "", ""
+	}
+
+	for op, meta := range action {
+		return op, meta.ID
+	}
+
+	return "", ""
+}
+
+// SetDeadLetter makes the client write the requests of failed operations to
+// w, in the `_bulk` NDJSON format, so they can be retried with Replay. It
+// must be called before anything is indexed.
+func (c *Client) SetDeadLetter(w io.Writer) {
+	c.deadLetter = bufio.NewWriter(w)
+}
+
+// writeDeadLetter must be called with mu held
+func (c *Client) writeDeadLetter(req elastic.BulkableRequest) {
+	if c.deadLetter == nil || c.deadLetterErr != nil || req == nil {
+		return
+	}
+
+	lines, err := req.Source()
+	if err != nil {
+		c.deadLetterErr = err
+		return
+	}
+
+	for _, line := range lines {
+		if _, err := c.deadLetter.WriteString(line + "\n"); err != nil {
+			c.deadLetterErr = err
+			return
+		}
+	}
+}
+
+func (c *Client) flushDeadLetter() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.deadLetter == nil {
+		return nil
+	}
+
+	if c.deadLetterErr != nil {
+		return c.deadLetterErr
+	}
+
+	return c.deadLetter.Flush()
+}
+
+// Summary returns the number of operations that succeeded and failed, and
+// why they failed
+func (c *Client) Summary() BulkSummary {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return BulkSummary{
+		Succeeded: c.succeeded,
+		Retried:   c.retried,
+		Skipped:   c.skipped,
+		Failed:    len(c.failures),
+		Failures:  append([]BulkFailure(nil), c.failures...),
+	}
+}
@@ -2,7 +2,6 @@ package elastic
 
 import (
 	"context"
-	"strconv"
 	"strings"
 )
 
@@ -144,11 +143,17 @@ const IndexProperties = `
 				"search_analyzer": "code_search_analyzer",
 				"type": "text"
 			},
+			"documentation": {
+				"type": "boolean"
+			},
 			"file_name": {
 				"analyzer": "code_analyzer",
 				"search_analyzer": "code_search_analyzer",
 				"type": "text"
 			},
+			"generated": {
+				"type": "boolean"
+			},
 			"id": {
 				"analyzer": "sha_analyzer",
 				"index_options": "offsets",
@@ -171,6 +176,9 @@ const IndexProperties = `
 			},
 			"type": {
 				"type": "keyword"
+			},
+			"vendored": {
+				"type": "boolean"
 			}
 		}
 	},
@@ -280,7 +288,8 @@ const IndexProperties = `
 				"milestone",
 				"wiki_blob",
 				"commit",
-				"merge_request"
+				"merge_request",
+				"submodule"
 			]
 		},
 		"type": "join"
@@ -346,6 +355,38 @@ const IndexProperties = `
 	"state": {
 		"type": "text"
 	},
+	"submodule": {
+		"properties": {
+			"commit_sha": {
+				"analyzer": "sha_analyzer",
+				"index_options": "offsets",
+				"type": "text"
+			},
+			"file_name": {
+				"analyzer": "code_analyzer",
+				"search_analyzer": "code_search_analyzer",
+				"type": "text"
+			},
+			"oid": {
+				"analyzer": "sha_analyzer",
+				"index_options": "offsets",
+				"type": "text"
+			},
+			"path": {
+				"analyzer": "path_analyzer",
+				"type": "text"
+			},
+			"rid": {
+				"type": "keyword"
+			},
+			"type": {
+				"type": "keyword"
+			},
+			"url": {
+				"type": "keyword"
+			}
+		}
+	},
 	"target_branch": {
 		"index_options": "offsets",
 		"type": "text"
@@ -374,31 +415,16 @@ const IndexProperties = `
 
 // createIndex creates an index matching that created by GitLab
 func (c *Client) createIndex(mapping string) error {
-	info, err := c.Client.NodesInfo().Do(context.Background())
+	return c.createIndexNamed(context.Background(), c.IndexName, mapping)
+}
+
+func (c *Client) createIndexNamed(ctx context.Context, name, mapping string) error {
+	body, err := c.dialect.indexBody(mapping)
 	if err != nil {
 		return err
 	}
 
-	createIndexService := c.Client.CreateIndex(c.IndexName).BodyString(mapping)
-
-	for _, node := range info.Nodes {
-		// Grab the first character of the version string and turn it into an int
-		version, _ := strconv.Atoi(string(node.Version[0]))
-		if version == 7 {
-			// include_type_name defaults to false in ES7. This will ensure ES7
-			// behaves like ES6 when creating mappings. See
-			// https://www.elastic.co/blog/moving-from-types-to-typeless-apis-in-elasticsearch-7-0
-			// for more information. We also can't set this for any versions before
-			// 6.8 as this parameter was not supported. Since it defaults to true in
-			// all 6.x it's safe to only set it for 7.x.
-			createIndexService = createIndexService.IncludeTypeName(true)
-		}
-
-		// We only look at the first node and assume they're all the same version
-		break
-	}
-
-	createIndex, err := createIndexService.Do(context.Background())
+	createIndex, err := c.Client.CreateIndex(name).BodyString(body).Do(ctx)
 	if err != nil {
 		return err
 	}
@@ -412,9 +438,7 @@ func (c *Client) createIndex(mapping string) error {
 
 // CreateIndex creates an index matching that created by gitlab-rails.
 func (c *Client) CreateWorkingIndex() error {
-	mapping := strings.Replace(IndexMapping, "__PROPERTIES__", IndexProperties, -1)
-
-	return c.createIndex(mapping)
+	return c.createIndex(RenderedMapping())
 }
 
 // For testing
@@ -0,0 +1,192 @@
+package elastic
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/olivere/elastic"
+)
+
+// RenderedMapping is the body of the create index request for the index,
+// with the "doc" mapping type of Elasticsearch 6
+func RenderedMapping() string {
+	return strings.Replace(IndexMapping, "__PROPERTIES__", IndexProperties, -1)
+}
+
+// IndexBody is RenderedMapping in the form the server accepts
+func (c *Client) IndexBody() (string, error) {
+	return c.dialect.indexBody(RenderedMapping())
+}
+
+// MappingDifference is a setting of the mapping that differs between the
+// index and RenderedMapping. Expected or Actual is nil when the setting is
+// missing from the index, or unexpected.
+type MappingDifference struct {
+	Path     string
+	Expected interface{}
+	Actual   interface{}
+}
+
+func (d MappingDifference) String() string {
+	switch {
+	case d.Actual == nil:
+		return fmt.Sprintf("%s: missing, expected %s", d.Path, describe(d.Expected))
+	case d.Expected == nil:
+		return fmt.Sprintf("%s: unexpected %s", d.Path, describe(d.Actual))
+	}
+
+	return fmt.Sprintf("%s: expected %s, got %s", d.Path, describe(d.Expected), describe(d.Actual))
+}
+
+func describe(value interface{}) string {
+	out, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+
+	return string(out)
+}
+
+// LiveMapping returns the mapping of the index, without a mapping type. If
+// IndexName is an alias, the mapping of every index behind it is returned.
+func (c *Client) LiveMapping(ctx context.Context) (map[string]map[string]interface{}, error) {
+	res, err := c.Client.PerformRequest(ctx, elastic.PerformRequestOptions{
+		Method: "GET",
+		Path:   "/" + c.IndexName + "/_mapping",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Couldn't get the mapping of %s: %s", c.IndexName, err)
+	}
+
+	var indices map[string]struct {
+		Mappings map[string]interface{} `json:"mappings"`
+	}
+
+	if err := json.Unmarshal(res.Body, &indices); err != nil {
+		return nil, fmt.Errorf("Couldn't get the mapping of %s: %s", c.IndexName, err)
+	}
+
+	out := make(map[string]map[string]interface{}, len(indices))
+	for name, index := range indices {
+		mapping := index.Mappings
+		if docType := c.dialect.documentType(); docType != "" {
+			mapping, _ = mapping[docType].(map[string]interface{})
+		}
+
+		out[name] = mapping
+	}
+
+	return out, nil
+}
+
+// MappingDrift compares the mapping of the index, or of every index behind
+// the alias, with RenderedMapping. It returns the differences by index, and
+// no entry for indices that match.
+func (c *Client) MappingDrift(ctx context.Context) (map[string][]MappingDifference, error) {
+	live, err := c.LiveMapping(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	expected, err := expectedMapping()
+	if err != nil {
+		return nil, err
+	}
+
+	drift := make(map[string][]MappingDifference)
+	for name, mapping := range live {
+		if differences := DiffMapping(expected, mapping); len(differences) > 0 {
+			drift[name] = differences
+		}
+	}
+
+	return drift, nil
+}
+
+// expectedMapping is the typeless mapping of RenderedMapping
+func expectedMapping() (map[string]interface{}, error) {
+	var body struct {
+		Mappings struct {
+			Doc map[string]interface{} `json:"doc"`
+		} `json:"mappings"`
+	}
+
+	if err := json.Unmarshal([]byte(RenderedMapping()), &body); err != nil {
+		return nil, err
+	}
+
+	return body.Mappings.Doc, nil
+}
+
+// DiffMapping returns the differences between two typeless mappings, sorted
+// by path. Scalars are compared as strings, as Elasticsearch may return
+// "true" for true, and arrays regardless of order, as Elasticsearch doesn't
+// keep the order of the children of a join relation.
+func DiffMapping(expected, actual map[string]interface{}) []MappingDifference {
+	var differences []MappingDifference
+	diffMapping("", expected, actual, &differences)
+
+	sort.Slice(differences, func(i, j int) bool {
+		return differences[i].Path < differences[j].Path
+	})
+
+	return differences
+}
+
+func diffMapping(prefix string, expected, actual map[string]interface{}, differences *[]MappingDifference) {
+	keys := make(map[string]bool)
+	for key := range expected {
+		keys[key] = true
+	}
+	for key := range actual {
+		keys[key] = true
+	}
+
+	for key := range keys {
+		path := key
+		if prefix != "" {
+			path = prefix + "." + key
+		}
+
+		e, a := expected[key], actual[key]
+		eMap, eIsMap := e.(map[string]interface{})
+		aMap, aIsMap := a.(map[string]interface{})
+
+		switch {
+		case eIsMap && aIsMap:
+			diffMapping(path, eMap, aMap, differences)
+		case e == nil || a == nil || eIsMap || aIsMap:
+			*differences = append(*differences, MappingDifference{Path: path, Expected: e, Actual: a})
+		case !scalarsEqual(e, a):
+			*differences = append(*differences, MappingDifference{Path: path, Expected: e, Actual: a})
+		}
+	}
+}
+
+func scalarsEqual(a, b interface{}) bool {
+	if reflect.DeepEqual(a, b) || fmt.Sprint(a) == fmt.Sprint(b) {
+		return true
+	}
+
+	aSlice, aIsSlice := a.([]interface{})
+	bSlice, bIsSlice := b.([]interface{})
+	if !aIsSlice || !bIsSlice || len(aSlice) != len(bSlice) {
+		return false
+	}
+
+	return reflect.DeepEqual(sortedStrings(aSlice), sortedStrings(bSlice))
+}
+
+func sortedStrings(values []interface{}) []string {
+	out := make([]string, len(values))
+	for n, value := range values {
+		out[n] = fmt.Sprint(value)
+	}
+	sort.Strings(out)
+
+	return out
+}
@@ -0,0 +1,154 @@
+package elastic_test
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/gitlab-elasticsearch-indexer/elastic"
+)
+
+// mappingServer answers GET /<index>/_mapping with the mappings of the body
+// the client would create the index with, after applying change to them
+func mappingServer(t *testing.T, version string, change func(mappings map[string]interface{})) *httptest.Server {
+	var srv *httptest.Server
+
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.URL.Path == "/" {
+			w.Write([]byte(`{"version":{"number":"` + version + `"}}`))
+			return
+		}
+
+		require.Equal(t, "/gitlab-test/_mapping", r.URL.Path)
+
+		client := newMappingTestClient(t, srv.URL, version)
+		defer client.Close()
+
+		body, err := client.IndexBody()
+		require.NoError(t, err)
+
+		var index map[string]interface{}
+		require.NoError(t, json.Unmarshal([]byte(body), &index))
+		delete(index, "settings")
+
+		mappings := index["mappings"].(map[string]interface{})
+		if doc, ok := mappings["doc"]; ok {
+			change(doc.(map[string]interface{}))
+		} else {
+			change(mappings)
+		}
+
+		json.NewEncoder(w).Encode(map[string]interface{}{"gitlab-test-20200102-150405": index})
+	}))
+
+	return srv
+}
+
+func newMappingTestClient(t *testing.T, url, version string) *elastic.Client {
+	config, err := elastic.ReadConfig(strings.NewReader(`{"url":["` + url + `"],"index_name":"gitlab-test"}`))
+	require.NoError(t, err)
+
+	client, err := elastic.NewClient(config)
+	require.NoError(t, err)
+
+	return client
+}
+
+// fixtureServer answers GET /gitlab-test/_mapping with a response body of
+// Elasticsearch from testdata
+func fixtureServer(t *testing.T, version, fixture string) *httptest.Server {
+	body, err := ioutil.ReadFile(filepath.Join("testdata", fixture))
+	require.NoError(t, err)
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.URL.Path == "/" {
+			w.Write([]byte(`{"version":{"number":"` + version + `"}}`))
+			return
+		}
+
+		require.Equal(t, "/gitlab-test/_mapping", r.URL.Path)
+		w.Write(body)
+	}))
+}
+
+func TestMappingDrift(t *testing.T) {
+	// The mappings Elasticsearch returns for an index created with
+	// IndexBody, which list the parameters of each field in its own order,
+	// and the children of join_field in the order of a Java HashSet
+	for _, tc := range []struct {
+		version string
+		fixture string
+	}{
+		{"6.8.23", "mapping-6.8.json"},
+		{"7.17.9", "mapping-7.17.json"},
+	} {
+		t.Run(tc.version, func(t *testing.T) {
+			srv := fixtureServer(t, tc.version, tc.fixture)
+			defer srv.Close()
+
+			client := newMappingTestClient(t, srv.URL, tc.version)
+			defer client.Close()
+
+			drift, err := client.MappingDrift(context.Background())
+			require.NoError(t, err)
+			require.Empty(t, drift)
+		})
+	}
+}
+
+func TestMappingDriftIsReported(t *testing.T) {
+	srv := mappingServer(t, "7.17.9", func(mappings map[string]interface{}) {
+		properties := mappings["properties"].(map[string]interface{})
+		blob := properties["blob"].(map[string]interface{})["properties"].(map[string]interface{})
+
+		delete(properties, "archived")
+		blob["path"].(map[string]interface{})["analyzer"] = "standard"
+		properties["extra"] = map[string]interface{}{"type": "keyword"}
+		mappings["dynamic"] = "true"
+	})
+	defer srv.Close()
+
+	client := newMappingTestClient(t, srv.URL, "7.17.9")
+	defer client.Close()
+
+	drift, err := client.MappingDrift(context.Background())
+	require.NoError(t, err)
+
+	var differences []string
+	for _, difference := range drift["gitlab-test-20200102-150405"] {
+		differences = append(differences, difference.String())
+	}
+
+	require.Equal(t, []string{
+		`dynamic: expected "strict", got "true"`,
+		`properties.archived: missing, expected {"type":"boolean"}`,
+		`properties.blob.properties.path.analyzer: expected "path_analyzer", got "standard"`,
+		`properties.extra: unexpected {"type":"keyword"}`,
+	}, differences)
+}
+
+func TestDiffMappingComparesScalarsAsStrings(t *testing.T) {
+	expected := map[string]interface{}{"reverse": "true", "min_gram": 2.0}
+	actual := map[string]interface{}{"reverse": true, "min_gram": "2"}
+
+	require.Empty(t, elastic.DiffMapping(expected, actual))
+}
+
+func TestDiffMappingIgnoresArrayOrder(t *testing.T) {
+	expected := map[string]interface{}{"project": []interface{}{"note", "blob"}}
+
+	require.Empty(t, elastic.DiffMapping(expected, map[string]interface{}{"project": []interface{}{"blob", "note"}}))
+	require.Len(t, elastic.DiffMapping(expected, map[string]interface{}{"project": []interface{}{"blob"}}), 1)
+	require.Len(t, elastic.DiffMapping(expected, map[string]interface{}{"project": []interface{}{"blob", "issue"}}), 1)
+}
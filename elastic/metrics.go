@@ -0,0 +1,87 @@
+package elastic
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/olivere/elastic"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"gitlab.com/gitlab-org/gitlab-elasticsearch-indexer/metrics"
+)
+
+var (
+	bulkRequestDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: metrics.Namespace,
+		Name:      "bulk_request_duration_seconds",
+		Help:      "Latency of bulk requests, including the retries of rejected items",
+		Buckets:   prometheus.DefBuckets,
+	})
+
+	bulkRequestOperations = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: metrics.Namespace,
+		Name:      "bulk_request_operations",
+		Help:      "Operations per bulk request",
+		Buckets:   prometheus.ExponentialBuckets(1, 4, 8),
+	})
+
+	bulkRequestBytes = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: metrics.Namespace,
+		Name:      "bulk_request_bytes",
+		Help:      "Size of the body of bulk requests",
+		Buckets:   prometheus.ExponentialBuckets(1024, 4, 8),
+	})
+
+	bulkItemFailures = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metrics.Namespace,
+		Name:      "bulk_item_failures_total",
+		Help:      "Bulk operations that failed for good, by operation and status, which is 0 if the whole request failed",
+	}, []string{"op", "status"})
+)
+
+func init() {
+	metrics.Registry.MustRegister(bulkRequestDuration, bulkRequestOperations, bulkRequestBytes, bulkItemFailures)
+}
+
+// beforeCallback records the start and size of a bulk request
+func (c *Client) beforeCallback(executionId int64, requests []elastic.BulkableRequest) {
+	var size int
+	for _, req := range requests {
+		// The processor already built and cached the lines to estimate
+		// the size of the request
+		lines, err := req.Source()
+		if err != nil {
+			continue
+		}
+
+		for _, line := range lines {
+			size += len(line) + 1
+		}
+	}
+
+	bulkRequestOperations.Observe(float64(len(requests)))
+	bulkRequestBytes.Observe(float64(size))
+
+	c.mu.Lock()
+	c.started[executionId] = time.Now()
+	c.mu.Unlock()
+}
+
+// observeBulk records the outcome of a bulk request, or of a retry of its
+// rejected items. Its latency is recorded once no items are left to retry.
+func (c *Client) observeBulk(executionId int64, outcome *bulkOutcome, final bool) {
+	if final {
+		c.mu.Lock()
+		start, ok := c.started[executionId]
+		delete(c.started, executionId)
+		c.mu.Unlock()
+
+		if ok {
+			bulkRequestDuration.Observe(time.Since(start).Seconds())
+		}
+	}
+
+	for _, failure := range outcome.failures {
+		bulkItemFailures.WithLabelValues(failure.Op, strconv.Itoa(failure.Status)).Inc()
+	}
+}
@@ -0,0 +1,126 @@
+package elastic_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/gitlab-elasticsearch-indexer/elastic"
+	"gitlab.com/gitlab-org/gitlab-elasticsearch-indexer/metrics"
+)
+
+// metricValue returns the value of a series of metrics.Registry, or 0 if
+// it wasn't written yet
+func metricValue(t *testing.T, series string) float64 {
+	rec := httptest.NewRecorder()
+	metrics.Handler().ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+
+	for _, line := range strings.Split(rec.Body.String(), "\n") {
+		if strings.HasPrefix(line, series+" ") {
+			value, err := strconv.ParseFloat(strings.TrimPrefix(line, series+" "), 64)
+			require.NoError(t, err)
+
+			return value
+		}
+	}
+
+	return 0
+}
+
+func TestBulkRequestsRecordMetrics(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if !strings.HasSuffix(r.URL.Path, "/_bulk") {
+			w.Write([]byte(`{}`))
+			return
+		}
+
+		w.Write([]byte(`{"errors":true,"items":[
+			{"index":{"_id":"` + projectIDString + `_foo","status":201}},
+			{"index":{"_id":"` + projectIDString + `_baz","status":400,"error":{"type":"strict_dynamic_mapping_exception","reason":"mapping set to strict"}}}
+		]}`))
+	}))
+	defer srv.Close()
+
+	config, err := elastic.ReadConfig(strings.NewReader(`{"url":["` + srv.URL + `"],"index_name":"gitlab-test","max_bulk_concurrency":1}`))
+	require.NoError(t, err)
+	config.ProjectID = projectID
+
+	client, err := elastic.NewClient(config)
+	require.NoError(t, err)
+	defer client.Close()
+
+	series := []string{
+		"gitlab_elasticsearch_indexer_bulk_request_duration_seconds_count",
+		"gitlab_elasticsearch_indexer_bulk_request_operations_sum",
+		"gitlab_elasticsearch_indexer_bulk_request_bytes_sum",
+		`gitlab_elasticsearch_indexer_bulk_item_failures_total{op="index",status="400"}`,
+	}
+
+	before := make([]float64, len(series))
+	for n, s := range series {
+		before[n] = metricValue(t, s)
+	}
+
+	client.Index(context.Background(), projectIDString+"_foo", map[string]interface{}{})
+	client.Index(context.Background(), projectIDString+"_baz", map[string]interface{}{"invalid": true})
+	require.Error(t, client.Flush(context.Background()))
+
+	var deltas []float64
+	for n, s := range series {
+		deltas = append(deltas, metricValue(t, s)-before[n])
+	}
+
+	body := `{"index":{"_index":"gitlab-test","_id":"667_foo","_type":"doc","routing":"project_667"}}
+{}
+{"index":{"_index":"gitlab-test","_id":"667_baz","_type":"doc","routing":"project_667"}}
+{"invalid":true}
+`
+
+	require.Equal(t, []float64{1, 2, float64(len(body)), 1}, deltas)
+}
+
+func TestBulkRequestDurationIncludesRetries(t *testing.T) {
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if !strings.HasSuffix(r.URL.Path, "/_bulk") {
+			w.Write([]byte(`{}`))
+			return
+		}
+
+		status := `201`
+		if requests++; requests == 1 {
+			status = `429,"error":{"type":"es_rejected_execution_exception","reason":"rejected execution"}`
+		}
+
+		w.Write([]byte(`{"errors":true,"items":[{"index":{"_id":"` + projectIDString + `_foo","status":` + status + `}}]}`))
+	}))
+	defer srv.Close()
+
+	config, err := elastic.ReadConfig(strings.NewReader(`{"url":["` + srv.URL + `"],"max_bulk_concurrency":1,"retry_initial_delay_ms":100,"retry_max_delay_ms":100}`))
+	require.NoError(t, err)
+	config.ProjectID = projectID
+
+	client, err := elastic.NewClient(config)
+	require.NoError(t, err)
+	defer client.Close()
+
+	count := metricValue(t, "gitlab_elasticsearch_indexer_bulk_request_duration_seconds_count")
+	sum := metricValue(t, "gitlab_elasticsearch_indexer_bulk_request_duration_seconds_sum")
+
+	client.Index(context.Background(), projectIDString+"_foo", map[string]interface{}{})
+	require.NoError(t, client.Flush(context.Background()))
+	require.Equal(t, 2, requests)
+
+	// One request, which took at least as long as the delay of its retry
+	require.Equal(t, float64(1), metricValue(t, "gitlab_elasticsearch_indexer_bulk_request_duration_seconds_count")-count)
+	require.True(t, metricValue(t, "gitlab_elasticsearch_indexer_bulk_request_duration_seconds_sum")-sum >= 0.1)
+}
@@ -0,0 +1,188 @@
+package elastic
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/olivere/elastic"
+)
+
+// NDJSONWriter is a Submitter that writes the operations Client would send
+// to Elasticsearch, in the `_bulk` NDJSON format, instead of sending them.
+// The output can be sent later with Client.Replay. Operations are written
+// with the "doc" mapping type of Elasticsearch 6, which Replay adapts to the
+// server it sends them to.
+type NDJSONWriter struct {
+	IndexName string
+	ProjectID int64
+
+	// Version enables external versioning, see Client.SetDocumentVersion
+	Version int64
+
+	w   *bufio.Writer
+	err error
+}
+
+func NewNDJSONWriter(w io.Writer, indexName string, projectID int64) *NDJSONWriter {
+	return &NDJSONWriter{
+		IndexName: indexName,
+		ProjectID: projectID,
+		w:         bufio.NewWriter(w),
+	}
+}
+
+func (n *NDJSONWriter) ParentID() int64 {
+	return n.ProjectID
+}
+
+// Index writes an index operation. Nothing is written once the context is
+// done.
+func (n *NDJSONWriter) Index(ctx context.Context, id string, thing interface{}) {
+	if ctx.Err() != nil {
+		return
+	}
+
+	n.write(newIndexRequest(typedDialect{}, n.IndexName, n.ProjectID, n.Version, id, thing))
+}
+
+// Remove writes a delete operation. Nothing is written once the context is
+// done.
+func (n *NDJSONWriter) Remove(ctx context.Context, id string) {
+	if ctx.Err() != nil {
+		return
+	}
+
+	n.write(newDeleteRequest(typedDialect{}, n.IndexName, n.ProjectID, n.Version, id))
+}
+
+// write keeps the first error, which Flush returns, as the Submitter
+// interface doesn't let Index and Remove fail
+func (n *NDJSONWriter) write(req elastic.BulkableRequest) {
+	if n.err != nil {
+		return
+	}
+
+	lines, err := req.Source()
+	if err != nil {
+		n.err = err
+		return
+	}
+
+	for _, line := range lines {
+		if _, err := n.w.WriteString(line + "\n"); err != nil {
+			n.err = err
+			return
+		}
+	}
+}
+
+func (n *NDJSONWriter) Flush(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if n.err != nil {
+		return n.err
+	}
+
+	return n.w.Flush()
+}
+
+type bulkMetadata struct {
+	Index       string `json:"_index"`
+	ID          string `json:"_id"`
+	Routing     string `json:"routing"`
+	Version     int64  `json:"version"`
+	VersionType string `json:"version_type"`
+}
+
+// Replay queues the index and delete operations of a `_bulk` NDJSON stream,
+// like the one NDJSONWriter produces. Call Flush to commit them. Replaying
+// stops with the context's error once it is done. Mapping types are replaced
+// with the one the server expects.
+func (c *Client) Replay(ctx context.Context, r io.Reader) error {
+	reader := bufio.NewReader(r)
+	lineNo := 0
+
+	readLine := func() ([]byte, error) {
+		for {
+			line, err := reader.ReadBytes('\n')
+			line = bytes.TrimSpace(line)
+			if len(line) > 0 {
+				lineNo++
+				return line, nil
+			}
+
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		line, err := readLine()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		var action map[string]bulkMetadata
+		if err := json.Unmarshal(line, &action); err != nil || len(action) != 1 {
+			return fmt.Errorf("Line %d: invalid bulk action: %s", lineNo, line)
+		}
+
+		for op, meta := range action {
+			if meta.Index == "" {
+				meta.Index = c.writeIndex
+			}
+
+			switch op {
+			case "index":
+				doc, err := readLine()
+				if err == io.EOF {
+					return fmt.Errorf("Line %d: index action without a document", lineNo)
+				}
+				if err != nil {
+					return err
+				}
+
+				req := elastic.NewBulkIndexRequest().
+					Index(meta.Index).
+					Type(c.dialect.documentType()).
+					Routing(meta.Routing).
+					Id(meta.ID).
+					Doc(json.RawMessage(doc))
+
+				if meta.VersionType != "" {
+					req = req.Version(meta.Version).VersionType(meta.VersionType)
+				}
+
+				c.bulk.Add(req)
+			case "delete":
+				req := elastic.NewBulkDeleteRequest().
+					Index(meta.Index).
+					Type(c.dialect.documentType()).
+					Routing(meta.Routing).
+					Id(meta.ID)
+
+				if meta.VersionType != "" {
+					req = req.Version(meta.Version).VersionType(meta.VersionType)
+				}
+
+				c.bulk.Add(req)
+			default:
+				return fmt.Errorf("Line %d: unsupported bulk action: %s", lineNo, op)
+			}
+		}
+	}
+}
@@ -0,0 +1,139 @@
+package elastic_test
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/gitlab-elasticsearch-indexer/elastic"
+)
+
+// bulkRecorder returns a client whose _bulk request bodies are recorded
+func bulkRecorder(t *testing.T) (*elastic.Client, *bytes.Buffer, func()) {
+	return aliasedBulkRecorder(t, false)
+}
+
+func aliasedBulkRecorder(t *testing.T, aliases bool) (*elastic.Client, *bytes.Buffer, func()) {
+	var bodies bytes.Buffer
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if strings.HasSuffix(r.URL.Path, "/_bulk") {
+			body, err := ioutil.ReadAll(r.Body)
+			require.NoError(t, err)
+			bodies.Write(body)
+		}
+
+		w.Write([]byte(`{}`))
+	}))
+
+	config, err := elastic.ReadConfig(strings.NewReader(`{"url":["` + srv.URL + `"],"index_name":"gitlab-test","max_bulk_concurrency":1}`))
+	require.NoError(t, err)
+	config.ProjectID = projectID
+	config.Aliases = aliases
+
+	client, err := elastic.NewClient(config)
+	require.NoError(t, err)
+
+	return client, &bodies, func() {
+		client.Close()
+		srv.Close()
+	}
+}
+
+func submitDocuments(t *testing.T, submitter interface {
+	Index(context.Context, string, interface{})
+	Remove(context.Context, string)
+	Flush(context.Context) error
+}) {
+	joinData := map[string]string{"name": "blob", "parent": "project_" + projectIDString}
+
+	submitter.Index(context.Background(), projectIDString+"_foo", map[string]interface{}{"type": "blob", "join_field": joinData})
+	submitter.Remove(context.Background(), projectIDString+"_bar")
+	require.NoError(t, submitter.Flush(context.Background()))
+}
+
+func TestNDJSONWriterMatchesClient(t *testing.T) {
+	for _, tc := range []struct {
+		aliases bool
+		index   string
+	}{
+		{aliases: false, index: "gitlab-test"},
+		{aliases: true, index: elastic.WriteAlias("gitlab-test")},
+	} {
+		client, sent, cleanup := aliasedBulkRecorder(t, tc.aliases)
+		defer cleanup()
+
+		// The index of a dry run is resolved like the client's
+		var written bytes.Buffer
+		writer := elastic.NewNDJSONWriter(&written, tc.index, projectID)
+		require.Equal(t, projectID, writer.ParentID())
+
+		submitDocuments(t, client)
+		submitDocuments(t, writer)
+
+		expected := `{"index":{"_index":"` + tc.index + `","_id":"667_foo","_type":"doc","routing":"project_667"}}
+{"join_field":{"name":"blob","parent":"project_667"},"type":"blob"}
+{"delete":{"_index":"` + tc.index + `","_type":"doc","_id":"667_bar","routing":"project_667"}}
+`
+
+		require.Equal(t, expected, written.String())
+		require.Equal(t, expected, sent.String())
+	}
+}
+
+func TestReplayNDJSON(t *testing.T) {
+	client, sent, cleanup := bulkRecorder(t)
+	defer cleanup()
+
+	var written bytes.Buffer
+	submitDocuments(t, elastic.NewNDJSONWriter(&written, "gitlab-test", projectID))
+
+	require.NoError(t, client.Replay(context.Background(), bytes.NewReader(written.Bytes())))
+	require.NoError(t, client.Flush(context.Background()))
+
+	require.Equal(t, written.String(), sent.String())
+}
+
+func TestReplayKeepsDocumentVersions(t *testing.T) {
+	client, sent, cleanup := bulkRecorder(t)
+	defer cleanup()
+
+	var written bytes.Buffer
+	writer := elastic.NewNDJSONWriter(&written, "gitlab-test", projectID)
+	writer.Version = 1509205127
+	submitDocuments(t, writer)
+
+	require.Equal(t, `{"index":{"_index":"gitlab-test","_id":"667_foo","_type":"doc","routing":"project_667","version":1509205127,"version_type":"external_gte"}}
+{"join_field":{"name":"blob","parent":"project_667"},"type":"blob"}
+{"delete":{"_index":"gitlab-test","_type":"doc","_id":"667_bar","routing":"project_667","version":1509205127,"version_type":"external_gte"}}
+`, written.String())
+
+	require.NoError(t, client.Replay(context.Background(), bytes.NewReader(written.Bytes())))
+	require.NoError(t, client.Flush(context.Background()))
+
+	require.Equal(t, written.String(), sent.String())
+}
+
+func TestReplayInvalidNDJSON(t *testing.T) {
+	client, sent, cleanup := bulkRecorder(t)
+	defer cleanup()
+
+	for _, input := range []string{
+		`{"index":{"_id":"667_foo"}}`,
+		`{"update":{"_id":"667_foo"}}` + "\n{}",
+		`not json`,
+	} {
+		require.Error(t, client.Replay(context.Background(), strings.NewReader(input)), input)
+	}
+
+	require.NoError(t, client.Flush(context.Background()))
+	require.Empty(t, sent.String())
+}
@@ -0,0 +1,187 @@
+package elastic
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/olivere/elastic"
+	log "github.com/sirupsen/logrus"
+
+	"gitlab.com/gitlab-org/gitlab-elasticsearch-indexer/logging"
+)
+
+// retryPolicy is an exponential backoff which gives up after maxRetries. It
+// applies both to bulk requests that fail as a whole and to rejected items.
+type retryPolicy struct {
+	initial    time.Duration
+	max        time.Duration
+	maxRetries int
+}
+
+func newRetryPolicy(config *Config) *retryPolicy {
+	maxRetries := DefaultMaxRetries
+	if config.MaxRetries != nil {
+		maxRetries = *config.MaxRetries
+	}
+
+	return &retryPolicy{
+		initial:    time.Duration(config.RetryInitialDelay) * time.Millisecond,
+		max:        time.Duration(config.RetryMaxDelay) * time.Millisecond,
+		maxRetries: maxRetries,
+	}
+}
+
+// Next implements elastic.Backoff. retry starts at 1.
+func (p *retryPolicy) Next(retry int) (time.Duration, bool) {
+	if retry > p.maxRetries {
+		return 0, false
+	}
+
+	delay := p.initial
+	for n := 1; n < retry && delay < p.max; n++ {
+		delay *= 2
+	}
+
+	if delay > p.max {
+		delay = p.max
+	}
+
+	return delay, true
+}
+
+// retryableStatus reports whether an item may succeed if it is sent again,
+// e.g. after es_rejected_execution_exception
+func retryableStatus(status int) bool {
+	switch status {
+	case http.StatusRequestTimeout,
+		http.StatusTooManyRequests,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout,
+		http.StatusInsufficientStorage:
+		return true
+	}
+
+	return false
+}
+
+// bulkOutcome collects the results of the items of a bulk request, including
+// those that were retried
+type bulkOutcome struct {
+	confirmed []string
+	retried   int
+	failures  []BulkFailure
+
+	// skipped holds the IDs of documents that weren't written as they have
+	// a higher version already
+	skipped []string
+
+	// failed holds the request of each failure, if it is known
+	failed []elastic.BulkableRequest
+}
+
+func (o *bulkOutcome) fail(failure BulkFailure, req elastic.BulkableRequest) {
+	o.failures = append(o.failures, failure)
+	o.failed = append(o.failed, req)
+}
+
+// collect sorts the items of a response to the given attempt into outcome,
+// and returns the requests that should be retried. The items of a response
+// are in the same order as the requests.
+func (c *Client) collect(requests []elastic.BulkableRequest, response *elastic.BulkResponse, attempt int, outcome *bulkOutcome) []elastic.BulkableRequest {
+	_, canRetry := c.retry.Next(attempt + 1)
+
+	var retry []elastic.BulkableRequest
+	for n, item := range response.Items {
+		var req elastic.BulkableRequest
+		if len(response.Items) == len(requests) {
+			req = requests[n]
+		}
+
+		for op, result := range item {
+			switch {
+			case itemSucceeded(op, result):
+				outcome.confirmed = append(outcome.confirmed, result.Id)
+				if attempt > 0 {
+					outcome.retried++
+				}
+			case staleWrite(result):
+				outcome.skipped = append(outcome.skipped, result.Id)
+			case canRetry && req != nil && retryableStatus(result.Status):
+				retry = append(retry, req)
+			default:
+				failure := BulkFailure{ID: result.Id, Op: op, Status: result.Status}
+				if result.Error != nil {
+					failure.Type = result.Error.Type
+					failure.Reason = result.Error.Reason
+				}
+
+				outcome.fail(failure, req)
+			}
+		}
+	}
+
+	return retry
+}
+
+// retryItems sends the rejected items of a bulk request again until they
+// succeed, fail for good, or the retries are exhausted. Only the items that
+// were rejected are sent again. It runs on its own goroutine, so that the
+// backoff doesn't hold up a bulk worker, and Flush waits for it.
+func (c *Client) retryItems(executionId int64, retry []elastic.BulkableRequest) {
+	defer c.doneRetrying()
+
+	for attempt := 1; len(retry) > 0; attempt++ {
+		delay, _ := c.retry.Next(attempt)
+		log.WithFields(log.Fields{
+			logging.BulkExecutionID: executionId,
+			"rejected":              len(retry),
+			"delay":                 delay.String(),
+		}).Info("Retrying rejected documents")
+		time.Sleep(delay)
+
+		outcome := &bulkOutcome{}
+
+		response, err := c.Client.Bulk().Add(retry...).Do(context.Background())
+		if err != nil {
+			for _, req := range retry {
+				op, id := requestAction(req)
+				outcome.fail(BulkFailure{ID: id, Op: op, Reason: err.Error()}, req)
+			}
+
+			retry = nil
+		} else {
+			retry = c.collect(retry, response, attempt, outcome)
+		}
+
+		c.commit(executionId, outcome, nil, len(retry) == 0)
+	}
+}
+
+func (c *Client) startRetrying() {
+	c.mu.Lock()
+	c.retrying++
+	c.mu.Unlock()
+}
+
+func (c *Client) doneRetrying() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.retrying--
+	if c.retrying == 0 {
+		c.retryDone.Broadcast()
+	}
+}
+
+// waitRetries waits until the rejected items of every committed bulk request
+// have been retried
+func (c *Client) waitRetries() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for c.retrying > 0 {
+		c.retryDone.Wait()
+	}
+}
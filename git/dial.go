@@ -0,0 +1,268 @@
+package git
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	gitalyauth "gitlab.com/gitlab-org/gitaly/auth"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	DefaultDialTimeout      = 10 * 1000
+	DefaultKeepaliveTime    = 30 * 1000
+	DefaultKeepaliveTimeout = 10 * 1000
+)
+
+// ConnectionFailure tells why Gitaly couldn't be connected to
+type ConnectionFailure string
+
+const (
+	// InvalidConfig means the address, token or TLS files can't be used
+	InvalidConfig ConnectionFailure = "invalid configuration"
+	// Unreachable means Gitaly didn't answer within the dial timeout, or
+	// refused the connection
+	Unreachable ConnectionFailure = "unreachable"
+	// HandshakeFailed means the TLS handshake failed, e.g. because the
+	// certificate of Gitaly isn't trusted or ours was rejected
+	HandshakeFailed ConnectionFailure = "TLS handshake failed"
+	// Unauthenticated means Gitaly rejected the token
+	Unauthenticated ConnectionFailure = "unauthenticated"
+)
+
+// ConnectionError is returned by NewGitalyClient when Gitaly can't be used
+type ConnectionError struct {
+	Address string
+	Failure ConnectionFailure
+	Err     error
+}
+
+func (e *ConnectionError) Error() string {
+	return fmt.Sprintf("Couldn't connect to Gitaly at %s: %s: %s", e.Address, e.Failure, e.Err)
+}
+
+func (c *StorageConfig) connectionError(failure ConnectionFailure, err error) *ConnectionError {
+	return &ConnectionError{Address: c.Address, Failure: failure, Err: err}
+}
+
+func (c *StorageConfig) setDefaults() {
+	if c.DialTimeout == 0 {
+		c.DialTimeout = DefaultDialTimeout
+	}
+
+	if c.KeepaliveTime == 0 {
+		c.KeepaliveTime = DefaultKeepaliveTime
+	}
+
+	if c.KeepaliveTimeout == 0 {
+		c.KeepaliveTimeout = DefaultKeepaliveTimeout
+	}
+}
+
+func (c *StorageConfig) hasTLSConfig() bool {
+	return c.CACert != "" || c.ClientCert != "" || c.ClientKey != "" || c.ServerName != ""
+}
+
+func (c *StorageConfig) tlsConfig() (*tls.Config, error) {
+	tlsConfig := &tls.Config{ServerName: c.ServerName}
+
+	// A CA pins the certificates Gitaly may present, otherwise the system's
+	// are trusted
+	if c.CACert != "" {
+		pem, err := ioutil.ReadFile(c.CACert)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't read ca_cert: %s", err)
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in ca_cert %s", c.CACert)
+		}
+
+		tlsConfig.RootCAs = pool
+	}
+
+	if (c.ClientCert == "") != (c.ClientKey == "") {
+		return nil, fmt.Errorf("client_cert and client_key must be set together")
+	}
+
+	if c.ClientCert != "" {
+		cert, err := tls.LoadX509KeyPair(c.ClientCert, c.ClientKey)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't load client_cert and client_key: %s", err)
+		}
+
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
+
+func (c *StorageConfig) rpcCredentials() (credentials.PerRPCCredentials, error) {
+	if c.TokenVersion == 0 || c.TokenVersion == 2 {
+		return gitalyauth.RPCCredentialsV2(c.Token), nil
+	}
+
+	return nil, fmt.Errorf("unknown token version %d", c.TokenVersion)
+}
+
+// dial connects to Gitaly, blocking until the connection is up so failures
+// are reported here rather than by the first RPC
+func dial(ctx context.Context, config *StorageConfig) (*grpc.ClientConn, error) {
+	config.setDefaults()
+
+	rpcCredentials, err := config.rpcCredentials()
+	if err != nil {
+		return nil, config.connectionError(InvalidConfig, err)
+	}
+
+	target, transportOpts, err := config.dialTarget()
+	if err != nil {
+		return nil, config.connectionError(InvalidConfig, err)
+	}
+
+	connOpts := []grpc.DialOption{
+		grpc.WithPerRPCCredentials(rpcCredentials),
+		grpc.WithBlock(),
+		grpc.FailOnNonTempDialError(true),
+		grpc.WithUnaryInterceptor(unaryMetricsInterceptor),
+		grpc.WithStreamInterceptor(streamMetricsInterceptor),
+	}
+	connOpts = append(connOpts, transportOpts...)
+
+	if config.KeepaliveTime > 0 {
+		connOpts = append(connOpts, grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:    time.Duration(config.KeepaliveTime) * time.Millisecond,
+			Timeout: time.Duration(config.KeepaliveTimeout) * time.Millisecond,
+		}))
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(config.DialTimeout)*time.Millisecond)
+	defer cancel()
+
+	conn, err := grpc.DialContext(ctx, target, connOpts...)
+	if err != nil {
+		return nil, config.connectionError(dialFailure(err), err)
+	}
+
+	return conn, nil
+}
+
+// dialTarget returns the gRPC target of a tcp://, tls:// or unix: address,
+// and how to connect to it, as gitalyclient.Dial would. It also supports the
+// configured TLS material, and the system's CAs are trusted if there is no
+// ca_cert.
+func (c *StorageConfig) dialTarget() (string, []grpc.DialOption, error) {
+	u, err := url.Parse(c.Address)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if c.hasTLSConfig() && u.Scheme != "tls" {
+		return "", nil, fmt.Errorf("TLS settings require a tls://host:port address")
+	}
+
+	switch u.Scheme {
+	case "unix":
+		path := strings.TrimPrefix(c.Address, "unix:")
+		dialer := func(ctx context.Context, _ string) (net.Conn, error) {
+			var d net.Dialer
+			return d.DialContext(ctx, "unix", path)
+		}
+
+		return c.Address, []grpc.DialOption{grpc.WithInsecure(), grpc.WithContextDialer(dialer)}, nil
+	case "tcp", "tls":
+		if u.Host == "" || u.Path != "" {
+			return "", nil, fmt.Errorf("%s addresses must be %s://host:port", u.Scheme, u.Scheme)
+		}
+	default:
+		return "", nil, fmt.Errorf("invalid connection string: %s", c.Address)
+	}
+
+	if u.Scheme == "tcp" {
+		return u.Host, []grpc.DialOption{grpc.WithInsecure()}, nil
+	}
+
+	tlsConfig, err := c.tlsConfig()
+	if err != nil {
+		return "", nil, err
+	}
+
+	creds := &permanentHandshakeErrors{credentials.NewTLS(tlsConfig)}
+
+	return u.Host, []grpc.DialOption{grpc.WithTransportCredentials(creds)}, nil
+}
+
+// permanentHandshakeErrors makes TLS handshake failures fail the dial right
+// away. gRPC would otherwise retry them, as if Gitaly was unreachable, until
+// the dial timeout.
+type permanentHandshakeErrors struct {
+	credentials.TransportCredentials
+}
+
+func (c *permanentHandshakeErrors) ClientHandshake(ctx context.Context, authority string, conn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	tlsConn, authInfo, err := c.TransportCredentials.ClientHandshake(ctx, authority, conn)
+	if err != nil {
+		var netErr net.Error
+		if !errors.As(err, &netErr) || !netErr.Timeout() {
+			err = handshakeError{err}
+		}
+	}
+
+	return tlsConn, authInfo, err
+}
+
+func (c *permanentHandshakeErrors) Clone() credentials.TransportCredentials {
+	return &permanentHandshakeErrors{c.TransportCredentials.Clone()}
+}
+
+type handshakeError struct {
+	error
+}
+
+func (handshakeError) Temporary() bool {
+	return false
+}
+
+// dialFailure classifies the errors of grpc.DialContext. Invalid addresses
+// are rejected before dialing, so it is either a failed TLS handshake, or
+// Gitaly was unreachable: the dial timed out or the connection was refused.
+func dialFailure(err error) ConnectionFailure {
+	// The connection errors of gRPC predate Unwrap, and expose the error of
+	// the handshake as their origin
+	if conn, ok := err.(interface{ Origin() error }); ok {
+		err = conn.Origin()
+	}
+
+	var handshake handshakeError
+	if errors.As(err, &handshake) {
+		return HandshakeFailed
+	}
+
+	return Unreachable
+}
+
+// rpcFailure returns a ConnectionError if an RPC failed because Gitaly
+// couldn't be reached or rejected the token, or nil
+func rpcFailure(address string, err error) error {
+	switch status.Code(err) {
+	case codes.Unauthenticated, codes.PermissionDenied:
+		return &ConnectionError{Address: address, Failure: Unauthenticated, Err: err}
+	case codes.Unavailable:
+		return &ConnectionError{Address: address, Failure: Unreachable, Err: err}
+	}
+
+	return nil
+}
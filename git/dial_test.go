@@ -0,0 +1,271 @@
+package git_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+
+	pb "gitlab.com/gitlab-org/gitaly/proto/go/gitalypb"
+	"gitlab.com/gitlab-org/gitlab-elasticsearch-indexer/git"
+)
+
+const fakeHeadSHA = "0123456789abcdef0123456789abcdef01234567"
+
+// fakeGitaly only answers the RPCs that resolve HEAD
+type fakeGitaly struct {
+	pb.UnimplementedRefServiceServer
+	pb.UnimplementedCommitServiceServer
+
+	unauthenticated bool
+}
+
+func (f *fakeGitaly) FindDefaultBranchName(ctx context.Context, req *pb.FindDefaultBranchNameRequest) (*pb.FindDefaultBranchNameResponse, error) {
+	if f.unauthenticated {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	return &pb.FindDefaultBranchNameResponse{Name: []byte("refs/heads/master")}, nil
+}
+
+func (f *fakeGitaly) FindCommit(ctx context.Context, req *pb.FindCommitRequest) (*pb.FindCommitResponse, error) {
+	return &pb.FindCommitResponse{Commit: &pb.GitCommit{Id: fakeHeadSHA}}, nil
+}
+
+func startFakeGitaly(t *testing.T, fake *fakeGitaly, opts ...grpc.ServerOption) (string, func()) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	return serveFakeGitaly(listener, fake, opts...)
+}
+
+func serveFakeGitaly(listener net.Listener, fake *fakeGitaly, opts ...grpc.ServerOption) (string, func()) {
+	server := grpc.NewServer(opts...)
+	pb.RegisterRefServiceServer(server, fake)
+	pb.RegisterCommitServiceServer(server, fake)
+
+	go server.Serve(listener)
+
+	return listener.Addr().String(), server.Stop
+}
+
+type testPKI struct {
+	dir        string
+	ca         *x509.Certificate
+	caKey      *ecdsa.PrivateKey
+	caPool     *x509.CertPool
+	serial     int64
+	caCertPath string
+}
+
+func newTestPKI(t *testing.T) (*testPKI, func()) {
+	dir, err := ioutil.TempDir("", "gitaly-tls")
+	require.NoError(t, err)
+
+	pki := &testPKI{dir: dir}
+	pki.ca, pki.caKey = pki.issue(t, "Test CA", nil, nil)
+	pki.caPool = x509.NewCertPool()
+	pki.caPool.AddCert(pki.ca)
+	pki.caCertPath, _ = pki.write(t, "ca", pki.ca, pki.caKey)
+
+	return pki, func() { os.RemoveAll(dir) }
+}
+
+// issue creates a certificate signed by the CA, or a self-signed CA if there
+// is none yet
+func (p *testPKI) issue(t *testing.T, name string, dnsNames []string, usage []x509.ExtKeyUsage) (*x509.Certificate, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	p.serial++
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(p.serial),
+		Subject:      pkix.Name{CommonName: name},
+		DNSNames:     dnsNames,
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:  usage,
+	}
+
+	parent, signer := template, key
+	if p.ca == nil {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+		template.KeyUsage |= x509.KeyUsageCertSign
+	} else {
+		parent, signer = p.ca, p.caKey
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, signer)
+	require.NoError(t, err)
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return cert, key
+}
+
+// write saves a certificate and its key as PEM files, and returns their paths
+func (p *testPKI) write(t *testing.T, name string, cert *x509.Certificate, key *ecdsa.PrivateKey) (string, string) {
+	certPath := filepath.Join(p.dir, name+".crt")
+	keyPath := filepath.Join(p.dir, name+".key")
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	require.NoError(t, ioutil.WriteFile(certPath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw}), 0600))
+	require.NoError(t, ioutil.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0600))
+
+	return certPath, keyPath
+}
+
+// serverCreds makes the fake Gitaly present a certificate for gitaly.test
+// and require a client certificate signed by the CA
+func (p *testPKI) serverCreds(t *testing.T) grpc.ServerOption {
+	cert, key := p.issue(t, "gitaly.test", []string{"gitaly.test"}, []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth})
+
+	return grpc.Creds(credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{{Certificate: [][]byte{cert.Raw}, PrivateKey: key}},
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    p.caPool,
+	}))
+}
+
+func requireConnectionError(t *testing.T, failure git.ConnectionFailure, err error) {
+	require.Error(t, err)
+
+	connErr, ok := err.(*git.ConnectionError)
+	require.True(t, ok, "%T: %v", err, err)
+	require.Equal(t, failure, connErr.Failure, err.Error())
+}
+
+func TestGitalyUnixSocket(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gitaly-socket")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	listener, err := net.Listen("unix", filepath.Join(dir, "gitaly.socket"))
+	require.NoError(t, err)
+
+	_, stop := serveFakeGitaly(listener, &fakeGitaly{})
+	defer stop()
+
+	config := &git.StorageConfig{Address: "unix:" + filepath.Join(dir, "gitaly.socket")}
+
+	client, err := git.NewGitalyClient(context.Background(), config, "", "")
+	require.NoError(t, err)
+	defer client.Close()
+
+	require.Equal(t, fakeHeadSHA, client.ToHash)
+}
+
+func TestGitalyTLS(t *testing.T) {
+	pki, cleanup := newTestPKI(t)
+	defer cleanup()
+
+	address, stop := startFakeGitaly(t, &fakeGitaly{}, pki.serverCreds(t))
+	defer stop()
+
+	clientCert, clientKey := pki.issue(t, "indexer", nil, []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth})
+	clientCertPath, clientKeyPath := pki.write(t, "client", clientCert, clientKey)
+
+	config := &git.StorageConfig{
+		Address:    "tls://" + address,
+		CACert:     pki.caCertPath,
+		ClientCert: clientCertPath,
+		ClientKey:  clientKeyPath,
+		ServerName: "gitaly.test",
+	}
+
+	client, err := git.NewGitalyClient(context.Background(), config, "", "")
+	require.NoError(t, err)
+	defer client.Close()
+
+	require.Equal(t, fakeHeadSHA, client.ToHash)
+}
+
+func TestGitalyTLSUntrustedServer(t *testing.T) {
+	pki, cleanup := newTestPKI(t)
+	defer cleanup()
+
+	address, stop := startFakeGitaly(t, &fakeGitaly{}, pki.serverCreds(t))
+	defer stop()
+
+	// The certificate of the server isn't signed by a CA of the system
+	config := &git.StorageConfig{Address: "tls://" + address, ServerName: "gitaly.test", DialTimeout: 2000}
+
+	_, err := git.NewGitalyClient(context.Background(), config, "", "")
+	requireConnectionError(t, git.HandshakeFailed, err)
+}
+
+func TestGitalyUnauthenticated(t *testing.T) {
+	address, stop := startFakeGitaly(t, &fakeGitaly{unauthenticated: true})
+	defer stop()
+
+	config := &git.StorageConfig{Address: "tcp://" + address, Token: "wrong"}
+
+	_, err := git.NewGitalyClient(context.Background(), config, "", "")
+	requireConnectionError(t, git.Unauthenticated, err)
+}
+
+func TestGitalyUnreachable(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	address := listener.Addr().String()
+	listener.Close()
+
+	config := &git.StorageConfig{Address: "tcp://" + address, DialTimeout: 2000}
+
+	_, err = git.NewGitalyClient(context.Background(), config, "", "")
+	requireConnectionError(t, git.Unreachable, err)
+}
+
+func TestGitalyDialStopsWithContext(t *testing.T) {
+	// Accepts connections, but never completes a TLS handshake
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+
+	config := &git.StorageConfig{Address: "tls://" + listener.Addr().String(), DialTimeout: 60000}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err = git.NewGitalyClient(ctx, config, "", "")
+	requireConnectionError(t, git.Unreachable, err)
+	require.True(t, time.Since(start) < 10*time.Second)
+}
+
+func TestGitalyInvalidConfig(t *testing.T) {
+	for _, config := range []*git.StorageConfig{
+		{Address: "http://localhost:8075"},
+		{Address: "tcp://localhost:8075/path"},
+		{Address: "tcp://localhost:8075", ServerName: "gitaly.test"},
+		{Address: "tcp://localhost:8075", TokenVersion: 3},
+		{Address: "tcp://localhost:8075", CACert: "/nonexistent/ca.crt"},
+		{Address: "tls://localhost:8075", CACert: "/nonexistent/ca.crt"},
+		{Address: "tls://localhost:8075", ClientCert: "/nonexistent/client.crt"},
+	} {
+		_, err := git.NewGitalyClient(context.Background(), config, "", "")
+		requireConnectionError(t, git.InvalidConfig, err)
+	}
+}
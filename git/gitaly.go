@@ -3,7 +3,6 @@ package git
 import (
 	"bytes"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -14,16 +13,22 @@ import (
 	log "github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
 
-	gitalyauth "gitlab.com/gitlab-org/gitaly/auth"
-	gitalyclient "gitlab.com/gitlab-org/gitaly/client"
 	pb "gitlab.com/gitlab-org/gitaly/proto/go/gitalypb"
+	"gitlab.com/gitlab-org/gitlab-elasticsearch-indexer/logging"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const SubmoduleFileMode = 0160000
 const LimitFileSize = 1024 * 1024
 
+// Blobs are fetched from Gitaly in batches to save round trips. These bound
+// the number of blobs requested at once, and how much blob data a batch may
+// hold in memory.
+const BlobBatchSize = 100
+const BlobBatchBytes = 10 * LimitFileSize
+
 // See https://stackoverflow.com/questions/9765453/is-gits-semi-secret-empty-tree-object-reliable-and-why-is-there-not-a-symbolic
 const NullTreeSHA = "4b825dc642cb6eb9a060e54bf8d69288fbee4904"
 const ZeroSHA = "0000000000000000000000000000000000000000"
@@ -34,9 +39,23 @@ type StorageConfig struct {
 	StorageName  string `json:"storage"`
 	RelativePath string `json:"relative_path"`
 	TokenVersion int    `json:"token_version"`
+
+	// Paths of PEM files, used with a tls:// address. ServerName overrides
+	// the name the certificate of Gitaly is verified against.
+	CACert     string `json:"ca_cert"`
+	ClientCert string `json:"client_cert"`
+	ClientKey  string `json:"client_key"`
+	ServerName string `json:"server_name"`
+
+	// Timeouts are in milliseconds. A negative KeepaliveTime disables
+	// keepalive pings.
+	DialTimeout      int `json:"dial_timeout_ms"`
+	KeepaliveTime    int `json:"keepalive_time_ms"`
+	KeepaliveTimeout int `json:"keepalive_timeout_ms"`
 }
 
 type gitalyClient struct {
+	address                 string
 	conn                    *grpc.ClientConn
 	repository              *pb.Repository
 	blobServiceClient       pb.BlobServiceClient
@@ -48,22 +67,10 @@ type gitalyClient struct {
 	ToHash   string
 }
 
-func NewGitalyClient(config *StorageConfig, fromSHA, toSHA string) (*gitalyClient, error) {
-	var RPCCred credentials.PerRPCCredentials
-	if config.TokenVersion == 0 || config.TokenVersion == 2 {
-		RPCCred = gitalyauth.RPCCredentialsV2(config.Token)
-	} else {
-		return nil, errors.New("Unknown token version")
-	}
-
-	connOpts := append(
-		gitalyclient.DefaultDialOpts,
-		grpc.WithPerRPCCredentials(RPCCred),
-	)
-
-	conn, err := gitalyclient.Dial(config.Address, connOpts)
+func NewGitalyClient(ctx context.Context, config *StorageConfig, fromSHA, toSHA string) (*gitalyClient, error) {
+	conn, err := dial(ctx, config)
 	if err != nil {
-		return nil, fmt.Errorf("did not connect: %s", err)
+		return nil, err
 	}
 
 	repository := &pb.Repository{
@@ -72,6 +79,7 @@ func NewGitalyClient(config *StorageConfig, fromSHA, toSHA string) (*gitalyClien
 	}
 
 	client := &gitalyClient{
+		address:                 config.Address,
 		conn:                    conn,
 		repository:              repository,
 		blobServiceClient:       pb.NewBlobServiceClient(conn),
@@ -87,8 +95,14 @@ func NewGitalyClient(config *StorageConfig, fromSHA, toSHA string) (*gitalyClien
 	}
 
 	if toSHA == "" {
-		head, err := client.lookUpHEAD()
+		head, err := client.lookUpHEAD(ctx)
 		if err != nil {
+			conn.Close()
+
+			if _, ok := err.(*ConnectionError); ok {
+				return nil, err
+			}
+
 			return nil, fmt.Errorf("lookUpHEAD: %v", err)
 		}
 		client.ToHash = head
@@ -99,7 +113,7 @@ func NewGitalyClient(config *StorageConfig, fromSHA, toSHA string) (*gitalyClien
 	return client, nil
 }
 
-func NewGitalyClientFromEnv(projectPath, fromSHA, toSHA string) (*gitalyClient, error) {
+func NewGitalyClientFromEnv(ctx context.Context, projectPath, fromSHA, toSHA string) (*gitalyClient, error) {
 	data := strings.NewReader(os.Getenv("GITALY_CONNECTION_INFO"))
 
 	config := StorageConfig{RelativePath: projectPath}
@@ -108,7 +122,10 @@ func NewGitalyClientFromEnv(projectPath, fromSHA, toSHA string) (*gitalyClient,
 		return nil, err
 	}
 
-	client, err := NewGitalyClient(&config, fromSHA, toSHA)
+	client, err := NewGitalyClient(ctx, &config, fromSHA, toSHA)
+	if _, ok := err.(*ConnectionError); ok {
+		return nil, err
+	}
 	if err != nil {
 		return nil, fmt.Errorf("Failed to open %s: %s", config.RelativePath, err)
 	}
@@ -120,18 +137,21 @@ func (gc *gitalyClient) Close() {
 	gc.conn.Close()
 }
 
-func (gc *gitalyClient) EachFileChange(put PutFunc, del DelFunc) error {
+func (gc *gitalyClient) EachFileChange(ctx context.Context, put PutFunc, putSubmodule PutSubmoduleFunc, del DelFunc) error {
 	request := &pb.GetRawChangesRequest{
 		Repository:   gc.repository,
 		FromRevision: gc.FromHash,
 		ToRevision:   gc.ToHash,
 	}
 
-	stream, err := gc.repositoryServiceClient.GetRawChanges(context.Background(), request)
+	stream, err := gc.repositoryServiceClient.GetRawChanges(ctx, request)
 	if err != nil {
 		return fmt.Errorf("could not call rpc.GetRawChanges: %v", err)
 	}
 
+	submodules := &submoduleURLs{read: func() ([]byte, error) { return gc.ReadFile(ctx, ".gitmodules") }}
+	batch := &blobBatch{ctx: ctx, client: gc, put: put}
+
 	for {
 		c, err := stream.Recv()
 		if err == io.EOF {
@@ -141,42 +161,141 @@ func (gc *gitalyClient) EachFileChange(put PutFunc, del DelFunc) error {
 			return fmt.Errorf("%v.GetRawChanges, %v", c, err)
 		}
 		for _, change := range c.RawChanges {
-			// TODO: We just skip submodules from indexing now just to mirror the go-git
-			// implementation but it can be not that expensive to implement with gitaly actually so some
-			// investigation is required here
-			if change.OldMode == SubmoduleFileMode || change.NewMode == SubmoduleFileMode {
-				continue
-			}
-
 			switch change.Operation.String() {
 			case "DELETED", "RENAMED":
 				path := string(change.OldPath)
-				log.Debug("Indexing blob change: ", "DELETE", path)
+				log.WithField(logging.Path, path).Debug("Indexing blob change: DELETE")
 				if err = del(path); err != nil {
 					return err
 				}
 			}
 
 			switch change.Operation.String() {
-			case "ADDED", "RENAMED", "MODIFIED", "COPIED":
-				file, err := gc.gitalyBuildFile(change, string(change.NewPath))
-				if err != nil {
-					return err
+			case "ADDED", "RENAMED", "MODIFIED", "COPIED", "TYPE_CHANGED":
+				path := string(change.NewPath)
+
+				if change.NewMode == SubmoduleFileMode {
+					url, err := submodules.lookup(path)
+					if err != nil {
+						return err
+					}
+
+					submodule := &Submodule{Path: path, CommitSHA: change.BlobId, URL: url}
+					log.WithField(logging.Path, submodule.Path).Debug("Indexing submodule change: PUT")
+					if err = putSubmodule(submodule, gc.FromHash, gc.ToHash); err != nil {
+						return err
+					}
+					continue
 				}
-				log.Debug("Indexing blob change: ", "PUT", file.Path)
-				if err = put(file, gc.FromHash, gc.ToHash); err != nil {
+
+				if err = batch.add(gc.gitalyBuildFile(ctx, change, path)); err != nil {
 					return err
 				}
 			}
 		}
 	}
+
+	return batch.flush()
+}
+
+// blobBatch holds back files passed to EachFileChange until their content
+// has been fetched with a single GetBlobs call
+type blobBatch struct {
+	ctx    context.Context
+	client *gitalyClient
+	put    PutFunc
+
+	files []*File
+	paths []*pb.GetBlobsRequest_RevisionPath
+	bytes int64
+}
+
+func (b *blobBatch) add(file *File) error {
+	b.files = append(b.files, file)
+
+	// Blobs that are too large will be rejected by the indexer, so we don't
+	// fetch them at all. They keep their lazy reader.
+	if file.Size <= LimitFileSize {
+		b.paths = append(b.paths, &pb.GetBlobsRequest_RevisionPath{
+			Revision: b.client.ToHash,
+			Path:     []byte(file.Path),
+		})
+		b.bytes += file.Size
+	}
+
+	if len(b.files) >= BlobBatchSize || b.bytes >= BlobBatchBytes {
+		return b.flush()
+	}
+
+	return nil
+}
+
+func (b *blobBatch) flush() error {
+	if len(b.files) == 0 {
+		return nil
+	}
+
+	blobs, err := b.client.getBlobs(b.ctx, b.paths)
+	if err != nil {
+		return err
+	}
+
+	for _, file := range b.files {
+		// Fall back to the lazy reader if the blob wasn't returned
+		if data, ok := blobs[file.Path]; ok && file.Size <= LimitFileSize {
+			file.Blob = getBlobReader(data)
+		}
+
+		log.WithFields(log.Fields{logging.Path: file.Path, logging.OID: file.Oid}).Debug("Indexing blob change: PUT")
+		if err := b.put(file, b.client.FromHash, b.client.ToHash); err != nil {
+			return err
+		}
+	}
+
+	b.files = nil
+	b.paths = nil
+	b.bytes = 0
+
 	return nil
 }
 
+// ReadFile returns the content of the file at path in ToHash, or nothing if
+// the file doesn't exist
+func (gc *gitalyClient) ReadFile(ctx context.Context, path string) ([]byte, error) {
+	request := &pb.TreeEntryRequest{
+		Repository: gc.repository,
+		Revision:   []byte(gc.ToHash),
+		Path:       []byte(path),
+		Limit:      LimitFileSize,
+	}
+
+	stream, err := gc.commitServiceClient.TreeEntry(ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("could not call rpc.TreeEntry: %v", err)
+	}
+
+	data := new(bytes.Buffer)
+	for {
+		c, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if status.Code(err) == codes.NotFound {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error calling rpc.TreeEntry: %v", err)
+		}
+		data.Write(c.Data)
+	}
+
+	return data.Bytes(), nil
+}
+
 // HEAD is not always set in some cases, so we find the last commit in
 // a default branch instead
-func (gc *gitalyClient) lookUpHEAD() (string, error) {
-	defaultBranchName, err := gc.findDefaultBranchName()
+func (gc *gitalyClient) lookUpHEAD(ctx context.Context) (string, error) {
+	defaultBranchName, err := gc.findDefaultBranchName(ctx)
 	if err != nil {
 		return "", err
 	}
@@ -186,90 +305,194 @@ func (gc *gitalyClient) lookUpHEAD() (string, error) {
 		Revision:   defaultBranchName,
 	}
 
-	response, err := gc.commitServiceClient.FindCommit(context.Background(), request)
+	response, err := gc.commitServiceClient.FindCommit(ctx, request)
+	if connErr := rpcFailure(gc.address, err); connErr != nil {
+		return "", connErr
+	}
 	if err != nil {
 		return "", fmt.Errorf("Cannot look up HEAD: %v", err)
 	}
 	return response.Commit.Id, nil
 }
 
-func (gc *gitalyClient) findDefaultBranchName() ([]byte, error) {
+func (gc *gitalyClient) CommitTime(ctx context.Context, sha string) (time.Time, error) {
+	request := &pb.FindCommitRequest{
+		Repository: gc.repository,
+		Revision:   []byte(sha),
+	}
+
+	response, err := gc.commitServiceClient.FindCommit(ctx, request)
+	if connErr := rpcFailure(gc.address, err); connErr != nil {
+		return time.Time{}, connErr
+	}
+	if err != nil {
+		return time.Time{}, fmt.Errorf("Cannot look up commit %s: %v", sha, err)
+	}
+	if response.Commit == nil {
+		return time.Time{}, fmt.Errorf("Cannot look up commit %s: not found", sha)
+	}
+
+	return time.Unix(response.Commit.GetCommitter().GetDate().GetSeconds(), 0), nil
+}
+
+func (gc *gitalyClient) findDefaultBranchName(ctx context.Context) ([]byte, error) {
 	request := &pb.FindDefaultBranchNameRequest{
 		Repository: gc.repository,
 	}
 
-	response, err := gc.refServiceClient.FindDefaultBranchName(context.Background(), request)
+	response, err := gc.refServiceClient.FindDefaultBranchName(ctx, request)
+	if connErr := rpcFailure(gc.address, err); connErr != nil {
+		return nil, connErr
+	}
 	if err != nil {
 		return nil, fmt.Errorf("Cannot find a default branch: %v", err)
 	}
 	return response.Name, nil
 }
 
-func (gc *gitalyClient) getBlob(oid string) (io.ReadCloser, error) {
-	data := new(bytes.Buffer)
+// blobStream streams the content of a blob from GetBlob as it is read
+type blobStream struct {
+	stream pb.BlobService_GetBlobClient
+	cancel context.CancelFunc
+	oid    string
+	data   []byte
+}
+
+func (bs *blobStream) Read(p []byte) (int, error) {
+	for len(bs.data) == 0 {
+		c, err := bs.stream.Recv()
+		if err == io.EOF {
+			return 0, io.EOF
+		}
+		if err != nil {
+			return 0, fmt.Errorf("%v.GetBlob: %v", bs.oid, err)
+		}
+		bs.data = c.Data
+	}
+
+	n := copy(p, bs.data)
+	bs.data = bs.data[n:]
 
+	return n, nil
+}
+
+// Close abandons the stream, so unread chunks are never sent
+func (bs *blobStream) Close() error {
+	bs.cancel()
+	return nil
+}
+
+func (gc *gitalyClient) getBlob(ctx context.Context, oid string) (io.ReadCloser, error) {
 	request := &pb.GetBlobRequest{
 		Repository: gc.repository,
 		Oid:        oid,
 		Limit:      LimitFileSize,
 	}
 
-	stream, err := gc.blobServiceClient.GetBlob(context.Background(), request)
+	ctx, cancel := context.WithCancel(ctx)
+
+	stream, err := gc.blobServiceClient.GetBlob(ctx, request)
 	if err != nil {
+		cancel()
 		return nil, fmt.Errorf("Cannot get blob: %s", oid)
 	}
 
+	return &blobStream{stream: stream, cancel: cancel, oid: oid}, nil
+}
+
+// getBlobs fetches several blobs at once, returning their content by path
+func (gc *gitalyClient) getBlobs(ctx context.Context, paths []*pb.GetBlobsRequest_RevisionPath) (map[string][]byte, error) {
+	blobs := make(map[string][]byte)
+	if len(paths) == 0 {
+		return blobs, nil
+	}
+
+	request := &pb.GetBlobsRequest{
+		Repository:    gc.repository,
+		RevisionPaths: paths,
+		Limit:         LimitFileSize,
+	}
+
+	stream, err := gc.blobServiceClient.GetBlobs(ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("could not call rpc.GetBlobs: %v", err)
+	}
+
+	// Only the first message for each blob carries its path, the rest are
+	// data chunks for the same blob
+	var current *bytes.Buffer
+	var currentPath string
+
 	for {
 		c, err := stream.Recv()
 		if err == io.EOF {
 			break
 		}
 		if err != nil {
-			return nil, fmt.Errorf("%v.GetBlob: %v", c, err)
+			return nil, fmt.Errorf("error calling rpc.GetBlobs: %v", err)
+		}
+
+		if len(c.Path) > 0 {
+			if current != nil {
+				blobs[currentPath] = current.Bytes()
+			}
+
+			current = nil
+			currentPath = string(c.Path)
+
+			// An empty OID means the blob wasn't found
+			if c.Oid != "" {
+				current = new(bytes.Buffer)
+			}
 		}
-		if c.Data != nil {
-			data.Write(c.Data)
+
+		if current != nil {
+			current.Write(c.Data)
 		}
 	}
 
-	return ioutil.NopCloser(data), nil
+	if current != nil {
+		blobs[currentPath] = current.Bytes()
+	}
+
+	return blobs, nil
 }
 
-func (gc *gitalyClient) gitalyBuildFile(change *pb.GetRawChangesResponse_RawChange, path string) (*File, error) {
-	var data io.ReadCloser
-	// We limit the size to avoid loading too big blobs into memory
-	// as they will be rejected on the indexer side anyway
-	// Ideally, we need to create a lazy blob reader here.
-	if change.Size > LimitFileSize {
-		data = ioutil.NopCloser(new(bytes.Buffer))
-	} else {
-		var err error
-		data, err = gc.getBlob(change.BlobId)
-		if err != nil {
-			return nil, fmt.Errorf("getBlob returns error: %v", err)
-		}
-	}
+func getBlobReader(data []byte) func() (io.ReadCloser, error) {
+	return func() (io.ReadCloser, error) { return ioutil.NopCloser(bytes.NewReader(data)), nil }
+}
 
+// gitalyBuildFile doesn't fetch the blob. The GetBlob stream is only opened
+// when the blob is read, so blobs rejected by the indexer, e.g. because they
+// are too large, are never loaded into memory.
+func (gc *gitalyClient) gitalyBuildFile(ctx context.Context, change *pb.GetRawChangesResponse_RawChange, path string) *File {
 	return &File{
 		Path: path,
 		Oid:  change.BlobId,
-		Blob: getBlobReader(data),
+		Blob: gc.blobReader(ctx, change.BlobId),
 		Size: change.Size,
-	}, nil
+	}
 }
 
-func getBlobReader(data io.ReadCloser) func() (io.ReadCloser, error) {
-	return func() (io.ReadCloser, error) { return data, nil }
+func (gc *gitalyClient) blobReader(ctx context.Context, oid string) func() (io.ReadCloser, error) {
+	return func() (io.ReadCloser, error) {
+		data, err := gc.getBlob(ctx, oid)
+		if err != nil {
+			return nil, fmt.Errorf("getBlob returns error: %v", err)
+		}
+
+		return data, nil
+	}
 }
 
-func (gc *gitalyClient) EachCommit(f CommitFunc) error {
+func (gc *gitalyClient) EachCommit(ctx context.Context, f CommitFunc) error {
 	request := &pb.CommitsBetweenRequest{
 		Repository: gc.repository,
 		From:       []byte(gc.FromHash),
 		To:         []byte(gc.ToHash),
 	}
 
-	stream, err := gc.commitServiceClient.CommitsBetween(context.Background(), request)
+	stream, err := gc.commitServiceClient.CommitsBetween(ctx, request)
 	if err != nil {
 		return fmt.Errorf("could not call rpc.CommitsBetween: %v", err)
 	}
@@ -290,7 +513,7 @@ func (gc *gitalyClient) EachCommit(f CommitFunc) error {
 				Committer: gitalyBuildSignature(cmt.Committer),
 			}
 
-			log.Debug("Indexing commit: ", cmt.Id)
+			log.WithField(logging.CommitSHA, commit.Hash).Debug("Indexing commit")
 
 			if err := f(commit); err != nil {
 				return err
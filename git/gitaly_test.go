@@ -0,0 +1,444 @@
+package git
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "gitlab.com/gitlab-org/gitaly/proto/go/gitalypb"
+
+	"gitlab.com/gitlab-org/gitlab-elasticsearch-indexer/metrics"
+)
+
+// fakeBlobServiceClient serves GetBlob and GetBlobs from memory.
+// Unimplemented RPCs of the embedded interface panic.
+type fakeBlobServiceClient struct {
+	pb.BlobServiceClient
+
+	// Chunks sent by GetBlob, followed by err, or io.EOF if it's nil
+	chunks []string
+	err    error
+
+	// The context of the last GetBlob call
+	ctx context.Context
+
+	// Blobs served by GetBlobs by path, in chunks of up to 4 bytes
+	blobs map[string]string
+
+	// The paths of each GetBlobs call
+	requests [][]string
+	events   *[]string
+}
+
+func (c *fakeBlobServiceClient) GetBlob(ctx context.Context, in *pb.GetBlobRequest, opts ...grpc.CallOption) (pb.BlobService_GetBlobClient, error) {
+	c.ctx = ctx
+
+	return &fakeGetBlobClient{ctx: ctx, chunks: c.chunks, err: c.err}, nil
+}
+
+type fakeGetBlobClient struct {
+	grpc.ClientStream
+
+	ctx    context.Context
+	chunks []string
+	err    error
+}
+
+func (s *fakeGetBlobClient) Recv() (*pb.GetBlobResponse, error) {
+	if err := s.ctx.Err(); err != nil {
+		return nil, status.FromContextError(err).Err()
+	}
+
+	if len(s.chunks) == 0 {
+		if s.err != nil {
+			return nil, s.err
+		}
+
+		return nil, io.EOF
+	}
+
+	chunk := s.chunks[0]
+	s.chunks = s.chunks[1:]
+
+	return &pb.GetBlobResponse{Data: []byte(chunk)}, nil
+}
+
+func (c *fakeBlobServiceClient) GetBlobs(ctx context.Context, in *pb.GetBlobsRequest, opts ...grpc.CallOption) (pb.BlobService_GetBlobsClient, error) {
+	var paths []string
+	var responses []*pb.GetBlobsResponse
+
+	for _, revisionPath := range in.RevisionPaths {
+		path := string(revisionPath.Path)
+		paths = append(paths, path)
+
+		data, ok := c.blobs[path]
+		if !ok {
+			responses = append(responses, &pb.GetBlobsResponse{Path: revisionPath.Path})
+			continue
+		}
+
+		// Only the first chunk of a blob carries its path
+		chunk := &pb.GetBlobsResponse{Path: revisionPath.Path, Oid: "oid-" + path, Size: int64(len(data))}
+		for {
+			n := 4
+			if len(data) < n {
+				n = len(data)
+			}
+
+			chunk.Data = []byte(data[:n])
+			data = data[n:]
+			responses = append(responses, chunk)
+
+			if len(data) == 0 {
+				break
+			}
+			chunk = &pb.GetBlobsResponse{}
+		}
+	}
+
+	c.requests = append(c.requests, paths)
+	if c.events != nil {
+		*c.events = append(*c.events, "GetBlobs")
+	}
+
+	return &fakeGetBlobsClient{responses: responses}, nil
+}
+
+type fakeGetBlobsClient struct {
+	grpc.ClientStream
+
+	responses []*pb.GetBlobsResponse
+}
+
+func (s *fakeGetBlobsClient) Recv() (*pb.GetBlobsResponse, error) {
+	if len(s.responses) == 0 {
+		return nil, io.EOF
+	}
+
+	response := s.responses[0]
+	s.responses = s.responses[1:]
+
+	return response, nil
+}
+
+// fakeRepositoryServiceClient serves GetRawChanges from memory, one change
+// per message
+type fakeRepositoryServiceClient struct {
+	pb.RepositoryServiceClient
+
+	changes []*pb.GetRawChangesResponse_RawChange
+}
+
+func (c *fakeRepositoryServiceClient) GetRawChanges(ctx context.Context, in *pb.GetRawChangesRequest, opts ...grpc.CallOption) (pb.RepositoryService_GetRawChangesClient, error) {
+	return &fakeGetRawChangesClient{changes: c.changes}, nil
+}
+
+type fakeGetRawChangesClient struct {
+	grpc.ClientStream
+
+	changes []*pb.GetRawChangesResponse_RawChange
+}
+
+func (s *fakeGetRawChangesClient) Recv() (*pb.GetRawChangesResponse, error) {
+	if len(s.changes) == 0 {
+		return nil, io.EOF
+	}
+
+	change := s.changes[0]
+	s.changes = s.changes[1:]
+
+	return &pb.GetRawChangesResponse{RawChanges: []*pb.GetRawChangesResponse_RawChange{change}}, nil
+}
+
+func openBlob(t *testing.T, blobs *fakeBlobServiceClient) io.ReadCloser {
+	gc := &gitalyClient{blobServiceClient: blobs}
+
+	blob, err := gc.blobReader(context.Background(), "abc123")()
+	require.NoError(t, err)
+
+	return blob
+}
+
+func TestBlobStreamReadsAllChunks(t *testing.T) {
+	blobs := &fakeBlobServiceClient{chunks: []string{"foo", "", "bar", "baz"}}
+	blob := openBlob(t, blobs)
+
+	data, err := ioutil.ReadAll(blob)
+	require.NoError(t, err)
+	require.Equal(t, "foobarbaz", string(data))
+
+	// Reads after the end keep returning EOF
+	_, err = blob.Read(make([]byte, 1))
+	require.Equal(t, io.EOF, err)
+
+	require.NoError(t, blob.Close())
+}
+
+func TestBlobStreamClosedAfterPartialRead(t *testing.T) {
+	blobs := &fakeBlobServiceClient{chunks: []string{"foo", "bar"}}
+	blob := openBlob(t, blobs)
+
+	data := make([]byte, 2)
+	_, err := io.ReadFull(blob, data)
+	require.NoError(t, err)
+	require.Equal(t, "fo", string(data))
+	require.NoError(t, blobs.ctx.Err())
+
+	// Closing cancels the stream, so the remaining chunks are never sent
+	require.NoError(t, blob.Close())
+	require.Equal(t, context.Canceled, blobs.ctx.Err())
+}
+
+func TestBlobStreamErrorMidStream(t *testing.T) {
+	blobs := &fakeBlobServiceClient{
+		chunks: []string{"foo"},
+		err:    status.Error(codes.Unavailable, "connection lost"),
+	}
+	blob := openBlob(t, blobs)
+	defer blob.Close()
+
+	data := make([]byte, 3)
+	n, err := blob.Read(data)
+	require.NoError(t, err)
+	require.Equal(t, "foo", string(data[:n]))
+
+	_, err = blob.Read(data)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "abc123.GetBlob")
+	require.Contains(t, err.Error(), "connection lost")
+}
+
+// newTestBatch returns a batch that collects the paths and content of the
+// files it puts
+func newTestBatch(blobs *fakeBlobServiceClient) (*blobBatch, map[string]string) {
+	gc := &gitalyClient{blobServiceClient: blobs, ToHash: "b83d6e3"}
+	put := make(map[string]string)
+
+	batch := &blobBatch{
+		ctx:    context.Background(),
+		client: gc,
+		put: func(file *File, _, _ string) error {
+			put[file.Path] = ""
+			if file.Size > LimitFileSize {
+				return nil
+			}
+
+			blob, err := file.Blob()
+			if err != nil {
+				return err
+			}
+			defer blob.Close()
+
+			data, err := ioutil.ReadAll(blob)
+			put[file.Path] = string(data)
+
+			return err
+		},
+	}
+
+	return batch, put
+}
+
+func TestBlobBatchFlushesAtBatchSize(t *testing.T) {
+	blobs := &fakeBlobServiceClient{blobs: make(map[string]string)}
+	batch, put := newTestBatch(blobs)
+
+	for i := 0; i < BlobBatchSize+1; i++ {
+		path := fmt.Sprintf("file%d", i)
+		blobs.blobs[path] = path
+
+		require.NoError(t, batch.add(&File{Path: path, Size: int64(len(path))}))
+
+		if i < BlobBatchSize-1 {
+			require.Empty(t, blobs.requests)
+			require.Empty(t, put)
+		}
+	}
+
+	require.Len(t, blobs.requests, 1)
+	require.Len(t, blobs.requests[0], BlobBatchSize)
+	require.Len(t, put, BlobBatchSize)
+
+	require.NoError(t, batch.flush())
+	require.Len(t, blobs.requests, 2)
+	require.Equal(t, []string{"file100"}, blobs.requests[1])
+	require.Equal(t, "file100", put["file100"])
+
+	// Flushing an empty batch doesn't call GetBlobs
+	require.NoError(t, batch.flush())
+	require.Len(t, blobs.requests, 2)
+}
+
+func TestBlobBatchFlushesAtBatchBytes(t *testing.T) {
+	blobs := &fakeBlobServiceClient{blobs: make(map[string]string)}
+	batch, put := newTestBatch(blobs)
+
+	// Too large to be indexed, so neither fetched nor counted
+	require.NoError(t, batch.add(&File{Path: "large", Size: LimitFileSize + 1}))
+
+	files := int(BlobBatchBytes / LimitFileSize)
+	for i := 0; i < files; i++ {
+		path := fmt.Sprintf("file%d", i)
+		blobs.blobs[path] = path
+
+		require.Empty(t, blobs.requests)
+		require.NoError(t, batch.add(&File{Path: path, Size: LimitFileSize}))
+	}
+
+	require.Len(t, blobs.requests, 1)
+	require.Len(t, blobs.requests[0], files)
+	require.NotContains(t, blobs.requests[0], "large")
+	require.Len(t, put, files+1)
+}
+
+func TestGetBlobsReassemblesChunks(t *testing.T) {
+	blobs := &fakeBlobServiceClient{
+		blobs: map[string]string{
+			"README.md": "testme\n======\n",
+			"empty":     "",
+			"short":     "foo",
+		},
+	}
+	gc := &gitalyClient{blobServiceClient: blobs}
+
+	var paths []*pb.GetBlobsRequest_RevisionPath
+	for _, path := range []string{"README.md", "missing", "empty", "short"} {
+		paths = append(paths, &pb.GetBlobsRequest_RevisionPath{Revision: "b83d6e3", Path: []byte(path)})
+	}
+
+	data, err := gc.getBlobs(context.Background(), paths)
+	require.NoError(t, err)
+
+	expected := map[string][]byte{
+		"README.md": []byte("testme\n======\n"),
+		"empty":     nil,
+		"short":     []byte("foo"),
+	}
+	require.Equal(t, len(expected), len(data))
+	for path, content := range expected {
+		require.Contains(t, data, path)
+		require.Equal(t, string(content), string(data[path]), path)
+	}
+}
+
+func TestEachFileChangeBatchesPutsButNotDeletes(t *testing.T) {
+	var events []string
+
+	blobs := &fakeBlobServiceClient{
+		blobs:  map[string]string{"added": "added content", "modified": "modified content", "new": "renamed content"},
+		events: &events,
+	}
+	repository := &fakeRepositoryServiceClient{
+		changes: []*pb.GetRawChangesResponse_RawChange{
+			{Operation: pb.GetRawChangesResponse_RawChange_ADDED, NewPath: "added", Size: 13},
+			{Operation: pb.GetRawChangesResponse_RawChange_DELETED, OldPath: "deleted"},
+			{Operation: pb.GetRawChangesResponse_RawChange_MODIFIED, NewPath: "modified", Size: 16},
+			{Operation: pb.GetRawChangesResponse_RawChange_RENAMED, OldPath: "old", NewPath: "new", Size: 15},
+		},
+	}
+	gc := &gitalyClient{blobServiceClient: blobs, repositoryServiceClient: repository, ToHash: "b83d6e3"}
+
+	put := func(file *File, _, _ string) error {
+		blob, err := file.Blob()
+		if err != nil {
+			return err
+		}
+		defer blob.Close()
+
+		data, err := ioutil.ReadAll(blob)
+		events = append(events, "put "+file.Path+": "+string(data))
+
+		return err
+	}
+	del := func(path string) error {
+		events = append(events, "del "+path)
+		return nil
+	}
+
+	require.NoError(t, gc.EachFileChange(context.Background(), put, nil, del))
+
+	require.Equal(t, []string{
+		"del deleted",
+		"del old",
+		"GetBlobs",
+		"put added: added content",
+		"put modified: modified content",
+		"put new: renamed content",
+	}, events)
+}
+
+// rpcCount returns how many calls of method ended with code
+func rpcCount(t *testing.T, method string, code codes.Code) uint64 {
+	families, err := metrics.Registry.Gather()
+	require.NoError(t, err)
+
+	for _, family := range families {
+		if family.GetName() != metrics.Namespace+"_gitaly_rpc_duration_seconds" {
+			continue
+		}
+
+		for _, metric := range family.GetMetric() {
+			labels := make(map[string]string)
+			for _, label := range metric.GetLabel() {
+				labels[label.GetName()] = label.GetValue()
+			}
+
+			if labels["method"] == method && labels["code"] == code.String() {
+				return metric.GetHistogram().GetSampleCount()
+			}
+		}
+	}
+
+	return 0
+}
+
+// fakeClientStream receives empty messages until its context is done
+type fakeClientStream struct {
+	grpc.ClientStream
+
+	ctx context.Context
+}
+
+func (s *fakeClientStream) RecvMsg(m interface{}) error {
+	if err := s.ctx.Err(); err != nil {
+		return status.FromContextError(err).Err()
+	}
+
+	return nil
+}
+
+func TestStreamMetricsObserveCancelledStreams(t *testing.T) {
+	const method = "/gitaly.BlobService/GetBlob"
+	before := rpcCount(t, method, codes.Canceled)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	streamer := func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		return &fakeClientStream{ctx: ctx}, nil
+	}
+
+	stream, err := streamMetricsInterceptor(ctx, &grpc.StreamDesc{ServerStreams: true}, nil, method, streamer)
+	require.NoError(t, err)
+
+	// Closing a blob after a partial read cancels its stream, which is then
+	// observed although RecvMsg never fails
+	require.NoError(t, stream.RecvMsg(&pb.GetBlobResponse{}))
+	cancel()
+
+	deadline := time.Now().Add(time.Second)
+	for rpcCount(t, method, codes.Canceled) == before && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	require.Equal(t, before+1, rpcCount(t, method, codes.Canceled))
+
+	// Observed once, even if RecvMsg fails afterwards
+	require.Error(t, stream.RecvMsg(&pb.GetBlobResponse{}))
+	require.Equal(t, before+1, rpcCount(t, method, codes.Canceled))
+}
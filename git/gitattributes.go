@@ -0,0 +1,170 @@
+package git
+
+import (
+	"bufio"
+	"bytes"
+	"regexp"
+	"strings"
+)
+
+// Gitattributes holds the patterns of a `.gitattributes` file at the root of
+// a repository, and the attributes they assign
+type Gitattributes struct {
+	rules []attributeRule
+}
+
+type attributeRule struct {
+	pattern *regexp.Regexp
+
+	// Patterns without a slash match the basename of paths at any depth
+	basename bool
+
+	// The value of each attribute, or "" to make it unspecified again
+	attrs map[string]string
+}
+
+// ParseGitattributes reads a `.gitattributes` file. Set attributes (`attr`)
+// have the value "true", unset ones (`-attr`) "false". Macros, negative and
+// quoted patterns aren't supported, and their lines are ignored.
+func ParseGitattributes(data []byte) *Gitattributes {
+	ga := &Gitattributes{}
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+
+		pattern := fields[0]
+		if strings.HasPrefix(pattern, "#") || strings.HasPrefix(pattern, "[attr]") ||
+			strings.HasPrefix(pattern, "!") || strings.HasPrefix(pattern, `"`) {
+			continue
+		}
+
+		// A pattern for a directory never matches a file
+		if strings.HasSuffix(pattern, "/") {
+			continue
+		}
+
+		rule := attributeRule{
+			basename: !strings.Contains(pattern, "/"),
+			attrs:    make(map[string]string),
+		}
+
+		re, err := regexp.Compile(globToRegexp(strings.TrimPrefix(pattern, "/")))
+		if err != nil {
+			continue
+		}
+		rule.pattern = re
+
+		for _, attr := range fields[1:] {
+			switch {
+			case strings.HasPrefix(attr, "-"):
+				rule.attrs[attr[1:]] = "false"
+			case strings.HasPrefix(attr, "!"):
+				rule.attrs[attr[1:]] = ""
+			case strings.Contains(attr, "="):
+				parts := strings.SplitN(attr, "=", 2)
+				rule.attrs[parts[0]] = parts[1]
+			default:
+				rule.attrs[attr] = "true"
+			}
+		}
+
+		ga.rules = append(ga.rules, rule)
+	}
+
+	return ga
+}
+
+// Attributes returns the attributes of path. As in git, the last matching
+// line wins for each attribute.
+func (ga *Gitattributes) Attributes(path string) map[string]string {
+	attrs := make(map[string]string)
+	if ga == nil {
+		return attrs
+	}
+
+	basename := path
+	if i := strings.LastIndexByte(path, '/'); i >= 0 {
+		basename = path[i+1:]
+	}
+
+	for _, rule := range ga.rules {
+		subject := path
+		if rule.basename {
+			subject = basename
+		}
+
+		if !rule.pattern.MatchString(subject) {
+			continue
+		}
+
+		for name, value := range rule.attrs {
+			if value == "" {
+				delete(attrs, name)
+			} else {
+				attrs[name] = value
+			}
+		}
+	}
+
+	return attrs
+}
+
+// globToRegexp translates a pattern with the wildmatch syntax of git, where
+// `*` doesn't match slashes and `**` does, into a regular expression
+func globToRegexp(glob string) string {
+	var re strings.Builder
+	re.WriteString("^")
+
+	for i := 0; i < len(glob); i++ {
+		switch c := glob[i]; c {
+		case '*':
+			if strings.HasPrefix(glob[i:], "**") {
+				switch {
+				// `**/` matches any leading directories, or none
+				case strings.HasPrefix(glob[i:], "**/") && (i == 0 || glob[i-1] == '/'):
+					re.WriteString("(?:.*/)?")
+					i += 2
+				// A trailing `/**` matches everything inside
+				case i+2 == len(glob) && i > 0 && glob[i-1] == '/':
+					re.WriteString(".*")
+					i++
+				default:
+					re.WriteString("[^/]*")
+					i++
+				}
+				continue
+			}
+
+			re.WriteString("[^/]*")
+		case '?':
+			re.WriteString("[^/]")
+		case '[':
+			end := strings.IndexByte(glob[i+1:], ']')
+			if end < 0 {
+				re.WriteString(`\[`)
+				continue
+			}
+
+			class := glob[i+1 : i+1+end]
+			if strings.HasPrefix(class, "!") {
+				class = "^" + class[1:]
+			}
+			re.WriteString("[" + strings.Replace(class, `\`, `\\`, -1) + "]")
+			i += end + 1
+		case '\\':
+			if i+1 < len(glob) {
+				i++
+				re.WriteString(regexp.QuoteMeta(glob[i : i+1]))
+			}
+		default:
+			re.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+
+	re.WriteString("$")
+	return re.String()
+}
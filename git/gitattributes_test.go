@@ -0,0 +1,65 @@
+package git_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/gitlab-elasticsearch-indexer/git"
+)
+
+func TestParseGitattributes(t *testing.T) {
+	ga := git.ParseGitattributes([]byte(`
+# Treat templates as Ruby
+*.erb          linguist-language=Ruby
+/vendor/**     linguist-vendored
+vendor/keep/** -linguist-vendored
+docs/*.md      linguist-documentation
+**/gen/*.go    linguist-generated=true
+*.pb.go        linguist-generated !linguist-language
+[attr]custom   text
+build/         linguist-generated
+!*.txt         text
+`))
+
+	for _, tc := range []struct {
+		path  string
+		attrs map[string]string
+	}{
+		{"app.erb", map[string]string{"linguist-language": "Ruby"}},
+		{"app/views/index.html.erb", map[string]string{"linguist-language": "Ruby"}},
+		{"vendor/lib/a.js", map[string]string{"linguist-vendored": "true"}},
+		{"vendor/keep/b.js", map[string]string{"linguist-vendored": "false"}},
+		{"src/vendor/c.js", map[string]string{}},
+		{"docs/index.md", map[string]string{"linguist-documentation": "true"}},
+		{"docs/api/index.md", map[string]string{}},
+		{"gen/a.go", map[string]string{"linguist-generated": "true"}},
+		{"pkg/gen/a.go", map[string]string{"linguist-generated": "true"}},
+		{"pkg/gen/sub/a.go", map[string]string{}},
+		{"api/api.pb.go", map[string]string{"linguist-generated": "true"}},
+		{"build/out.js", map[string]string{}},
+		{"README.txt", map[string]string{}},
+	} {
+		require.Equal(t, tc.attrs, ga.Attributes(tc.path), tc.path)
+	}
+}
+
+func TestGitattributesPatterns(t *testing.T) {
+	ga := git.ParseGitattributes([]byte(`
+file?.[ch]   a
+[!x]*.rb     b
+\#*          c
+`))
+
+	require.Equal(t, map[string]string{"a": "true"}, ga.Attributes("src/file1.c"))
+	require.Equal(t, map[string]string{}, ga.Attributes("src/file10.c"))
+	require.Equal(t, map[string]string{"b": "true"}, ga.Attributes("lib/foo.rb"))
+	require.Equal(t, map[string]string{}, ga.Attributes("lib/xfoo.rb"))
+	require.Equal(t, map[string]string{"c": "true"}, ga.Attributes("#notes"))
+}
+
+func TestNilGitattributes(t *testing.T) {
+	var ga *git.Gitattributes
+
+	require.Equal(t, map[string]string{}, ga.Attributes("foo.rb"))
+}
@@ -0,0 +1,110 @@
+package git
+
+import (
+	"bufio"
+	"bytes"
+	"strings"
+)
+
+// ParseGitmodules reads a `.gitmodules` file and returns the URL of each
+// submodule, keyed by its path. Only the subset of the git-config syntax
+// that appears in `.gitmodules` files is supported.
+func ParseGitmodules(data []byte) map[string]string {
+	type section struct{ path, url string }
+
+	var sections []*section
+	var current *section
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || line[0] == '#' || line[0] == ';' {
+			continue
+		}
+
+		if line[0] == '[' {
+			current = nil
+			if strings.HasPrefix(line, "[submodule ") {
+				current = &section{}
+				sections = append(sections, current)
+			}
+			continue
+		}
+
+		if current == nil {
+			continue
+		}
+
+		parts := strings.SplitN(line, "=", 2)
+		if len(parts) != 2 {
+			continue
+		}
+
+		value := gitConfigValue(parts[1])
+		switch strings.ToLower(strings.TrimSpace(parts[0])) {
+		case "path":
+			current.path = value
+		case "url":
+			current.url = value
+		}
+	}
+
+	urls := make(map[string]string)
+	for _, s := range sections {
+		if s.path != "" {
+			urls[s.path] = s.url
+		}
+	}
+
+	return urls
+}
+
+// gitConfigValue strips quotes and trailing comments from a git-config value
+func gitConfigValue(raw string) string {
+	var out strings.Builder
+	quoted := false
+
+	raw = strings.TrimSpace(raw)
+	for i := 0; i < len(raw); i++ {
+		switch c := raw[i]; {
+		case c == '"':
+			quoted = !quoted
+		case c == '\\' && i+1 < len(raw):
+			i++
+			switch raw[i] {
+			case 'n':
+				out.WriteByte('\n')
+			case 't':
+				out.WriteByte('\t')
+			default:
+				out.WriteByte(raw[i])
+			}
+		case (c == '#' || c == ';') && !quoted:
+			return strings.TrimSpace(out.String())
+		default:
+			out.WriteByte(c)
+		}
+	}
+
+	return strings.TrimSpace(out.String())
+}
+
+// submoduleURLs resolves submodule URLs from `.gitmodules`, only reading it
+// the first time a submodule is encountered
+type submoduleURLs struct {
+	read func() ([]byte, error)
+	urls map[string]string
+}
+
+func (s *submoduleURLs) lookup(path string) (string, error) {
+	if s.urls == nil {
+		data, err := s.read()
+		if err != nil {
+			return "", err
+		}
+
+		s.urls = ParseGitmodules(data)
+	}
+
+	return s.urls[path], nil
+}
@@ -0,0 +1,43 @@
+package git_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/gitlab-elasticsearch-indexer/git"
+)
+
+func TestParseGitmodules(t *testing.T) {
+	data := []byte(`
+# Vendored libraries
+[submodule "six"]
+	path = six
+	url = git://github.com/randx/six.git
+[submodule "gitlab-shell"]
+	path = gitlab-shell
+	url = https://github.com/gitlabhq/gitlab-shell.git ; trailing comment
+[core]
+	path = not-a-submodule
+[submodule "with space"]
+	path = "deps/with space"
+	URL = "https://example.com/with space.git"
+[submodule "no-url"]
+	path = deps/no-url
+`)
+
+	require.Equal(
+		t,
+		map[string]string{
+			"six":             "git://github.com/randx/six.git",
+			"gitlab-shell":    "https://github.com/gitlabhq/gitlab-shell.git",
+			"deps/with space": "https://example.com/with space.git",
+			"deps/no-url":     "",
+		},
+		git.ParseGitmodules(data),
+	)
+}
+
+func TestParseGitmodulesEmpty(t *testing.T) {
+	require.Empty(t, git.ParseGitmodules(nil))
+}
@@ -0,0 +1,514 @@
+package git
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os/exec"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"gitlab.com/gitlab-org/gitlab-elasticsearch-indexer/logging"
+)
+
+// localClient reads a repository straight from disk by shelling out to git.
+// It works with both bare repositories and working copies.
+type localClient struct {
+	gitDir string
+
+	FromHash string
+	ToHash   string
+}
+
+func NewLocalClient(ctx context.Context, path, fromSHA, toSHA string) (*localClient, error) {
+	gitDir, err := resolveGitDir(ctx, path)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to open %s: %s", path, err)
+	}
+
+	client := &localClient{gitDir: gitDir}
+
+	if fromSHA == "" || fromSHA == ZeroSHA {
+		client.FromHash = NullTreeSHA
+	} else {
+		client.FromHash = fromSHA
+	}
+
+	if toSHA == "" {
+		head, err := client.lookUpHEAD(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("lookUpHEAD: %v", err)
+		}
+		client.ToHash = head
+	} else {
+		client.ToHash = toSHA
+	}
+
+	return client, nil
+}
+
+func resolveGitDir(ctx context.Context, path string) (string, error) {
+	out, err := exec.CommandContext(ctx, "git", "-C", path, "rev-parse", "--absolute-git-dir").Output()
+	if err != nil {
+		return "", commandError("rev-parse", err)
+	}
+
+	return strings.TrimSpace(string(out)), nil
+}
+
+func (lc *localClient) command(ctx context.Context, args ...string) *exec.Cmd {
+	return exec.CommandContext(ctx, "git", append([]string{"--git-dir", lc.gitDir}, args...)...)
+}
+
+func (lc *localClient) output(ctx context.Context, args ...string) (string, error) {
+	out, err := lc.command(ctx, args...).Output()
+	if err != nil {
+		return "", commandError(args[0], err)
+	}
+
+	return strings.TrimSpace(string(out)), nil
+}
+
+func commandError(name string, err error) error {
+	if exitErr, ok := err.(*exec.ExitError); ok && len(exitErr.Stderr) > 0 {
+		return fmt.Errorf("git %s: %v: %s", name, err, bytes.TrimSpace(exitErr.Stderr))
+	}
+
+	return fmt.Errorf("git %s: %v", name, err)
+}
+
+// cleanUpCommand kills and reaps a command we bailed out of early, otherwise
+// it would block forever on a full pipe
+func cleanUpCommand(cmd *exec.Cmd) {
+	if cmd.ProcessState == nil {
+		cmd.Process.Kill()
+		cmd.Wait()
+	}
+}
+
+func (lc *localClient) refExists(ctx context.Context, ref string) bool {
+	return lc.command(ctx, "show-ref", "--verify", "--quiet", ref).Run() == nil
+}
+
+// HEAD is not always set in some cases, so we find the last commit in
+// a default branch instead
+func (lc *localClient) lookUpHEAD(ctx context.Context) (string, error) {
+	defaultBranchName, err := lc.findDefaultBranchName(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	head, err := lc.output(ctx, "rev-parse", "--verify", defaultBranchName+"^{commit}")
+	if err != nil {
+		return "", fmt.Errorf("Cannot look up HEAD: %v", err)
+	}
+
+	return head, nil
+}
+
+// findDefaultBranchName follows the same rules as Gitaly: the branch HEAD
+// points to, then master, then the first branch found.
+func (lc *localClient) findDefaultBranchName(ctx context.Context) (string, error) {
+	if head, err := lc.output(ctx, "symbolic-ref", "-q", "HEAD"); err == nil && lc.refExists(ctx, head) {
+		return head, nil
+	}
+
+	if lc.refExists(ctx, "refs/heads/master") {
+		return "refs/heads/master", nil
+	}
+
+	branch, err := lc.output(ctx, "for-each-ref", "--count=1", "--format=%(refname)", "refs/heads/")
+	if err != nil {
+		return "", fmt.Errorf("Cannot find a default branch: %v", err)
+	}
+
+	if branch == "" {
+		return "", fmt.Errorf("Cannot find a default branch: no branches found")
+	}
+
+	return branch, nil
+}
+
+func (lc *localClient) CommitTime(ctx context.Context, sha string) (time.Time, error) {
+	timestamp, err := lc.output(ctx, "show", "-s", "--format=%ct", sha+"^{commit}")
+	if err != nil {
+		return time.Time{}, fmt.Errorf("Cannot look up commit %s: %v", sha, err)
+	}
+
+	committer, err := localBuildSignature("", "", timestamp)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return committer.When, nil
+}
+
+func (lc *localClient) EachFileChange(ctx context.Context, put PutFunc, putSubmodule PutSubmoduleFunc, del DelFunc) error {
+	cmd := lc.command(ctx, "diff-tree", "-r", "-z", "--raw", "--no-abbrev", "-M", lc.FromHash, lc.ToHash)
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return err
+	}
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("could not run git diff-tree: %v", err)
+	}
+
+	defer cleanUpCommand(cmd)
+
+	sizes, err := lc.newObjectSizeReader(ctx)
+	if err != nil {
+		return err
+	}
+	defer sizes.Close()
+
+	submodules := &submoduleURLs{read: func() ([]byte, error) { return lc.ReadFile(ctx, ".gitmodules") }}
+
+	changes := bufio.NewReader(stdout)
+	for {
+		change, err := readRawChange(changes)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("git diff-tree: %v", err)
+		}
+
+		switch change.status {
+		case 'D', 'R':
+			log.WithField(logging.Path, change.oldPath).Debug("Indexing blob change: DELETE")
+			if err = del(change.oldPath); err != nil {
+				return err
+			}
+		}
+
+		switch change.status {
+		case 'A', 'R', 'M', 'C', 'T':
+			if change.newMode == SubmoduleFileMode {
+				url, err := submodules.lookup(change.newPath)
+				if err != nil {
+					return err
+				}
+
+				submodule := &Submodule{Path: change.newPath, CommitSHA: change.newOid, URL: url}
+				log.WithField(logging.Path, submodule.Path).Debug("Indexing submodule change: PUT")
+				if err = putSubmodule(submodule, lc.FromHash, lc.ToHash); err != nil {
+					return err
+				}
+				continue
+			}
+
+			size, err := sizes.size(change.newOid)
+			if err != nil {
+				return err
+			}
+
+			file := &File{
+				Path: change.newPath,
+				Oid:  change.newOid,
+				Blob: lc.blobReader(ctx, change.newOid),
+				Size: size,
+			}
+			log.WithFields(log.Fields{logging.Path: file.Path, logging.OID: file.Oid}).Debug("Indexing blob change: PUT")
+			if err = put(file, lc.FromHash, lc.ToHash); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := cmd.Wait(); err != nil {
+		return fmt.Errorf("git diff-tree: %v: %s", err, bytes.TrimSpace(stderr.Bytes()))
+	}
+
+	return nil
+}
+
+// ReadFile returns the content of the file at path in ToHash, or nothing if
+// the file doesn't exist
+func (lc *localClient) ReadFile(ctx context.Context, path string) ([]byte, error) {
+	revision := lc.ToHash + ":" + path
+	if lc.command(ctx, "cat-file", "-e", revision).Run() != nil {
+		return nil, nil
+	}
+
+	out, err := lc.command(ctx, "cat-file", "blob", revision).Output()
+	if err != nil {
+		return nil, commandError("cat-file", err)
+	}
+
+	return out, nil
+}
+
+type rawChange struct {
+	oldMode int64
+	newMode int64
+	oldOid  string
+	newOid  string
+	status  byte
+	oldPath string
+	newPath string
+}
+
+// readRawChange parses a single entry of `git diff-tree -z --raw` output:
+//
+//	:<old mode> <new mode> <old oid> <new oid> <status>\0<path>\0[<new path>\0]
+func readRawChange(r *bufio.Reader) (*rawChange, error) {
+	header, err := readNulTerminated(r)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := strings.Fields(strings.TrimPrefix(header, ":"))
+	if len(fields) != 5 || len(fields[4]) == 0 {
+		return nil, fmt.Errorf("malformed raw change: %q", header)
+	}
+
+	change := &rawChange{
+		oldOid: fields[2],
+		newOid: fields[3],
+		status: fields[4][0],
+	}
+
+	if change.oldMode, err = strconv.ParseInt(fields[0], 8, 64); err != nil {
+		return nil, fmt.Errorf("malformed raw change: %q", header)
+	}
+
+	if change.newMode, err = strconv.ParseInt(fields[1], 8, 64); err != nil {
+		return nil, fmt.Errorf("malformed raw change: %q", header)
+	}
+
+	if change.oldPath, err = readNulTerminated(r); err != nil {
+		return nil, unexpectedEOF(err)
+	}
+
+	change.newPath = change.oldPath
+	if change.status == 'R' || change.status == 'C' {
+		if change.newPath, err = readNulTerminated(r); err != nil {
+			return nil, unexpectedEOF(err)
+		}
+	}
+
+	return change, nil
+}
+
+func readNulTerminated(r *bufio.Reader) (string, error) {
+	s, err := r.ReadString(0)
+	if err != nil {
+		if err == io.EOF && s != "" {
+			return s, nil
+		}
+		return "", err
+	}
+
+	return s[:len(s)-1], nil
+}
+
+func unexpectedEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+
+	return err
+}
+
+// objectSizeReader looks up object sizes through a long-running
+// `git cat-file --batch-check` process
+type objectSizeReader struct {
+	cmd    *exec.Cmd
+	stdin  io.WriteCloser
+	stdout *bufio.Reader
+}
+
+func (lc *localClient) newObjectSizeReader(ctx context.Context) (*objectSizeReader, error) {
+	cmd := lc.command(ctx, "cat-file", "--batch-check")
+
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("could not run git cat-file: %v", err)
+	}
+
+	return &objectSizeReader{cmd: cmd, stdin: stdin, stdout: bufio.NewReader(stdout)}, nil
+}
+
+func (r *objectSizeReader) size(oid string) (int64, error) {
+	if _, err := fmt.Fprintln(r.stdin, oid); err != nil {
+		return 0, fmt.Errorf("git cat-file: %v", err)
+	}
+
+	line, err := r.stdout.ReadString('\n')
+	if err != nil {
+		return 0, fmt.Errorf("git cat-file: %v", err)
+	}
+
+	// <oid> <type> <size>, or <oid> missing
+	fields := strings.Fields(line)
+	if len(fields) != 3 {
+		return 0, fmt.Errorf("git cat-file: cannot get size of %s: %s", oid, strings.TrimSpace(line))
+	}
+
+	return strconv.ParseInt(fields[2], 10, 64)
+}
+
+func (r *objectSizeReader) Close() error {
+	r.stdin.Close()
+	return r.cmd.Wait()
+}
+
+// commandReader streams the stdout of a command, reaping it on Close
+type commandReader struct {
+	io.ReadCloser
+	cmd *exec.Cmd
+}
+
+// Close stops reading the output of the command and waits for it to exit.
+// Closing before the end of the output kills the command with SIGPIPE on its
+// next write, which isn't an error of the command.
+func (r *commandReader) Close() error {
+	r.ReadCloser.Close()
+
+	err := r.cmd.Wait()
+	if isBrokenPipe(err) {
+		return nil
+	}
+
+	return err
+}
+
+func isBrokenPipe(err error) bool {
+	exitErr, ok := err.(*exec.ExitError)
+	if !ok {
+		return false
+	}
+
+	status, ok := exitErr.Sys().(syscall.WaitStatus)
+	return ok && status.Signaled() && status.Signal() == syscall.SIGPIPE
+}
+
+func (lc *localClient) blobReader(ctx context.Context, oid string) func() (io.ReadCloser, error) {
+	return func() (io.ReadCloser, error) {
+		cmd := lc.command(ctx, "cat-file", "blob", oid)
+		stdout, err := cmd.StdoutPipe()
+		if err != nil {
+			return nil, err
+		}
+
+		if err := cmd.Start(); err != nil {
+			return nil, fmt.Errorf("Cannot get blob %s: %s", oid, err)
+		}
+
+		return &commandReader{ReadCloser: stdout, cmd: cmd}, nil
+	}
+}
+
+// commitFormat separates fields with NUL. Combined with -z, which separates
+// commits with NUL too, every commit is exactly commitFields fields long.
+const (
+	commitFormat = "--format=%H%x00%an%x00%ae%x00%at%x00%cn%x00%ce%x00%ct%x00%B"
+	commitFields = 8
+)
+
+func (lc *localClient) EachCommit(ctx context.Context, f CommitFunc) error {
+	revision := lc.ToHash
+	if lc.FromHash != NullTreeSHA {
+		revision = lc.FromHash + ".." + lc.ToHash
+	}
+
+	cmd := lc.command(ctx, "log", "--reverse", "-z", commitFormat, revision, "--")
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return err
+	}
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("could not run git log: %v", err)
+	}
+
+	defer cleanUpCommand(cmd)
+
+	r := bufio.NewReader(stdout)
+	for {
+		commit, err := readCommit(r)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("git log: %v", err)
+		}
+
+		log.WithField(logging.CommitSHA, commit.Hash).Debug("Indexing commit")
+
+		if err := f(commit); err != nil {
+			return err
+		}
+	}
+
+	if err := cmd.Wait(); err != nil {
+		return fmt.Errorf("git log: %v: %s", err, bytes.TrimSpace(stderr.Bytes()))
+	}
+
+	return nil
+}
+
+func readCommit(r *bufio.Reader) (*Commit, error) {
+	fields := make([]string, commitFields)
+	for i := range fields {
+		field, err := readNulTerminated(r)
+		if err != nil {
+			if i > 0 {
+				return nil, unexpectedEOF(err)
+			}
+			return nil, err
+		}
+		fields[i] = field
+	}
+
+	author, err := localBuildSignature(fields[1], fields[2], fields[3])
+	if err != nil {
+		return nil, err
+	}
+
+	committer, err := localBuildSignature(fields[4], fields[5], fields[6])
+	if err != nil {
+		return nil, err
+	}
+
+	return &Commit{
+		Hash:      fields[0],
+		Author:    author,
+		Committer: committer,
+		Message:   fields[7],
+	}, nil
+}
+
+func localBuildSignature(name, email, timestamp string) (Signature, error) {
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return Signature{}, fmt.Errorf("malformed commit date: %q", timestamp)
+	}
+
+	return Signature{
+		Name:  name,
+		Email: email,
+		When:  time.Unix(seconds, 0),
+	}, nil
+}
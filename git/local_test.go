@@ -0,0 +1,313 @@
+package git_test
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/gitlab-elasticsearch-indexer/git"
+)
+
+type localTestRepo struct {
+	t   *testing.T
+	dir string
+}
+
+func newLocalTestRepo(t *testing.T) (*localTestRepo, func()) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	dir, err := ioutil.TempDir("", "gitlab-elasticsearch-indexer")
+	require.NoError(t, err)
+
+	repo := &localTestRepo{t: t, dir: dir}
+	repo.git("init", "--quiet")
+	repo.git("checkout", "--quiet", "-b", "master")
+
+	return repo, func() { os.RemoveAll(dir) }
+}
+
+func (r *localTestRepo) git(args ...string) string {
+	cmd := exec.Command("git", append([]string{"-C", r.dir}, args...)...)
+	cmd.Env = append(
+		os.Environ(),
+		"GIT_AUTHOR_NAME=Job van der Voort",
+		"GIT_AUTHOR_EMAIL=job@gitlab.com",
+		"GIT_AUTHOR_DATE=1474987066 +0000",
+		"GIT_COMMITTER_NAME=Nick Thomas",
+		"GIT_COMMITTER_EMAIL=nick@gitlab.com",
+		"GIT_COMMITTER_DATE=1509205127 +0000",
+	)
+
+	out, err := cmd.CombinedOutput()
+	require.NoError(r.t, err, "git %v: %s", args, out)
+
+	return strings.TrimSpace(string(out))
+}
+
+func (r *localTestRepo) write(path, content string) {
+	fullPath := filepath.Join(r.dir, path)
+	require.NoError(r.t, os.MkdirAll(filepath.Dir(fullPath), 0755))
+	require.NoError(r.t, ioutil.WriteFile(fullPath, []byte(content), 0644))
+}
+
+func (r *localTestRepo) commit(message string) string {
+	r.git("add", "--all")
+	r.git("commit", "--quiet", "--allow-empty", "-m", message)
+
+	return r.git("rev-parse", "HEAD")
+}
+
+// buildHistory creates three commits: an initial one, one that modifies a
+// file, and one that renames and removes files
+func (r *localTestRepo) buildHistory() (string, string, string) {
+	r.write("README.md", "testme\n")
+	r.write("files/js/commit.js.coffee", strings.Repeat("class Commit\n", 20))
+	r.write("files/empty", "")
+	initial := r.commit("Initial commit")
+
+	r.write("README.md", "testme\n======\n")
+	modified := r.commit("Modify README")
+
+	r.git("mv", "files/js/commit.js.coffee", "files/js/commit.coffee")
+	r.git("rm", "--quiet", "files/empty")
+	renamed := r.commit("Rename and remove files")
+
+	return initial, modified, renamed
+}
+
+func TestLocalEachFileChangeAllModifications(t *testing.T) {
+	r, cleanup := newLocalTestRepo(t)
+	defer cleanup()
+
+	_, _, head := r.buildHistory()
+
+	repo, err := git.NewLocalClient(context.Background(), r.dir, git.ZeroSHA, "")
+	require.NoError(t, err)
+	require.Equal(t, git.NullTreeSHA, repo.FromHash)
+	require.Equal(t, head, repo.ToHash)
+
+	putFiles, delFiles, filePaths, err := runEachFileChange(repo)
+	require.NoError(t, err)
+
+	sort.Strings(filePaths)
+	require.Equal(t, []string{"README.md", "files/js/commit.coffee"}, filePaths)
+	require.Empty(t, delFiles)
+
+	file := putFiles["README.md"]
+	blob, err := file.Blob()
+	require.NoError(t, err)
+	data, err := ioutil.ReadAll(blob)
+	require.NoError(t, err)
+	require.NoError(t, blob.Close())
+
+	require.Equal(t, r.git("rev-parse", "HEAD:README.md"), file.Oid)
+	require.Equal(t, int64(14), file.Size)
+	require.Equal(t, "testme\n======\n", string(data))
+}
+
+func TestLocalEachFileChangeWithRenameAndRemove(t *testing.T) {
+	r, cleanup := newLocalTestRepo(t)
+	defer cleanup()
+
+	_, modified, head := r.buildHistory()
+
+	repo, err := git.NewLocalClient(context.Background(), r.dir, modified, head)
+	require.NoError(t, err)
+
+	putFiles, delFiles, _, err := runEachFileChange(repo)
+	require.NoError(t, err)
+
+	require.Len(t, putFiles, 1)
+	require.Contains(t, putFiles, "files/js/commit.coffee")
+
+	sort.Strings(delFiles)
+	require.Equal(t, []string{"files/empty", "files/js/commit.js.coffee"}, delFiles)
+}
+
+func TestLocalBlobClosedEarly(t *testing.T) {
+	r, cleanup := newLocalTestRepo(t)
+	defer cleanup()
+
+	// Larger than a pipe buffer, so git is still writing when it's closed
+	r.write("large.txt", strings.Repeat("large file\n", 1<<17))
+	head := r.commit("Add a large file")
+
+	repo, err := git.NewLocalClient(context.Background(), r.dir, git.ZeroSHA, head)
+	require.NoError(t, err)
+
+	putFiles, _, _, err := runEachFileChange(repo)
+	require.NoError(t, err)
+
+	blob, err := putFiles["large.txt"].Blob()
+	require.NoError(t, err)
+
+	data := make([]byte, 1024)
+	_, err = io.ReadFull(blob, data)
+	require.NoError(t, err)
+	require.NoError(t, blob.Close())
+}
+
+func TestLocalEachCommit(t *testing.T) {
+	r, cleanup := newLocalTestRepo(t)
+	defer cleanup()
+
+	initial, modified, head := r.buildHistory()
+
+	repo, err := git.NewLocalClient(context.Background(), r.dir, "", head)
+	require.NoError(t, err)
+
+	commits, commitHashes, err := runEachCommit(repo)
+	require.NoError(t, err)
+	require.Equal(t, []string{initial, modified, head}, commitHashes)
+
+	commit := commits[initial]
+	require.Equal(t, "Initial commit\n", commit.Message)
+	require.Equal(t, "Job van der Voort", commit.Author.Name)
+	require.Equal(t, "job@gitlab.com", commit.Author.Email)
+	require.Equal(t, int64(1474987066), commit.Author.When.Unix())
+	require.Equal(t, "Nick Thomas", commit.Committer.Name)
+	require.Equal(t, "nick@gitlab.com", commit.Committer.Email)
+	require.Equal(t, int64(1509205127), commit.Committer.When.Unix())
+}
+
+func TestLocalCommitTime(t *testing.T) {
+	r, cleanup := newLocalTestRepo(t)
+	defer cleanup()
+
+	_, _, head := r.buildHistory()
+
+	repo, err := git.NewLocalClient(context.Background(), r.dir, "", head)
+	require.NoError(t, err)
+
+	var timer git.CommitTimer = repo
+	committed, err := timer.CommitTime(context.Background(), head)
+	require.NoError(t, err)
+	require.Equal(t, int64(1509205127), committed.Unix())
+
+	_, err = timer.CommitTime(context.Background(), "0123456789abcdef0123456789abcdef01234567")
+	require.Error(t, err)
+}
+
+func TestLocalReadFile(t *testing.T) {
+	r, cleanup := newLocalTestRepo(t)
+	defer cleanup()
+
+	r.write(".gitattributes", "*.erb linguist-language=Ruby\n")
+	first := r.commit("Add attributes")
+
+	r.write(".gitattributes", "*.erb linguist-vendored\n")
+	r.commit("Change attributes")
+
+	repo, err := git.NewLocalClient(context.Background(), r.dir, "", first)
+	require.NoError(t, err)
+
+	var reader git.FileReader = repo
+	data, err := reader.ReadFile(context.Background(), ".gitattributes")
+	require.NoError(t, err)
+	require.Equal(t, "*.erb linguist-language=Ruby\n", string(data))
+
+	data, err = reader.ReadFile(context.Background(), "missing")
+	require.NoError(t, err)
+	require.Nil(t, data)
+}
+
+func TestLocalEachCommitGivenRange(t *testing.T) {
+	r, cleanup := newLocalTestRepo(t)
+	defer cleanup()
+
+	initial, _, head := r.buildHistory()
+
+	repo, err := git.NewLocalClient(context.Background(), r.dir, initial, head)
+	require.NoError(t, err)
+
+	_, commitHashes, err := runEachCommit(repo)
+	require.NoError(t, err)
+	require.Len(t, commitHashes, 2)
+
+	repo, err = git.NewLocalClient(context.Background(), r.dir, head, head)
+	require.NoError(t, err)
+
+	_, commitHashes, err = runEachCommit(repo)
+	require.NoError(t, err)
+	require.Equal(t, []string{}, commitHashes)
+}
+
+func TestLocalBareRepositoryWithoutHEAD(t *testing.T) {
+	r, cleanup := newLocalTestRepo(t)
+	defer cleanup()
+
+	_, _, head := r.buildHistory()
+	r.git("branch", "--quiet", "-m", "master", "feature")
+
+	bare, err := ioutil.TempDir("", "gitlab-elasticsearch-indexer-bare")
+	require.NoError(t, err)
+	defer os.RemoveAll(bare)
+
+	r.git("clone", "--quiet", "--bare", r.dir, bare)
+
+	// HEAD points at a branch that doesn't exist, so we fall back to the
+	// first branch found
+	require.NoError(t, exec.Command("git", "--git-dir", bare, "symbolic-ref", "HEAD", "refs/heads/missing").Run())
+
+	repo, err := git.NewLocalClient(context.Background(), bare, "", "")
+	require.NoError(t, err)
+	require.Equal(t, head, repo.ToHash)
+}
+
+func TestLocalEachFileChangeWithSubmodules(t *testing.T) {
+	r, cleanup := newLocalTestRepo(t)
+	defer cleanup()
+
+	_, _, head := r.buildHistory()
+
+	r.write(".gitmodules", "[submodule \"six\"]\n\tpath = vendor/six\n\turl = git://github.com/randx/six.git\n")
+	r.git("add", ".gitmodules")
+	r.git("update-index", "--add", "--cacheinfo", "160000,"+head+",vendor/six")
+
+	// Commit directly, as `git add --all` would see the gitlink as deleted
+	r.git("commit", "--quiet", "-m", "Add submodule")
+	added := r.git("rev-parse", "HEAD")
+
+	repo, err := git.NewLocalClient(context.Background(), r.dir, head, added)
+	require.NoError(t, err)
+
+	putFiles, putSubmodules, delFiles, _, err := runEachFileChangeWithSubmodules(repo)
+	require.NoError(t, err)
+
+	require.Contains(t, putFiles, ".gitmodules")
+	require.Empty(t, delFiles)
+	require.Equal(
+		t,
+		map[string]*git.Submodule{
+			"vendor/six": {Path: "vendor/six", CommitSHA: head, URL: "git://github.com/randx/six.git"},
+		},
+		putSubmodules,
+	)
+
+	r.git("rm", "--quiet", "--cached", "vendor/six")
+	removed := r.commit("Remove submodule")
+
+	repo, err = git.NewLocalClient(context.Background(), r.dir, added, removed)
+	require.NoError(t, err)
+
+	_, putSubmodules, delFiles, _, err = runEachFileChangeWithSubmodules(repo)
+	require.NoError(t, err)
+
+	require.Empty(t, putSubmodules)
+	require.Equal(t, []string{"vendor/six"}, delFiles)
+}
+
+func TestLocalRepositoryNotFound(t *testing.T) {
+	_, err := git.NewLocalClient(context.Background(), "/absolutely/nobody/will/make/this/path", "", "")
+	require.Error(t, err)
+}
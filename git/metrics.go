@@ -0,0 +1,88 @@
+package git
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+
+	"gitlab.com/gitlab-org/gitlab-elasticsearch-indexer/metrics"
+)
+
+var gitalyRPCDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+	Namespace: metrics.Namespace,
+	Name:      "gitaly_rpc_duration_seconds",
+	Help:      "Latency of Gitaly RPCs, until the last message of streams, by method and status code",
+	Buckets:   prometheus.DefBuckets,
+}, []string{"method", "code"})
+
+func init() {
+	metrics.Registry.MustRegister(gitalyRPCDuration)
+}
+
+func observeRPC(method string, start time.Time, err error) {
+	gitalyRPCDuration.WithLabelValues(method, status.Code(err).String()).Observe(time.Since(start).Seconds())
+}
+
+func unaryMetricsInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	start := time.Now()
+	err := invoker(ctx, method, req, reply, cc, opts...)
+	observeRPC(method, start, err)
+
+	return err
+}
+
+func streamMetricsInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	start := time.Now()
+	stream, err := streamer(ctx, desc, cc, method, opts...)
+	if err != nil {
+		observeRPC(method, start, err)
+		return nil, err
+	}
+
+	observed := &observedStream{ClientStream: stream, method: method, start: start, done: make(chan struct{})}
+
+	// Streams abandoned by cancelling their context, e.g. blobs that are
+	// closed before they are read to the end, never see RecvMsg fail
+	go func() {
+		select {
+		case <-ctx.Done():
+			observed.observe(status.FromContextError(ctx.Err()).Err())
+		case <-observed.done:
+		}
+	}()
+
+	return observed, nil
+}
+
+// observedStream observes the latency of a streaming RPC once it ends, which
+// is when RecvMsg first fails or its context is done
+type observedStream struct {
+	grpc.ClientStream
+	method string
+	start  time.Time
+	once   sync.Once
+	done   chan struct{}
+}
+
+func (s *observedStream) observe(err error) {
+	s.once.Do(func() {
+		observeRPC(s.method, s.start, err)
+		close(s.done)
+	})
+}
+
+func (s *observedStream) RecvMsg(m interface{}) error {
+	err := s.ClientStream.RecvMsg(m)
+	if err == io.EOF {
+		s.observe(nil)
+	} else if err != nil {
+		s.observe(err)
+	}
+
+	return err
+}
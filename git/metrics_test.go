@@ -0,0 +1,55 @@
+package git_test
+
+import (
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+
+	"gitlab.com/gitlab-org/gitlab-elasticsearch-indexer/git"
+	"gitlab.com/gitlab-org/gitlab-elasticsearch-indexer/metrics"
+)
+
+// metricValue returns the value of a series of metrics.Registry, or 0 if
+// it wasn't written yet
+func metricValue(t *testing.T, series string) float64 {
+	rec := httptest.NewRecorder()
+	metrics.Handler().ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+
+	for _, line := range strings.Split(rec.Body.String(), "\n") {
+		if strings.HasPrefix(line, series+" ") {
+			value, err := strconv.ParseFloat(strings.TrimPrefix(line, series+" "), 64)
+			require.NoError(t, err)
+
+			return value
+		}
+	}
+
+	return 0
+}
+
+func TestGitalyRPCsRecordMetrics(t *testing.T) {
+	found := `gitlab_elasticsearch_indexer_gitaly_rpc_duration_seconds_count{code="OK",method="/gitaly.RefService/FindDefaultBranchName"}`
+	denied := `gitlab_elasticsearch_indexer_gitaly_rpc_duration_seconds_count{code="Unauthenticated",method="/gitaly.RefService/FindDefaultBranchName"}`
+
+	foundBefore, deniedBefore := metricValue(t, found), metricValue(t, denied)
+
+	address, stop := startFakeGitaly(t, &fakeGitaly{})
+	defer stop()
+
+	client, err := git.NewGitalyClient(context.Background(), &git.StorageConfig{Address: "tcp://" + address}, "", "")
+	require.NoError(t, err)
+	client.Close()
+
+	deniedAddress, stopDenied := startFakeGitaly(t, &fakeGitaly{unauthenticated: true})
+	defer stopDenied()
+
+	_, err = git.NewGitalyClient(context.Background(), &git.StorageConfig{Address: "tcp://" + deniedAddress}, "", "")
+	require.Error(t, err)
+
+	require.Equal(t, float64(1), metricValue(t, found)-foundBefore)
+	require.Equal(t, float64(1), metricValue(t, denied)-deniedBefore)
+}
@@ -1,6 +1,7 @@
 package git
 
 import (
+	"context"
 	"io"
 	"time"
 )
@@ -12,6 +13,14 @@ type File struct {
 	Size int64
 }
 
+// Submodule is a gitlink entry in a tree. CommitSHA is the commit the
+// submodule is pinned to, and URL is resolved from `.gitmodules`.
+type Submodule struct {
+	Path      string
+	CommitSHA string
+	URL       string
+}
+
 type Signature struct {
 	Name  string
 	Email string
@@ -26,10 +35,23 @@ type Commit struct {
 }
 
 type Repository interface {
-	EachFileChange(put PutFunc, del DelFunc) error
-	EachCommit(f CommitFunc) error
+	EachFileChange(ctx context.Context, put PutFunc, putSubmodule PutSubmoduleFunc, del DelFunc) error
+	EachCommit(ctx context.Context, f CommitFunc) error
+}
+
+// CommitTimer is implemented by repositories that can look up when a commit
+// was committed
+type CommitTimer interface {
+	CommitTime(ctx context.Context, sha string) (time.Time, error)
+}
+
+// FileReader is implemented by repositories that can read a file at ToHash,
+// e.g. `.gitattributes`. Files that don't exist are read as nothing.
+type FileReader interface {
+	ReadFile(ctx context.Context, path string) ([]byte, error)
 }
 
 type PutFunc func(file *File, fromCommit, toCommit string) error
+type PutSubmoduleFunc func(submodule *Submodule, fromCommit, toCommit string) error
 type DelFunc func(path string) error
 type CommitFunc func(commit *Commit) error
@@ -97,7 +97,7 @@ func runEachCommit(repo git.Repository) (map[string]*git.Commit, []string, error
 	commits := make(map[string]*git.Commit)
 	commitHashes := []string{}
 
-	err := repo.EachCommit(func(commit *git.Commit) error {
+	err := repo.EachCommit(context.Background(), func(commit *git.Commit) error {
 		commits[commit.Hash] = commit
 		commitHashes = append(commitHashes, commit.Hash)
 		return nil
@@ -110,7 +110,7 @@ func TestEachCommit(t *testing.T) {
 	checkDeps(t)
 	require.NoError(t, ensureGitalyRepository(t))
 
-	repo, err := git.NewGitalyClientFromEnv(testRepo, "", headSHA)
+	repo, err := git.NewGitalyClientFromEnv(context.Background(), testRepo, "", headSHA)
 	require.NoError(t, err)
 
 	commits, commitHashes, err := runEachCommit(repo)
@@ -184,7 +184,7 @@ func TestEachCommitGivenRangeOf3Commits(t *testing.T) {
 	checkDeps(t)
 	require.NoError(t, ensureGitalyRepository(t))
 
-	repo, err := git.NewGitalyClientFromEnv(testRepo, "1b12f15a11fc6e62177bef08f47bc7b5ce50b141", headSHA)
+	repo, err := git.NewGitalyClientFromEnv(context.Background(), testRepo, "1b12f15a11fc6e62177bef08f47bc7b5ce50b141", headSHA)
 	require.NoError(t, err)
 
 	_, commitHashes, err := runEachCommit(repo)
@@ -201,7 +201,7 @@ func TestEachCommitGivenRangeOf2Commits(t *testing.T) {
 	checkDeps(t)
 	require.NoError(t, ensureGitalyRepository(t))
 
-	repo, err := git.NewGitalyClientFromEnv(testRepo, "498214de67004b1da3d820901307bed2a68a8ef6", headSHA)
+	repo, err := git.NewGitalyClientFromEnv(context.Background(), testRepo, "498214de67004b1da3d820901307bed2a68a8ef6", headSHA)
 	require.NoError(t, err)
 
 	_, commitHashes, err := runEachCommit(repo)
@@ -214,7 +214,7 @@ func TestEachCommitGivenRangeOf1Commit(t *testing.T) {
 	checkDeps(t)
 	require.NoError(t, ensureGitalyRepository(t))
 
-	repo, err := git.NewGitalyClientFromEnv(testRepo, headSHA, headSHA)
+	repo, err := git.NewGitalyClientFromEnv(context.Background(), testRepo, headSHA, headSHA)
 	require.NoError(t, err)
 
 	_, commitHashes, err := runEachCommit(repo)
@@ -226,7 +226,7 @@ func TestEmptyToSHADefaultsToHeadSHA(t *testing.T) {
 	checkDeps(t)
 	require.NoError(t, ensureGitalyRepository(t))
 
-	repo, err := git.NewGitalyClientFromEnv(testRepo, "498214de67004b1da3d820901307bed2a68a8ef6", "")
+	repo, err := git.NewGitalyClientFromEnv(context.Background(), testRepo, "498214de67004b1da3d820901307bed2a68a8ef6", "")
 	require.NoError(t, err)
 
 	_, commitHashes, err := runEachCommit(repo)
@@ -235,7 +235,13 @@ func TestEmptyToSHADefaultsToHeadSHA(t *testing.T) {
 }
 
 func runEachFileChange(repo git.Repository) (map[string]*git.File, []string, []string, error) {
+	putFiles, _, delFiles, filePaths, err := runEachFileChangeWithSubmodules(repo)
+	return putFiles, delFiles, filePaths, err
+}
+
+func runEachFileChangeWithSubmodules(repo git.Repository) (map[string]*git.File, map[string]*git.Submodule, []string, []string, error) {
 	putFiles := make(map[string]*git.File)
+	putSubmodules := make(map[string]*git.Submodule)
 	delFiles := []string{}
 	filePaths := []string{}
 
@@ -245,21 +251,26 @@ func runEachFileChange(repo git.Repository) (map[string]*git.File, []string, []s
 		return nil
 	}
 
+	putSubmoduleStore := func(s *git.Submodule, _, _ string) error {
+		putSubmodules[s.Path] = s
+		return nil
+	}
+
 	delStore := func(f string) error {
 		delFiles = append(delFiles, f)
 		filePaths = append(filePaths, f)
 		return nil
 	}
 
-	err := repo.EachFileChange(putStore, delStore)
-	return putFiles, delFiles, filePaths, err
+	err := repo.EachFileChange(context.Background(), putStore, putSubmoduleStore, delStore)
+	return putFiles, putSubmodules, delFiles, filePaths, err
 }
 
 func TestEachFileChangeAllModifications(t *testing.T) {
 	checkDeps(t)
 	require.NoError(t, ensureGitalyRepository(t))
 
-	repo, err := git.NewGitalyClientFromEnv(testRepo, "", headSHA)
+	repo, err := git.NewGitalyClientFromEnv(context.Background(), testRepo, "", headSHA)
 	require.NoError(t, err)
 
 	putFiles, _, filePaths, err := runEachFileChange(repo)
@@ -325,11 +336,33 @@ func TestEachFileChangeAllModifications(t *testing.T) {
 	require.Equal(t, "6.7.0.pre\n", string(data))
 }
 
+func TestEachFileChangeIndexesSubmodules(t *testing.T) {
+	checkDeps(t)
+	require.NoError(t, ensureGitalyRepository(t))
+
+	repo, err := git.NewGitalyClientFromEnv(context.Background(), testRepo, "", headSHA)
+	require.NoError(t, err)
+
+	_, putSubmodules, _, _, err := runEachFileChangeWithSubmodules(repo)
+	require.NoError(t, err)
+
+	require.Contains(t, putSubmodules, "six")
+	require.Equal(
+		t,
+		&git.Submodule{
+			Path:      "six",
+			CommitSHA: "409f37c4f05865e4fb208c771485f211a22c4c2d",
+			URL:       "git://github.com/randx/six.git",
+		},
+		putSubmodules["six"],
+	)
+}
+
 func TestEachFileChangeGivenRangeOfThreeCommits(t *testing.T) {
 	checkDeps(t)
 	require.NoError(t, ensureGitalyRepository(t))
 
-	repo, err := git.NewGitalyClientFromEnv(testRepo, "1b12f15a11fc6e62177bef08f47bc7b5ce50b141", headSHA)
+	repo, err := git.NewGitalyClientFromEnv(context.Background(), testRepo, "1b12f15a11fc6e62177bef08f47bc7b5ce50b141", headSHA)
 	require.NoError(t, err)
 
 	_, _, filePaths, err := runEachFileChange(repo)
@@ -341,7 +374,7 @@ func TestEachFileChangeGivenRangeOfTwoCommits(t *testing.T) {
 	checkDeps(t)
 	require.NoError(t, ensureGitalyRepository(t))
 
-	repo, err := git.NewGitalyClientFromEnv(testRepo, "498214de67004b1da3d820901307bed2a68a8ef6", headSHA)
+	repo, err := git.NewGitalyClientFromEnv(context.Background(), testRepo, "498214de67004b1da3d820901307bed2a68a8ef6", headSHA)
 	require.NoError(t, err)
 
 	_, _, filePaths, err := runEachFileChange(repo)
@@ -353,7 +386,7 @@ func TestEachFileChangeWithRename(t *testing.T) {
 	checkDeps(t)
 	require.NoError(t, ensureGitalyRepository(t))
 
-	repo, err := git.NewGitalyClientFromEnv(testRepo, "19e2e9b4ef76b422ce1154af39a91323ccc57434", "c347ca2e140aa667b968e51ed0ffe055501fe4f4")
+	repo, err := git.NewGitalyClientFromEnv(context.Background(), testRepo, "19e2e9b4ef76b422ce1154af39a91323ccc57434", "c347ca2e140aa667b968e51ed0ffe055501fe4f4")
 	require.NoError(t, err)
 
 	putFiles, delFiles, _, err := runEachFileChange(repo)
@@ -7,8 +7,10 @@ import (
 	"path"
 	"strconv"
 
+	log "github.com/sirupsen/logrus"
 	"gitlab.com/gitlab-org/gitlab-elasticsearch-indexer/git"
 	"gitlab.com/gitlab-org/gitlab-elasticsearch-indexer/linguist"
+	"gitlab.com/gitlab-org/gitlab-elasticsearch-indexer/logging"
 )
 
 var (
@@ -18,6 +20,10 @@ var (
 
 const (
 	binarySearchLimit = 8 * 1024 // 8 KiB, Same as git
+
+	// MinClassifierConfidence is the confidence the classifier needs to
+	// detect the language of a file nothing else about names one
+	MinClassifierConfidence = 0.5
 )
 
 func isSkipBlobErr(err error) bool {
@@ -53,13 +59,25 @@ type Blob struct {
 	Filename string `json:"file_name"`
 
 	Language string `json:"language"`
+
+	// Classified as linguist does, unless the linguist-vendored,
+	// linguist-generated or linguist-documentation attribute is set or unset
+	// in `.gitattributes`. Like linguist-language, they are only read from
+	// the `.gitattributes` at the root of the repository: nested ones are
+	// ignored.
+	Vendored      bool `json:"vendored"`
+	Generated     bool `json:"generated"`
+	Documentation bool `json:"documentation"`
 }
 
 func GenerateBlobID(parentID int64, filename string) string {
 	return fmt.Sprintf("%v_%s", parentID, filename)
 }
 
-func BuildBlob(file *git.File, parentID int64, commitSHA string, blobType string) (*Blob, error) {
+// BuildBlob reads a file into a blob document. attrs, which may be nil, are
+// the `.gitattributes` of the repository: the linguist attributes override
+// the detected language and classification.
+func BuildBlob(file *git.File, parentID int64, commitSHA string, blobType string, attrs *git.Gitattributes) (*Blob, error) {
 	if file.Size > git.LimitFileSize {
 		return nil, SkipTooLargeBlob
 	}
@@ -82,7 +100,7 @@ func BuildBlob(file *git.File, parentID int64, commitSHA string, blobType string
 		return nil, SkipBinaryBlob
 	}
 
-	content := tryEncodeBytes(b)
+	content := tryEncodeBytes(b, log.Fields{logging.Path: file.Path, logging.OID: file.Oid})
 	filename := tryEncodeString(file.Path)
 	blob := &Blob{
 		ID:        GenerateBlobID(parentID, filename),
@@ -91,9 +109,10 @@ func BuildBlob(file *git.File, parentID int64, commitSHA string, blobType string
 		Content:   content,
 		Path:      filename,
 		Filename:  path.Base(filename),
-		Language:  DetectLanguage(filename, b),
 	}
 
+	applyAttributes(blob, attrs.Attributes(file.Path), b)
+
 	switch blobType {
 	case "blob":
 		blob.Type = "blob"
@@ -106,16 +125,50 @@ func BuildBlob(file *git.File, parentID int64, commitSHA string, blobType string
 	return blob, nil
 }
 
+// applyAttributes sets the language and classification of a blob from its
+// attributes, detecting those they don't set from the path and content
+func applyAttributes(blob *Blob, attrs map[string]string, data []byte) {
+	if lang := linguist.FindLanguage(attrs["linguist-language"]); lang != nil {
+		blob.Language = lang.Name
+	} else {
+		blob.Language = DetectLanguage(blob.Path, data)
+	}
+
+	blob.Vendored = attributeOr(attrs["linguist-vendored"], func() bool { return linguist.IsVendored(blob.Path) })
+	blob.Generated = attributeOr(attrs["linguist-generated"], func() bool { return linguist.IsGenerated(blob.Path, data) })
+	blob.Documentation = attributeOr(attrs["linguist-documentation"], func() bool { return linguist.IsDocumentation(blob.Path) })
+}
+
+// attributeOr reads a boolean attribute as linguist does, falling back to
+// detect when it isn't set to true or false
+func attributeOr(value string, detect func() bool) bool {
+	switch value {
+	case "true", "1":
+		return true
+	case "false", "0":
+		return false
+	}
+
+	return detect()
+}
+
 // DetectLanguage returns a string describing the language of the file. This is
 // programming language, rather than natural language.
 //
-// If no language is detected, "Text" is returned.
+// When nothing about the file names a language, the classifier guesses one
+// from its content. Unless it confidently guesses a programming language,
+// "Text" is returned: plain text or data is only recognised by its name.
 func DetectLanguage(filename string, data []byte) string {
 	lang := linguist.DetectLanguage(filename, data)
 	if lang != nil {
 		return lang.Name
 	}
 
+	lang, confidence := linguist.Classify(data, nil)
+	if lang != nil && lang.Type == "programming" && confidence >= MinClassifierConfidence {
+		return lang.Name
+	}
+
 	return "Text"
 }
 
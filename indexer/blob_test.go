@@ -6,6 +6,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"gitlab.com/gitlab-org/gitlab-elasticsearch-indexer/git"
 	"gitlab.com/gitlab-org/gitlab-elasticsearch-indexer/indexer"
 )
 
@@ -13,7 +14,7 @@ func TestBuildBlob(t *testing.T) {
 	file := gitFile("foo/bar", "foo")
 	expected := validBlob(file, "foo", "Text")
 
-	actual, err := indexer.BuildBlob(file, parentID, expected.CommitSHA, "blob")
+	actual, err := indexer.BuildBlob(file, parentID, expected.CommitSHA, "blob", nil)
 	require.NoError(t, err)
 
 	require.Equal(t, expected, actual)
@@ -23,6 +24,9 @@ func TestBuildBlob(t *testing.T) {
 		"content"    : "` + expected.Content + `",
 		"file_name"  : "` + expected.Filename + `",
 		"language"   : "` + expected.Language + `",
+		"vendored"   : false,
+		"generated"  : false,
+		"documentation" : false,
 		"oid"        : "` + expected.OID + `",
 		"path"       : "` + expected.Path + `",
 		"rid"        : "` + expected.RepoID + `",
@@ -38,7 +42,7 @@ func TestBuildBlobSkipsLargeBlobs(t *testing.T) {
 	file := gitFile("foo/bar", "foo")
 	file.Size = 1024*1024 + 1
 
-	blob, err := indexer.BuildBlob(file, parentID, sha, "blob")
+	blob, err := indexer.BuildBlob(file, parentID, sha, "blob", nil)
 	require.Error(t, err, indexer.SkipTooLargeBlob)
 	require.Nil(t, blob)
 }
@@ -46,14 +50,14 @@ func TestBuildBlobSkipsLargeBlobs(t *testing.T) {
 func TestBuildBlobSkipsBinaryBlobs(t *testing.T) {
 	file := gitFile("foo/bar", "foo\x00")
 
-	blob, err := indexer.BuildBlob(file, parentID, sha, "blob")
+	blob, err := indexer.BuildBlob(file, parentID, sha, "blob", nil)
 	require.Equal(t, err, indexer.SkipBinaryBlob)
 	require.Nil(t, blob)
 }
 
 func TestBuildBlobDetectsLanguageByFilename(t *testing.T) {
 	file := gitFile("Makefile.am", "foo")
-	blob, err := indexer.BuildBlob(file, parentID, sha, "blob")
+	blob, err := indexer.BuildBlob(file, parentID, sha, "blob", nil)
 
 	require.NoError(t, err)
 	require.Equal(t, "Makefile", blob.Language)
@@ -61,12 +65,91 @@ func TestBuildBlobDetectsLanguageByFilename(t *testing.T) {
 
 func TestBuildBlobDetectsLanguageByExtension(t *testing.T) {
 	file := gitFile("foo.rb", "foo")
-	blob, err := indexer.BuildBlob(file, parentID, sha, "blob")
+	blob, err := indexer.BuildBlob(file, parentID, sha, "blob", nil)
 
 	require.NoError(t, err)
 	require.Equal(t, "Ruby", blob.Language)
 }
 
+func TestBuildBlobClassifiesLanguageByContent(t *testing.T) {
+	file := gitFile("bin/serve", "package main\n\nimport \"net/http\"\n\nfunc main() {\n\thttp.ListenAndServe(\":8080\", nil)\n}\n")
+	blob, err := indexer.BuildBlob(file, parentID, sha, "blob", nil)
+
+	require.NoError(t, err)
+	require.Equal(t, "Go", blob.Language)
+}
+
+func TestDetectLanguageFallsBackToText(t *testing.T) {
+	for _, tc := range []struct {
+		desc string
+		data string
+	}{
+		{"empty", ""},
+		{"word", "foo"},
+		{"prose", "This project indexes repositories into Elasticsearch. To build it, run\nmake and then install the binary somewhere on your path.\n"},
+		{"release notes", "Release notes\n\nThis release fixes a bug where large repositories could not be indexed, and\nadds support for indexing wikis. Thanks to everyone who reported issues and\nsent patches. Please upgrade as soon as you can.\n"},
+		{"license", "Copyright (c) 2015 The Authors\n\nPermission is hereby granted, free of charge, to any person obtaining a copy\nof this software and associated documentation files (the \"Software\"), to deal\nin the Software without restriction, including without limitation the rights\n"},
+		{"INI", "[database]\nhost = localhost\nport = 5432\nuser = admin\n\n[server]\nlisten = 0.0.0.0\ntimeout = 30\n"},
+		{"INI with comments", "; Settings of the build server\n[build]\nworkers = 4\ncache_dir = /var/cache/build\nkeep_logs = yes\n\n[notifications]\nemail = ops@example.com\non_failure = true\n"},
+		{"setup.cfg", "[metadata]\nname = gitlab-search\nversion = 1.2.0\ndescription = Search the code of your projects\nlicense = MIT\n\n[options]\npackages = find:\npython_requires = >=3.8\n"},
+		{"CSV", "id,name,email,created_at\n1,Alice,alice@example.com,2020-01-02\n2,Bob,bob@example.com,2020-02-03\n3,Carol,carol@example.com,2020-03-04\n"},
+		{"CSV with text", "name,language,stars,description\nrails,Ruby,52000,Ruby on Rails web framework\ndjango,Python,71000,The web framework for perfectionists with deadlines\nflask,Python,63000,A lightweight WSGI web application framework\n"},
+	} {
+		require.Equal(t, "Text", indexer.DetectLanguage("data", []byte(tc.data)), tc.desc)
+	}
+}
+
+func TestBuildBlobAppliesGitattributes(t *testing.T) {
+	attrs := git.ParseGitattributes([]byte("*.tpl linguist-language=ruby\nvendor/** linguist-vendored linguist-generated\n*.md linguist-documentation\n*.rb linguist-language=nobody-will-make-this-language\n"))
+
+	blob, err := indexer.BuildBlob(gitFile("views/index.tpl", "foo"), parentID, sha, "blob", attrs)
+	require.NoError(t, err)
+	require.Equal(t, "Ruby", blob.Language)
+	require.False(t, blob.Vendored)
+
+	blob, err = indexer.BuildBlob(gitFile("vendor/lib/foo.js", "foo"), parentID, sha, "blob", attrs)
+	require.NoError(t, err)
+	require.Equal(t, "JavaScript", blob.Language)
+	require.True(t, blob.Vendored)
+	require.True(t, blob.Generated)
+	require.False(t, blob.Documentation)
+
+	blob, err = indexer.BuildBlob(gitFile("docs/intro.md", "foo"), parentID, sha, "blob", attrs)
+	require.NoError(t, err)
+	require.True(t, blob.Documentation)
+
+	// Unknown languages are ignored
+	blob, err = indexer.BuildBlob(gitFile("foo.rb", "foo"), parentID, sha, "blob", attrs)
+	require.NoError(t, err)
+	require.Equal(t, "Ruby", blob.Language)
+}
+
+func TestBuildBlobClassifiesBlobs(t *testing.T) {
+	blob, err := indexer.BuildBlob(gitFile("node_modules/left-pad/index.js", "module.exports = leftPad;\n"), parentID, sha, "blob", nil)
+	require.NoError(t, err)
+	require.True(t, blob.Vendored)
+	require.True(t, blob.Generated)
+	require.False(t, blob.Documentation)
+
+	blob, err = indexer.BuildBlob(gitFile("docs/install.md", "Run make\n"), parentID, sha, "blob", nil)
+	require.NoError(t, err)
+	require.False(t, blob.Vendored)
+	require.False(t, blob.Generated)
+	require.True(t, blob.Documentation)
+
+	// Attributes take precedence over detection
+	attrs := git.ParseGitattributes([]byte("node_modules/** -linguist-vendored linguist-generated=false\ndocs/** linguist-documentation=false\n"))
+
+	blob, err = indexer.BuildBlob(gitFile("node_modules/left-pad/index.js", "module.exports = leftPad;\n"), parentID, sha, "blob", attrs)
+	require.NoError(t, err)
+	require.False(t, blob.Vendored)
+	require.False(t, blob.Generated)
+
+	blob, err = indexer.BuildBlob(gitFile("docs/install.md", "Run make\n"), parentID, sha, "blob", attrs)
+	require.NoError(t, err)
+	require.False(t, blob.Documentation)
+}
+
 func TestGenerateBlobID(t *testing.T) {
 	require.Equal(t, "2147483648_path", indexer.GenerateBlobID(2147483648, "path"))
 }
@@ -0,0 +1,239 @@
+package indexer
+
+import (
+	"fmt"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+	"gitlab.com/gitlab-org/gitlab-elasticsearch-indexer/checkpoint"
+)
+
+// Confirmer is implemented by Submitters that report which documents have
+// been committed. Checkpoints need it, as work only counts as done once its
+// documents are committed.
+type Confirmer interface {
+	NotifyConfirmed(f func(ids []string))
+}
+
+// streamKind selects the events a checkpoint counts
+type streamKind int
+
+const (
+	fileChanges streamKind = iota
+	commits
+)
+
+// event is a file change or commit, which results in at most one document
+type event struct {
+	stream  *stream
+	name    string
+	pending int
+	open    bool
+}
+
+// stream tracks the events of one kind in the order they are submitted.
+// done counts the events that are confirmed, including resumed ones, and
+// only advances past events which are fully confirmed.
+type stream struct {
+	events []*event
+	done   int
+	last   string
+
+	// skip is the number of events a resumed run skips, and lastSkipped the
+	// name of the last one
+	skip        int
+	lastSkipped string
+	seen        int
+}
+
+// skipNext reports whether the next event is covered by the checkpoint. The
+// last skipped event must have the recorded name, otherwise the repository
+// is streaming something else and the checkpoint can't be trusted.
+func (s *stream) skipNext(name string) (bool, error) {
+	if s.seen >= s.skip {
+		return false, nil
+	}
+
+	s.seen++
+	if s.seen == s.skip && name != s.lastSkipped {
+		return true, fmt.Errorf("Checkpoint doesn't match the repository: expected %q, got %q", s.lastSkipped, name)
+	}
+
+	return true, nil
+}
+
+// advance pops the confirmed events at the head of the stream, and reports
+// whether there were any
+func (s *stream) advance() bool {
+	n := 0
+	for n < len(s.events) && !s.events[n].open && s.events[n].pending == 0 {
+		s.last = s.events[n].name
+		n++
+	}
+
+	s.events = s.events[n:]
+	s.done += n
+
+	return n > 0
+}
+
+// progress saves a checkpoint whenever the Submitter confirms documents that
+// complete events. Events are added by a single goroutine, while
+// confirmations may arrive from any.
+type progress struct {
+	store checkpoint.Store
+	key   checkpoint.Key
+
+	mu      sync.Mutex
+	changes stream
+	commits stream
+	current *event
+	byID    map[string][]*event
+}
+
+func newProgress(store checkpoint.Store, key checkpoint.Key, resume bool) (*progress, error) {
+	p := &progress{store: store, key: key, byID: make(map[string][]*event)}
+	if !resume {
+		return p, nil
+	}
+
+	saved, err := store.Load(key)
+	if err != nil {
+		return nil, fmt.Errorf("Couldn't load checkpoint: %s", err)
+	}
+
+	if saved != nil {
+		log.WithFields(log.Fields{
+			"file_changes": saved.Changes,
+			"commits":      saved.Commits,
+		}).Info("Resuming from checkpoint")
+
+		p.changes = stream{done: saved.Changes, last: saved.LastPath, skip: saved.Changes, lastSkipped: saved.LastPath}
+		p.commits = stream{done: saved.Commits, last: saved.LastCommit, skip: saved.Commits, lastSkipped: saved.LastCommit}
+	}
+
+	return p, nil
+}
+
+func (p *progress) stream(kind streamKind) *stream {
+	if kind == commits {
+		return &p.commits
+	}
+
+	return &p.changes
+}
+
+// skip reports whether the next event of the given kind was confirmed by a previous run.
+// It does nothing if checkpoints are disabled, like the other methods.
+func (p *progress) skip(kind streamKind, name string) (bool, error) {
+	if p == nil {
+		return false, nil
+	}
+
+	return p.stream(kind).skipNext(name)
+}
+
+// track wraps submit so that the documents it submits are attributed to a
+// new event of the given kind
+func (p *progress) track(kind streamKind, name string, submit submitFunc) submitFunc {
+	if p == nil {
+		return submit
+	}
+
+	s := p.stream(kind)
+
+	return func() error {
+		e := &event{stream: s, name: name, open: true}
+
+		p.mu.Lock()
+		s.events = append(s.events, e)
+		p.current = e
+		p.mu.Unlock()
+
+		err := submit()
+
+		p.mu.Lock()
+		e.open = false
+		p.current = nil
+		p.update(s)
+		p.mu.Unlock()
+
+		return err
+	}
+}
+
+// expect attributes a document that is about to be submitted to the current
+// event
+func (p *progress) expect(id string) {
+	if p == nil {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.current == nil {
+		return
+	}
+
+	p.current.pending++
+	p.byID[id] = append(p.byID[id], p.current)
+}
+
+func (p *progress) confirm(ids []string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	streams := make(map[*stream]bool)
+	for _, id := range ids {
+		events := p.byID[id]
+		if len(events) == 0 {
+			continue
+		}
+
+		e := events[0]
+		if len(events) == 1 {
+			delete(p.byID, id)
+		} else {
+			p.byID[id] = events[1:]
+		}
+
+		e.pending--
+		streams[e.stream] = true
+	}
+
+	for s := range streams {
+		p.update(s)
+	}
+}
+
+// update saves a checkpoint if s advanced. It must be called with mu held.
+func (p *progress) update(s *stream) {
+	if !s.advance() {
+		return
+	}
+
+	saved := &checkpoint.Checkpoint{
+		Changes:    p.changes.done,
+		LastPath:   p.changes.last,
+		Commits:    p.commits.done,
+		LastCommit: p.commits.last,
+	}
+
+	if err := p.store.Save(p.key, saved); err != nil {
+		log.WithError(err).Warn("Couldn't save checkpoint")
+	}
+}
+
+// finish removes the checkpoint once everything has been flushed
+func (p *progress) finish() error {
+	if p == nil {
+		return nil
+	}
+
+	if err := p.store.Delete(p.key); err != nil {
+		return fmt.Errorf("Couldn't remove checkpoint: %s", err)
+	}
+
+	return nil
+}
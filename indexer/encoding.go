@@ -2,40 +2,63 @@ package indexer
 
 import (
 	"fmt"
-	"log"
+	"runtime"
+	"sync"
 
+	log "github.com/sirupsen/logrus"
 	"gitlab.com/gitlab-org/gitlab-elasticsearch-indexer/git"
 
 	"gitlab.com/lupine/icu"
 )
 
-var (
+// ICU detectors and converters serialise every call with a mutex, so blobs
+// processed concurrently each take their own pair from the pool instead of
+// sharing one
+type encoder struct {
 	detector  *icu.CharsetDetector
-	converter = icu.NewCharsetConverter(git.LimitFileSize)
-)
+	converter *icu.CharsetConverter
+}
 
-func init() {
-	var err error
-	detector, err = icu.NewCharsetDetector()
+var encoders = sync.Pool{New: newEncoder}
+
+func newEncoder() interface{} {
+	detector, err := icu.NewCharsetDetector()
 	if err != nil {
 		panic(err)
 	}
+
+	e := &encoder{
+		detector:  detector,
+		converter: icu.NewCharsetConverter(git.LimitFileSize),
+	}
+
+	// The pool may drop encoders at any time, so free the detector's native
+	// resources once they're collected
+	runtime.SetFinalizer(e, func(e *encoder) { e.detector.Close() })
+
+	return e
+}
+
+func init() {
+	// Fail at startup, rather than during indexing, if ICU is unusable
+	encoders.Put(newEncoder())
 }
 
 func tryEncodeString(s string) string {
 	encoded, err := encodeString(s)
 	if err != nil {
-		log.Println(err)
+		log.WithError(err).Warn("Couldn't convert to UTF-8")
 		return s // TODO: Run it through the UTF-8 replacement encoder
 	}
 
 	return encoded
 }
 
-func tryEncodeBytes(b []byte) string {
+// tryEncodeBytes logs failures with fields, e.g. the path of the blob
+func tryEncodeBytes(b []byte, fields log.Fields) string {
 	encoded, err := encodeBytes(b)
 	if err != nil {
-		log.Println(err)
+		log.WithFields(fields).WithError(err).Warn("Couldn't convert to UTF-8")
 		s := string(b)
 		return s // TODO: Run it through the UTF-8 replacement encoder
 	}
@@ -53,14 +76,17 @@ func encodeBytes(b []byte) (string, error) {
 		return "", nil
 	}
 
-	matches, err := detector.GuessCharset(b)
+	e := encoders.Get().(*encoder)
+	defer encoders.Put(e)
+
+	matches, err := e.detector.GuessCharset(b)
 	if err != nil {
 		return "", fmt.Errorf("Couldn't guess charset: %s", err)
 	}
 
 	// Try encoding for each match, returning the first that succeeds
 	for _, match := range matches {
-		utf8, err := converter.ConvertToUtf8(b, match.Charset)
+		utf8, err := e.converter.ConvertToUtf8(b, match.Charset)
 		if err == nil {
 			return string(utf8), nil
 		}
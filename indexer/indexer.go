@@ -1,110 +1,351 @@
 package indexer
 
 import (
+	"context"
 	"fmt"
-	"log"
+	"strconv"
 
+	log "github.com/sirupsen/logrus"
+	"gitlab.com/gitlab-org/gitlab-elasticsearch-indexer/checkpoint"
 	"gitlab.com/gitlab-org/gitlab-elasticsearch-indexer/git"
 )
 
 type Submitter interface {
 	ParentID() int64
 
-	Index(id string, thing interface{})
-	Remove(id string)
+	Index(ctx context.Context, id string, thing interface{})
+	Remove(ctx context.Context, id string)
 
-	Flush() error
+	Flush(ctx context.Context) error
 }
 
 type Indexer struct {
 	git.Repository
 	Submitter
+
+	// Workers is the number of blobs processed concurrently. Blobs are
+	// processed inline, as they are streamed, when it is 0 or 1.
+	Workers int
+
+	// Full must be set when the repository walks the whole tree at TO_SHA.
+	// Blob documents that weren't submitted are then removed, as their paths
+	// no longer exist.
+	Full bool
+
+	// Checkpoints, when set, records the file changes and commits whose
+	// documents the Submitter confirmed, under CheckpointKey. With Resume,
+	// the work recorded by an earlier run is skipped.
+	Checkpoints   checkpoint.Store
+	CheckpointKey checkpoint.Key
+	Resume        bool
+
+	// SkipVendored, SkipGenerated and SkipDocumentation leave out blobs
+	// classified as such, like binary blobs
+	SkipVendored      bool
+	SkipGenerated     bool
+	SkipDocumentation bool
+
+	submitted  map[string]bool
+	progress   *progress
+	attributes *git.Gitattributes
 }
 
-func (i *Indexer) submitCommit(c *git.Commit) error {
+func (i *Indexer) submitCommit(ctx context.Context, c *git.Commit) error {
 	commit := BuildCommit(c, i.Submitter.ParentID())
 
 	joinData := map[string]string{
 		"name":   "commit",
 		"parent": fmt.Sprintf("project_%v", i.Submitter.ParentID())}
 
-	i.Submitter.Index(commit.ID, map[string]interface{}{"commit": commit, "type": "commit", "join_field": joinData})
-	return nil
+	i.progress.expect(commit.ID)
+	i.Submitter.Index(ctx, commit.ID, map[string]interface{}{"commit": commit, "type": "commit", "join_field": joinData})
+	commitsIndexed.Inc()
+	return ctx.Err()
 }
 
-func (i *Indexer) submitRepoBlob(f *git.File, _, toCommit string) error {
-	blob, err := BuildBlob(f, i.Submitter.ParentID(), toCommit, "blob")
+// submitBlobDocument indexes a blob or submodule document, keeping track of
+// it in full indexing mode
+func (i *Indexer) submitBlobDocument(ctx context.Context, blobType, id string, thing interface{}) error {
+	if i.submitted != nil {
+		i.submitted[id] = true
+	}
+
+	i.progress.expect(id)
+	i.Submitter.Index(ctx, id, thing)
+	blobsIndexed.WithLabelValues(blobType).Inc()
+	return ctx.Err()
+}
+
+func (i *Indexer) prepareRepoBlob(ctx context.Context, f *git.File, toCommit string) (submitFunc, error) {
+	blobsSeen.WithLabelValues("blob").Inc()
+
+	blob, err := BuildBlob(f, i.Submitter.ParentID(), toCommit, "blob", i.attributes)
 	if err != nil {
 		if isSkipBlobErr(err) {
-			return nil
+			blobsSkipped.WithLabelValues("blob", skipReason(err)).Inc()
+			return skipSubmit, nil
 		}
 
-		return fmt.Errorf("Blob %s: %s", f.Path, err)
+		return nil, fmt.Errorf("Blob %s: %s", f.Path, err)
+	}
+
+	if reason := i.skipClassified(blob); reason != "" {
+		blobsSkipped.WithLabelValues("blob", reason).Inc()
+		return skipSubmit, nil
 	}
 
 	joinData := map[string]string{
 		"name":   "blob",
 		"parent": fmt.Sprintf("project_%v", i.Submitter.ParentID())}
 
-	i.Submitter.Index(blob.ID, map[string]interface{}{"project_id": i.Submitter.ParentID(), "blob": blob, "type": "blob", "join_field": joinData})
-	return nil
+	return func() error {
+		return i.submitBlobDocument(ctx, "blob", blob.ID, map[string]interface{}{"project_id": i.Submitter.ParentID(), "blob": blob, "type": "blob", "join_field": joinData})
+	}, nil
 }
 
-func (i *Indexer) submitWikiBlob(f *git.File, _, toCommit string) error {
-	wikiBlob, err := BuildBlob(f, i.Submitter.ParentID(), toCommit, "wiki_blob")
+func (i *Indexer) prepareWikiBlob(ctx context.Context, f *git.File, toCommit string) (submitFunc, error) {
+	blobsSeen.WithLabelValues("wiki_blob").Inc()
+
+	wikiBlob, err := BuildBlob(f, i.Submitter.ParentID(), toCommit, "wiki_blob", i.attributes)
 	if err != nil {
 		if isSkipBlobErr(err) {
-			return nil
+			blobsSkipped.WithLabelValues("wiki_blob", skipReason(err)).Inc()
+			return skipSubmit, nil
 		}
 
-		return fmt.Errorf("WikiBlob %s: %s", f.Path, err)
+		return nil, fmt.Errorf("WikiBlob %s: %s", f.Path, err)
+	}
+
+	if reason := i.skipClassified(wikiBlob); reason != "" {
+		blobsSkipped.WithLabelValues("wiki_blob", reason).Inc()
+		return skipSubmit, nil
 	}
 
 	joinData := map[string]string{
 		"name":   "wiki_blob",
 		"parent": fmt.Sprintf("project_%v", i.Submitter.ParentID())}
 
-	i.Submitter.Index(wikiBlob.ID, map[string]interface{}{"project_id": i.Submitter.ParentID(), "blob": wikiBlob, "type": "wiki_blob", "join_field": joinData})
-	return nil
+	return func() error {
+		return i.submitBlobDocument(ctx, "wiki_blob", wikiBlob.ID, map[string]interface{}{"project_id": i.Submitter.ParentID(), "blob": wikiBlob, "type": "wiki_blob", "join_field": joinData})
+	}, nil
+}
+
+// skipClassified returns the reason to skip a blob, if it is of a kind that
+// isn't indexed, or ""
+func (i *Indexer) skipClassified(blob *Blob) string {
+	switch {
+	case i.SkipVendored && blob.Vendored:
+		return "vendored"
+	case i.SkipGenerated && blob.Generated:
+		return "generated"
+	case i.SkipDocumentation && blob.Documentation:
+		return "documentation"
+	}
+
+	return ""
+}
+
+func (i *Indexer) prepareSubmodule(ctx context.Context, s *git.Submodule, toCommit string) (submitFunc, error) {
+	submodule := BuildSubmodule(s, i.Submitter.ParentID(), toCommit)
+
+	joinData := map[string]string{
+		"name":   "submodule",
+		"parent": fmt.Sprintf("project_%v", i.Submitter.ParentID())}
+
+	return func() error {
+		return i.submitBlobDocument(ctx, "submodule", submodule.ID, map[string]interface{}{"project_id": i.Submitter.ParentID(), "submodule": submodule, "type": "submodule", "join_field": joinData})
+	}, nil
+}
+
+// Wikis can't contain submodules in any useful way, so we don't index them
+func skipSubmodule(_ context.Context, _ *git.Submodule, _ string) (submitFunc, error) {
+	return skipSubmit, nil
 }
 
-func (i *Indexer) removeBlob(path string) error {
+func (i *Indexer) prepareRemoveBlob(ctx context.Context, blobType, path string) (submitFunc, error) {
 	blobID := GenerateBlobID(i.Submitter.ParentID(), path)
 
-	i.Submitter.Remove(blobID)
+	return func() error {
+		i.progress.expect(blobID)
+		i.Submitter.Remove(ctx, blobID)
+		blobsDeleted.WithLabelValues(blobType).Inc()
+		return ctx.Err()
+	}, nil
+}
+
+type prepareBlobFunc func(ctx context.Context, f *git.File, toCommit string) (submitFunc, error)
+type prepareSubmoduleFunc func(ctx context.Context, s *git.Submodule, toCommit string) (submitFunc, error)
+
+// eachFileChange prepares every change on the pipeline, which submits them
+// in the order the repository streamed them
+func (i *Indexer) eachFileChange(ctx context.Context, blobType string, prepareBlob prepareBlobFunc, prepareSubmodule prepareSubmoduleFunc) error {
+	p := newPipeline(i.Workers)
+
+	err := i.Repository.EachFileChange(
+		ctx,
+		func(f *git.File, _, toCommit string) error {
+			return i.addChange(p, f.Path, true, func() (submitFunc, error) { return prepareBlob(ctx, f, toCommit) })
+		},
+		func(s *git.Submodule, _, toCommit string) error {
+			return i.addChange(p, s.Path, true, func() (submitFunc, error) { return prepareSubmodule(ctx, s, toCommit) })
+		},
+		func(path string) error {
+			return i.addChange(p, path, false, func() (submitFunc, error) { return i.prepareRemoveBlob(ctx, blobType, path) })
+		},
+	)
+
+	return p.wait(err)
+}
+
+// addChange adds a file change to the pipeline, unless a resumed run already
+// confirmed it. Documents put by skipped changes still count as submitted, so
+// that full indexing doesn't remove them.
+func (i *Indexer) addChange(p *pipeline, path string, put bool, prepare func() (submitFunc, error)) error {
+	skip, err := i.progress.skip(fileChanges, path)
+	if err != nil {
+		return err
+	}
+
+	if skip {
+		if put && i.submitted != nil {
+			i.submitted[GenerateBlobID(i.Submitter.ParentID(), path)] = true
+		}
+
+		return nil
+	}
+
+	return p.add(func() (submitFunc, error) {
+		submit, err := prepare()
+		if err != nil {
+			return nil, err
+		}
+
+		return i.progress.track(fileChanges, path, submit), nil
+	})
+}
+
+func (i *Indexer) indexCommits(ctx context.Context) error {
+	return i.Repository.EachCommit(ctx, func(c *git.Commit) error {
+		skip, err := i.progress.skip(commits, c.Hash)
+		if err != nil || skip {
+			return err
+		}
+
+		return i.progress.track(commits, c.Hash, func() error { return i.submitCommit(ctx, c) })()
+	})
+}
+
+// startProgress loads the checkpoint, if enabled, before the first blob or
+// commit is indexed
+func (i *Indexer) startProgress() error {
+	if i.Checkpoints == nil || i.progress != nil {
+		return nil
+	}
+
+	confirmer, ok := i.Submitter.(Confirmer)
+	if !ok {
+		return fmt.Errorf("Checkpoints aren't supported by this submitter")
+	}
+
+	progress, err := newProgress(i.Checkpoints, i.CheckpointKey, i.Resume)
+	if err != nil {
+		return err
+	}
+
+	confirmer.NotifyConfirmed(progress.confirm)
+	i.progress = progress
+
 	return nil
 }
 
-func (i *Indexer) indexCommits() error {
-	return i.Repository.EachCommit(i.submitCommit)
+func (i *Indexer) indexRepoBlobs(ctx context.Context) error {
+	if err := i.eachFileChange(ctx, "blob", i.prepareRepoBlob, i.prepareSubmodule); err != nil {
+		return err
+	}
+
+	if i.Full {
+		return i.removeOrphans(ctx, strconv.FormatInt(i.Submitter.ParentID(), 10), "blob", "submodule")
+	}
+
+	return nil
 }
 
-func (i *Indexer) indexRepoBlobs() error {
-	return i.Repository.EachFileChange(i.submitRepoBlob, i.removeBlob)
+func (i *Indexer) indexWikiBlobs(ctx context.Context) error {
+	if err := i.eachFileChange(ctx, "wiki_blob", i.prepareWikiBlob, skipSubmodule); err != nil {
+		return err
+	}
+
+	if i.Full {
+		return i.removeOrphans(ctx, fmt.Sprintf("wiki_%d", i.Submitter.ParentID()), "wiki_blob")
+	}
+
+	return nil
 }
 
-func (i *Indexer) indexWikiBlobs() error {
-	return i.Repository.EachFileChange(i.submitWikiBlob, i.removeBlob)
+// readGitattributes reads `.gitattributes` at TO_SHA, if the repository can
+// read files. Blobs are indexed without attributes otherwise.
+func (i *Indexer) readGitattributes(ctx context.Context) error {
+	reader, ok := i.Repository.(git.FileReader)
+	if !ok {
+		return nil
+	}
+
+	data, err := reader.ReadFile(ctx, ".gitattributes")
+	if err != nil {
+		return fmt.Errorf("Couldn't read .gitattributes: %s", err)
+	}
+
+	i.attributes = git.ParseGitattributes(data)
+	return nil
 }
 
-func (i *Indexer) Flush() error {
-	return i.Submitter.Flush()
+// Flush commits everything that was submitted. The checkpoint is removed once
+// it succeeds, as there is nothing left to resume.
+func (i *Indexer) Flush(ctx context.Context) error {
+	if err := i.Submitter.Flush(ctx); err != nil {
+		return err
+	}
+
+	return i.progress.finish()
 }
 
-func (i *Indexer) IndexBlobs(blobType string) error {
+// IndexBlobs submits every blob changed between FROM_SHA and TO_SHA. It stops
+// with the context's error as soon as the context is done.
+func (i *Indexer) IndexBlobs(ctx context.Context, blobType string) error {
+	if err := i.startProgress(); err != nil {
+		return err
+	}
+
+	if err := i.readGitattributes(ctx); err != nil {
+		return err
+	}
+
+	if i.Full {
+		if _, ok := i.Submitter.(DocumentLister); !ok {
+			return fmt.Errorf("Full indexing isn't supported by this submitter")
+		}
+
+		i.submitted = make(map[string]bool)
+		defer func() { i.submitted = nil }()
+	}
+
 	switch blobType {
 	case "blob":
-		return i.indexRepoBlobs()
+		return i.indexRepoBlobs(ctx)
 	case "wiki_blob":
-		return i.indexWikiBlobs()
+		return i.indexWikiBlobs(ctx)
 	}
 
 	return fmt.Errorf("Unknown blob type: %v", blobType)
 }
 
-func (i *Indexer) IndexCommits() error {
-	if err := i.indexCommits(); err != nil {
-		log.Print("Error while indexing commits: ", err)
+func (i *Indexer) IndexCommits(ctx context.Context) error {
+	if err := i.startProgress(); err != nil {
+		return err
+	}
+
+	if err := i.indexCommits(ctx); err != nil {
+		log.WithError(err).Error("Error while indexing commits")
 		return err
 	}
 
@@ -1,18 +1,23 @@
 package indexer_test
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"io/ioutil"
+	"net/http/httptest"
 	"path"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
 
+	"gitlab.com/gitlab-org/gitlab-elasticsearch-indexer/checkpoint"
 	"gitlab.com/gitlab-org/gitlab-elasticsearch-indexer/git"
 	"gitlab.com/gitlab-org/gitlab-elasticsearch-indexer/indexer"
+	"gitlab.com/gitlab-org/gitlab-elasticsearch-indexer/metrics"
 )
 
 const (
@@ -31,43 +36,85 @@ type fakeSubmitter struct {
 
 	removed   int
 	removedID []string
+
+	// documents already in the index, by type and repository ID
+	documents map[string][]string
+
+	confirmed func(ids []string)
 }
 
 type fakeRepository struct {
 	commits []*git.Commit
 
-	added    []*git.File
-	modified []*git.File
-	removed  []*git.File
+	added      []*git.File
+	modified   []*git.File
+	removed    []*git.File
+	submodules []*git.Submodule
 }
 
 func (f *fakeSubmitter) ParentID() int64 {
 	return parentID
 }
 
-func (f *fakeSubmitter) Index(id string, thing interface{}) {
+func (f *fakeSubmitter) Index(_ context.Context, id string, thing interface{}) {
 	f.indexed++
 	f.indexedID = append(f.indexedID, id)
 	f.indexedThing = append(f.indexedThing, thing)
 }
 
-func (f *fakeSubmitter) Remove(id string) {
+func (f *fakeSubmitter) Remove(_ context.Context, id string) {
 	f.removed++
 	f.removedID = append(f.removedID, id)
 }
 
-func (f *fakeSubmitter) Flush() error {
+func (f *fakeSubmitter) Flush(_ context.Context) error {
 	f.flushed++
 	return nil
 }
 
-func (r *fakeRepository) EachFileChange(put git.PutFunc, del git.DelFunc) error {
+func (f *fakeSubmitter) EachDocumentID(_ context.Context, docType, repoID string, fn func(id string) error) error {
+	for _, id := range f.documents[docType+":"+repoID] {
+		if err := fn(id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (f *fakeSubmitter) NotifyConfirmed(confirmed func(ids []string)) {
+	f.confirmed = confirmed
+}
+
+type memoryStore map[checkpoint.Key]*checkpoint.Checkpoint
+
+func (m memoryStore) Load(key checkpoint.Key) (*checkpoint.Checkpoint, error) {
+	return m[key], nil
+}
+
+func (m memoryStore) Save(key checkpoint.Key, saved *checkpoint.Checkpoint) error {
+	m[key] = saved
+	return nil
+}
+
+func (m memoryStore) Delete(key checkpoint.Key) error {
+	delete(m, key)
+	return nil
+}
+
+func (r *fakeRepository) EachFileChange(_ context.Context, put git.PutFunc, putSubmodule git.PutSubmoduleFunc, del git.DelFunc) error {
 	for _, file := range r.added {
 		if err := put(file, sha, sha); err != nil {
 			return err
 		}
 	}
 
+	for _, submodule := range r.submodules {
+		if err := putSubmodule(submodule, sha, sha); err != nil {
+			return err
+		}
+	}
+
 	for _, file := range r.modified {
 		if err := put(file, sha, sha); err != nil {
 			return err
@@ -83,7 +130,7 @@ func (r *fakeRepository) EachFileChange(put git.PutFunc, del git.DelFunc) error
 	return nil
 }
 
-func (r *fakeRepository) EachCommit(f git.CommitFunc) error {
+func (r *fakeRepository) EachCommit(_ context.Context, f git.CommitFunc) error {
 	for _, commit := range r.commits {
 		if err := f(commit); err != nil {
 			return err
@@ -93,6 +140,22 @@ func (r *fakeRepository) EachCommit(f git.CommitFunc) error {
 	return nil
 }
 
+// fakeFileRepository can also read files at TO_SHA
+type fakeFileRepository struct {
+	*fakeRepository
+
+	files map[string]string
+}
+
+func (r *fakeFileRepository) ReadFile(_ context.Context, path string) ([]byte, error) {
+	data, ok := r.files[path]
+	if !ok {
+		return nil, nil
+	}
+
+	return []byte(data), nil
+}
+
 func setupIndexer() (*indexer.Indexer, *fakeRepository, *fakeSubmitter) {
 	repo := &fakeRepository{}
 	submitter := &fakeSubmitter{}
@@ -162,15 +225,19 @@ func validCommit(gitCommit *git.Commit) *indexer.Commit {
 }
 
 func index(idx *indexer.Indexer) error {
-	if err := idx.IndexBlobs("blob"); err != nil {
+	return indexWithContext(context.Background(), idx)
+}
+
+func indexWithContext(ctx context.Context, idx *indexer.Indexer) error {
+	if err := idx.IndexBlobs(ctx, "blob"); err != nil {
 		return err
 	}
 
-	if err := idx.IndexCommits(); err != nil {
+	if err := idx.IndexCommits(ctx); err != nil {
 		return err
 	}
 
-	if err := idx.Flush(); err != nil {
+	if err := idx.Flush(ctx); err != nil {
 		return err
 	}
 
@@ -178,7 +245,16 @@ func index(idx *indexer.Indexer) error {
 }
 
 func TestIndex(t *testing.T) {
+	for _, workers := range []int{0, 1, 4} {
+		t.Run(fmt.Sprintf("%d workers", workers), func(t *testing.T) {
+			testIndex(t, workers)
+		})
+	}
+}
+
+func testIndex(t *testing.T, workers int) {
 	idx, repo, submit := setupIndexer()
+	idx.Workers = workers
 
 	gitCommit := gitCommit("Initial commit")
 	gitAdded := gitFile("foo/bar", "added file")
@@ -222,8 +298,279 @@ func TestIndex(t *testing.T) {
 	require.Equal(t, submit.flushed, 1)
 }
 
+func TestIndexAppliesGitattributes(t *testing.T) {
+	idx, repo, submit := setupIndexer()
+	idx.Repository = &fakeFileRepository{
+		fakeRepository: repo,
+		files:          map[string]string{".gitattributes": "*.tpl linguist-language=Ruby\nvendor/** linguist-vendored\n"},
+	}
+
+	gitTemplate := gitFile("views/index.tpl", "template")
+	gitVendored := gitFile("vendor/lib.js", "vendored")
+	repo.added = append(repo.added, gitTemplate, gitVendored)
+
+	require.NoError(t, idx.IndexBlobs(context.Background(), "blob"))
+	require.Equal(t, 2, submit.indexed)
+
+	template := validBlob(gitTemplate, "template", "Ruby")
+	require.Equal(t, template, submit.indexedThing[0].(map[string]interface{})["blob"])
+
+	vendored := validBlob(gitVendored, "vendored", "JavaScript")
+	vendored.Vendored = true
+	require.Equal(t, vendored, submit.indexedThing[1].(map[string]interface{})["blob"])
+}
+
+func TestIndexSkipsClassifiedBlobs(t *testing.T) {
+	skipped := []string{
+		`gitlab_elasticsearch_indexer_blobs_skipped_total{reason="vendored",type="blob"}`,
+		`gitlab_elasticsearch_indexer_blobs_skipped_total{reason="generated",type="blob"}`,
+		`gitlab_elasticsearch_indexer_blobs_skipped_total{reason="documentation",type="blob"}`,
+	}
+
+	before := make([]float64, len(skipped))
+	for n, s := range skipped {
+		before[n] = metricValue(t, s)
+	}
+
+	idx, repo, submit := setupIndexer()
+	idx.SkipVendored = true
+	idx.SkipGenerated = true
+	idx.SkipDocumentation = true
+
+	repo.added = append(
+		repo.added,
+		gitFile("main.go", "package main\n"),
+		gitFile("vendor/lib/lib.go", "package lib\n"),
+		gitFile("package-lock.json", "{}\n"),
+		gitFile("README.md", "# Project\n"),
+	)
+
+	require.NoError(t, idx.IndexBlobs(context.Background(), "blob"))
+	require.Equal(t, []string{parentIDString + "_main.go"}, submit.indexedID)
+
+	for n, s := range skipped {
+		require.Equal(t, float64(1), metricValue(t, s)-before[n], s)
+	}
+}
+
+// metricValue returns the value of a series of metrics.Registry, or 0 if
+// it wasn't written yet
+func metricValue(t *testing.T, series string) float64 {
+	rec := httptest.NewRecorder()
+	metrics.Handler().ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+
+	for _, line := range strings.Split(rec.Body.String(), "\n") {
+		if strings.HasPrefix(line, series+" ") {
+			value, err := strconv.ParseFloat(strings.TrimPrefix(line, series+" "), 64)
+			require.NoError(t, err)
+
+			return value
+		}
+	}
+
+	return 0
+}
+
+func TestIndexRecordsMetrics(t *testing.T) {
+	series := []string{
+		`gitlab_elasticsearch_indexer_blobs_seen_total{type="blob"}`,
+		`gitlab_elasticsearch_indexer_blobs_indexed_total{type="blob"}`,
+		`gitlab_elasticsearch_indexer_blobs_skipped_total{reason="too_large",type="blob"}`,
+		`gitlab_elasticsearch_indexer_blobs_skipped_total{reason="binary",type="blob"}`,
+		`gitlab_elasticsearch_indexer_blobs_deleted_total{type="blob"}`,
+		`gitlab_elasticsearch_indexer_commits_indexed_total`,
+	}
+
+	before := make([]float64, len(series))
+	for n, s := range series {
+		before[n] = metricValue(t, s)
+	}
+
+	idx, repo, _ := setupIndexer()
+
+	gitTooBig := gitFile("invalid/too-big", "")
+	gitTooBig.Size = int64(1024*1024 + 1)
+
+	repo.commits = append(repo.commits, gitCommit("Initial commit"))
+	repo.added = append(repo.added, gitFile("foo/bar", "added file"), gitTooBig, gitFile("invalid/binary", "foo\x00"))
+	repo.modified = append(repo.modified, gitFile("foo/baz", "modified file"))
+	repo.removed = append(repo.removed, gitFile("foo/qux", "removed file"))
+
+	require.NoError(t, index(idx))
+
+	var deltas []float64
+	for n, s := range series {
+		deltas = append(deltas, metricValue(t, s)-before[n])
+	}
+
+	require.Equal(t, []float64{4, 2, 1, 1, 1, 1}, deltas)
+}
+
+func TestIndexSubmodules(t *testing.T) {
+	idx, repo, submit := setupIndexer()
+
+	repo.submodules = append(repo.submodules, &git.Submodule{
+		Path:      "vendor/six",
+		CommitSHA: oid,
+		URL:       "git://github.com/randx/six.git",
+	})
+
+	require.NoError(t, idx.IndexBlobs(context.Background(), "blob"))
+
+	join_data_submodule := map[string]string{"name": "submodule", "parent": "project_" + parentIDString}
+	submodule := &indexer.Submodule{
+		Type:      "submodule",
+		ID:        indexer.GenerateBlobID(parentID, "vendor/six"),
+		OID:       oid,
+		RepoID:    parentIDString,
+		CommitSHA: sha,
+		Path:      "vendor/six",
+		Filename:  "six",
+		URL:       "git://github.com/randx/six.git",
+	}
+
+	require.Equal(t, 1, submit.indexed)
+	require.Equal(t, parentIDString+"_vendor/six", submit.indexedID[0])
+	require.Equal(t, map[string]interface{}{"project_id": parentID, "submodule": submodule, "join_field": join_data_submodule, "type": "submodule"}, submit.indexedThing[0])
+}
+
+func TestWikiBlobsSkipSubmodules(t *testing.T) {
+	idx, repo, submit := setupIndexer()
+
+	repo.submodules = append(repo.submodules, &git.Submodule{Path: "vendor/six", CommitSHA: oid})
+
+	require.NoError(t, idx.IndexBlobs(context.Background(), "wiki_blob"))
+	require.Equal(t, 0, submit.indexed)
+}
+
+func TestFullIndexRemovesOrphans(t *testing.T) {
+	idx, repo, submit := setupIndexer()
+	idx.Full = true
+
+	repo.added = append(repo.added, gitFile("foo/bar", "kept file"), gitFile("invalid/binary", "foo\x00"))
+	repo.submodules = append(repo.submodules, &git.Submodule{Path: "vendor/six", CommitSHA: oid})
+
+	submit.documents = map[string][]string{
+		"blob:" + parentIDString: {
+			parentIDString + "_foo/bar",
+			parentIDString + "_foo/gone",
+			parentIDString + "_invalid/binary",
+		},
+		"submodule:" + parentIDString: {
+			parentIDString + "_vendor/six",
+			parentIDString + "_vendor/gone",
+		},
+		"wiki_blob:wiki_" + parentIDString: {
+			parentIDString + "_home.md",
+		},
+	}
+
+	require.NoError(t, idx.IndexBlobs(context.Background(), "blob"))
+
+	require.Equal(t, []string{parentIDString + "_foo/bar", parentIDString + "_vendor/six"}, submit.indexedID)
+	require.Equal(t, []string{
+		parentIDString + "_foo/gone",
+		parentIDString + "_invalid/binary",
+		parentIDString + "_vendor/gone",
+	}, submit.removedID)
+}
+
+func TestFullIndexWikiBlobsRemovesOrphans(t *testing.T) {
+	idx, repo, submit := setupIndexer()
+	idx.Full = true
+
+	repo.added = append(repo.added, gitFile("home.md", "kept page"))
+
+	submit.documents = map[string][]string{
+		"blob:" + parentIDString:           {parentIDString + "_foo/bar"},
+		"wiki_blob:wiki_" + parentIDString: {parentIDString + "_home.md", parentIDString + "_gone.md"},
+	}
+
+	require.NoError(t, idx.IndexBlobs(context.Background(), "wiki_blob"))
+
+	require.Equal(t, []string{parentIDString + "_home.md"}, submit.indexedID)
+	require.Equal(t, []string{parentIDString + "_gone.md"}, submit.removedID)
+}
+
+func TestFullIndexRequiresDocumentLister(t *testing.T) {
+	idx, repo, submit := setupIndexer()
+	idx.Full = true
+	idx.Submitter = struct{ indexer.Submitter }{submit}
+
+	repo.added = append(repo.added, gitFile("foo/bar", "file"))
+
+	require.Error(t, idx.IndexBlobs(context.Background(), "blob"))
+	require.Equal(t, 0, submit.indexed)
+}
+
+var checkpointKey = checkpoint.Key{ProjectID: parentID, BlobType: "blob", FromSHA: oid, ToSHA: sha}
+
+func TestCheckpointsRecordConfirmedWork(t *testing.T) {
+	idx, repo, submit := setupIndexer()
+	store := memoryStore{}
+	idx.Checkpoints = store
+	idx.CheckpointKey = checkpointKey
+
+	repo.added = append(repo.added, gitFile("a", "a"), gitFile("invalid/binary", "foo\x00"), gitFile("b", "b"))
+	repo.removed = append(repo.removed, gitFile("c", "c"))
+	repo.commits = append(repo.commits, gitCommit("Initial commit"))
+
+	require.NoError(t, idx.IndexBlobs(context.Background(), "blob"))
+	require.NoError(t, idx.IndexCommits(context.Background()))
+	require.Empty(t, store)
+
+	// Nothing is recorded until every earlier change is confirmed
+	submit.confirmed([]string{parentIDString + "_b"})
+	require.Empty(t, store)
+
+	submit.confirmed([]string{parentIDString + "_a"})
+	require.Equal(t, &checkpoint.Checkpoint{Changes: 3, LastPath: "b"}, store[checkpointKey])
+
+	submit.confirmed([]string{parentIDString + "_" + sha, parentIDString + "_c"})
+	require.Equal(t, &checkpoint.Checkpoint{Changes: 4, LastPath: "c", Commits: 1, LastCommit: sha}, store[checkpointKey])
+
+	require.NoError(t, idx.Flush(context.Background()))
+	require.Empty(t, store)
+}
+
+func TestResumeSkipsCheckpointedWork(t *testing.T) {
+	idx, repo, submit := setupIndexer()
+	idx.Checkpoints = memoryStore{checkpointKey: {Changes: 2, LastPath: "b", Commits: 1, LastCommit: sha}}
+	idx.CheckpointKey = checkpointKey
+	idx.Resume = true
+
+	repo.added = append(repo.added, gitFile("a", "a"), gitFile("b", "b"), gitFile("c", "c"))
+	repo.commits = append(repo.commits, gitCommit("Initial commit"))
+
+	require.NoError(t, idx.IndexBlobs(context.Background(), "blob"))
+	require.NoError(t, idx.IndexCommits(context.Background()))
+
+	require.Equal(t, []string{parentIDString + "_c"}, submit.indexedID)
+}
+
+func TestResumeWithMismatchedCheckpointFails(t *testing.T) {
+	idx, repo, submit := setupIndexer()
+	idx.Checkpoints = memoryStore{checkpointKey: {Changes: 2, LastPath: "other"}}
+	idx.CheckpointKey = checkpointKey
+	idx.Resume = true
+
+	repo.added = append(repo.added, gitFile("a", "a"), gitFile("b", "b"), gitFile("c", "c"))
+
+	require.Error(t, idx.IndexBlobs(context.Background(), "blob"))
+	require.Equal(t, 0, submit.indexed)
+}
+
 func TestErrorIndexingSkipsRemainder(t *testing.T) {
+	for _, workers := range []int{0, 4} {
+		t.Run(fmt.Sprintf("%d workers", workers), func(t *testing.T) {
+			testErrorIndexingSkipsRemainder(t, workers)
+		})
+	}
+}
+
+func testErrorIndexingSkipsRemainder(t *testing.T, workers int) {
 	idx, repo, submit := setupIndexer()
+	idx.Workers = workers
 
 	gitOKFile := gitFile("ok", "")
 
@@ -239,3 +586,41 @@ func TestErrorIndexingSkipsRemainder(t *testing.T) {
 	require.Equal(t, submit.removed, 0)
 	require.Equal(t, submit.flushed, 0)
 }
+
+func TestWorkersSubmitInStreamOrder(t *testing.T) {
+	idx, repo, submit := setupIndexer()
+	idx.Workers = 8
+
+	var expected []string
+	for n := 0; n < 100; n++ {
+		file := gitFile(fmt.Sprintf("file-%03d", n), strings.Repeat("content ", n))
+		repo.added = append(repo.added, file)
+		expected = append(expected, parentIDString+"_"+file.Path)
+	}
+
+	gitBreakingFile := gitFile("broken", "")
+	gitBreakingFile.Blob = readerFunc("", fmt.Errorf("Error"))
+	repo.added = append(repo.added, gitBreakingFile, gitFile("after-broken", ""))
+
+	err := idx.IndexBlobs(context.Background(), "blob")
+
+	require.Error(t, err)
+	require.Equal(t, expected, submit.indexedID)
+	require.Equal(t, 0, submit.flushed)
+}
+
+func TestCancelledContextStopsIndexing(t *testing.T) {
+	idx, repo, submit := setupIndexer()
+
+	repo.added = append(repo.added, gitFile("foo/bar", "added file"), gitFile("foo/baz", "added file"))
+	repo.commits = append(repo.commits, gitCommit("Initial commit"))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := indexWithContext(ctx, idx)
+
+	require.Equal(t, context.Canceled, err)
+	require.Equal(t, 1, submit.indexed)
+	require.Equal(t, 0, submit.flushed)
+}
@@ -0,0 +1,55 @@
+package indexer
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"gitlab.com/gitlab-org/gitlab-elasticsearch-indexer/metrics"
+)
+
+var (
+	blobsSeen = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metrics.Namespace,
+		Name:      "blobs_seen_total",
+		Help:      "Blobs added or modified in the indexed range, by blob type",
+	}, []string{"type"})
+
+	blobsIndexed = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metrics.Namespace,
+		Name:      "blobs_indexed_total",
+		Help:      "Blob and submodule documents submitted, by type",
+	}, []string{"type"})
+
+	blobsSkipped = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metrics.Namespace,
+		Name:      "blobs_skipped_total",
+		Help:      "Blobs that weren't indexed, by blob type and reason",
+	}, []string{"type", "reason"})
+
+	blobsDeleted = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metrics.Namespace,
+		Name:      "blobs_deleted_total",
+		Help:      "Blob and submodule documents removed, as their paths were deleted or no longer exist, by type",
+	}, []string{"type"})
+
+	commitsIndexed = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: metrics.Namespace,
+		Name:      "commits_indexed_total",
+		Help:      "Commit documents submitted",
+	})
+)
+
+func init() {
+	metrics.Registry.MustRegister(blobsSeen, blobsIndexed, blobsSkipped, blobsDeleted, commitsIndexed)
+}
+
+// skipReason is the reason label of blobsSkipped for err
+func skipReason(err error) string {
+	switch err {
+	case SkipTooLargeBlob:
+		return "too_large"
+	case SkipBinaryBlob:
+		return "binary"
+	}
+
+	return "unknown"
+}
@@ -0,0 +1,49 @@
+package indexer
+
+import (
+	"context"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// DocumentLister is implemented by Submitters that can list the documents
+// already in the index. Full indexing needs it to find orphaned documents.
+type DocumentLister interface {
+	EachDocumentID(ctx context.Context, docType, repoID string, f func(id string) error) error
+}
+
+// removeOrphans removes every document of the given types belonging to repoID
+// that wasn't submitted during this run
+func (i *Indexer) removeOrphans(ctx context.Context, repoID string, docTypes ...string) error {
+	lister := i.Submitter.(DocumentLister)
+
+	orphans := make(map[string][]string)
+	count := 0
+	for _, docType := range docTypes {
+		err := lister.EachDocumentID(ctx, docType, repoID, func(id string) error {
+			if !i.submitted[id] {
+				orphans[docType] = append(orphans[docType], id)
+				count++
+			}
+
+			return nil
+		})
+
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, docType := range docTypes {
+		for _, id := range orphans[docType] {
+			i.Submitter.Remove(ctx, id)
+			blobsDeleted.WithLabelValues(docType).Inc()
+		}
+	}
+
+	if count > 0 {
+		log.WithField("documents", count).Info("Removing orphaned documents")
+	}
+
+	return ctx.Err()
+}
@@ -0,0 +1,154 @@
+package indexer
+
+import (
+	"sync"
+)
+
+// submitFunc hands a prepared document to the Submitter
+type submitFunc func() error
+
+func skipSubmit() error {
+	return nil
+}
+
+type job struct {
+	prepare func() (submitFunc, error)
+
+	ready  chan struct{}
+	submit submitFunc
+	err    error
+}
+
+// pipeline prepares documents, which involves charset detection, conversion
+// and language detection, on a bounded pool of workers. Prepared documents
+// are submitted by a single goroutine in the order they were added, so the
+// output doesn't depend on scheduling. Once a job fails, nothing after it is
+// submitted and the error is returned by add and wait.
+//
+// With fewer than two workers, jobs are prepared and submitted inline.
+type pipeline struct {
+	workers int
+	jobs    chan *job
+	queue   chan *job
+	wg      sync.WaitGroup
+	done    chan struct{}
+
+	// failed is closed when err is set
+	failed   chan struct{}
+	failOnce sync.Once
+	err      error
+}
+
+func newPipeline(workers int) *pipeline {
+	p := &pipeline{workers: workers}
+	if workers < 2 {
+		return p
+	}
+
+	p.jobs = make(chan *job)
+	p.queue = make(chan *job, workers)
+	p.done = make(chan struct{})
+	p.failed = make(chan struct{})
+
+	for n := 0; n < workers; n++ {
+		p.wg.Add(1)
+		go p.work()
+	}
+
+	go p.submitAll()
+
+	return p
+}
+
+func (p *pipeline) add(prepare func() (submitFunc, error)) error {
+	if p.workers < 2 {
+		submit, err := prepare()
+		if err != nil {
+			return err
+		}
+
+		return submit()
+	}
+
+	// Once a job failed, the queue may still have room, and select would pick
+	// either case at random
+	select {
+	case <-p.failed:
+		return p.err
+	default:
+	}
+
+	j := &job{prepare: prepare, ready: make(chan struct{})}
+
+	// Queue the job before handing it to a worker, to keep the order
+	select {
+	case p.queue <- j:
+	case <-p.failed:
+		return p.err
+	}
+
+	p.jobs <- j
+
+	return nil
+}
+
+func (p *pipeline) work() {
+	defer p.wg.Done()
+
+	for j := range p.jobs {
+		j.submit, j.err = j.prepare()
+		close(j.ready)
+	}
+}
+
+func (p *pipeline) submitAll() {
+	defer close(p.done)
+
+	for j := range p.queue {
+		<-j.ready
+
+		// Drain the queue without submitting anything once a job has failed
+		select {
+		case <-p.failed:
+			continue
+		default:
+		}
+
+		err := j.err
+		if err == nil {
+			err = j.submit()
+		}
+
+		if err != nil {
+			p.fail(err)
+		}
+	}
+}
+
+func (p *pipeline) fail(err error) {
+	p.failOnce.Do(func() {
+		p.err = err
+		close(p.failed)
+	})
+}
+
+// wait stops the workers once every job has been submitted. The first error
+// of the pipeline takes precedence over err, which should be the error the
+// repository returned.
+func (p *pipeline) wait(err error) error {
+	if p.workers < 2 {
+		return err
+	}
+
+	close(p.jobs)
+	p.wg.Wait()
+	close(p.queue)
+	<-p.done
+
+	select {
+	case <-p.failed:
+		return p.err
+	default:
+		return err
+	}
+}
@@ -0,0 +1,36 @@
+package indexer
+
+import (
+	"path"
+	"strconv"
+
+	"gitlab.com/gitlab-org/gitlab-elasticsearch-indexer/git"
+)
+
+// Submodule is the document stored for a gitlink entry. It shares its ID
+// with blobs, so removing the path removes the submodule too.
+type Submodule struct {
+	Type      string `json:"type"`
+	ID        string `json:"-"`
+	OID       string `json:"oid"` // The commit the submodule is pinned to
+	RepoID    string `json:"rid"`
+	CommitSHA string `json:"commit_sha"`
+	Path      string `json:"path"`
+	Filename  string `json:"file_name"`
+	URL       string `json:"url"`
+}
+
+func BuildSubmodule(s *git.Submodule, parentID int64, commitSHA string) *Submodule {
+	filename := tryEncodeString(s.Path)
+
+	return &Submodule{
+		Type:      "submodule",
+		ID:        GenerateBlobID(parentID, filename),
+		OID:       s.CommitSHA,
+		RepoID:    strconv.FormatInt(parentID, 10),
+		CommitSHA: commitSHA,
+		Path:      filename,
+		Filename:  path.Base(filename),
+		URL:       tryEncodeString(s.URL),
+	}
+}
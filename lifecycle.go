@@ -0,0 +1,80 @@
+package main
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"gitlab.com/gitlab-org/gitlab-elasticsearch-indexer/elastic"
+)
+
+const indexUsage = "index (create | reindex | swap <index> | status)"
+
+// runIndexCommand manages the versioned indices behind the read alias, which
+// is the configured index_name, and its write alias
+func runIndexCommand(ctx context.Context, args []string) {
+	if len(args) == 0 {
+		log.Fatalf("Usage: %s %s", os.Args[0], indexUsage)
+	}
+
+	esClient, _, err := openClient(0)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer esClient.Close()
+
+	readAlias := esClient.IndexName
+	writeAlias := elastic.WriteAlias(readAlias)
+
+	switch {
+	case args[0] == "create" && len(args) == 1:
+		name, err := esClient.CreateAliasedIndex(ctx)
+		if err != nil {
+			fatal(ctx, err)
+		}
+
+		log.Infof("Created %s, aliased as %s and %s", name, readAlias, writeAlias)
+	case args[0] == "reindex" && len(args) == 1:
+		name, result, err := esClient.ReindexAliased(ctx)
+		if result != nil {
+			for _, failure := range result.Failures {
+				log.WithField("failure", failure).Error("Document couldn't be copied")
+			}
+
+			log.WithFields(log.Fields{
+				"total":     result.Total,
+				"created":   result.Created,
+				"conflicts": result.Conflicts,
+				"failed":    len(result.Failures),
+			}).Info("Reindex summary")
+		}
+
+		if err != nil {
+			if name != "" {
+				log.Errorf("%s still reads from the old index, writes go to %s", readAlias, name)
+			}
+
+			fatal(ctx, err)
+		}
+
+		log.Infof("Reindexed into %s, aliased as %s and %s", name, readAlias, writeAlias)
+	case args[0] == "swap" && len(args) == 2:
+		if err := esClient.PointAliases(ctx, args[1], readAlias, writeAlias); err != nil {
+			fatal(ctx, err)
+		}
+
+		log.Infof("%s and %s now point at %s", readAlias, writeAlias, args[1])
+	case args[0] == "status" && len(args) == 1:
+		for _, alias := range []string{readAlias, writeAlias} {
+			indices, err := esClient.AliasedIndices(ctx, alias)
+			if err != nil {
+				fatal(ctx, err)
+			}
+
+			log.Infof("%s: %s", alias, strings.Join(indices, ", "))
+		}
+	default:
+		log.Fatalf("Usage: %s %s", os.Args[0], indexUsage)
+	}
+}
@@ -0,0 +1,122 @@
+package linguist
+
+import (
+	"math"
+	"sort"
+)
+
+// minClassifierTokens is the number of tokens below which the confidence of
+// the classifier is lowered
+const minClassifierTokens = 8
+
+//go:generate go run generate_samples.go
+
+// samples holds token counts of the sample files the classifier is trained
+// on, by language
+type samples struct {
+	// Sample files by language
+	Languages      map[string]int
+	LanguagesTotal int
+
+	// Occurrences of each token in the samples of a language
+	Tokens         map[string]map[string]int
+	LanguageTokens map[string]int
+	TokensTotal    int
+}
+
+// Classify guesses which of candidates blob is written in with a naive Bayes
+// classifier, as github-linguist does. Candidates without samples are left
+// out. With no candidates, every language with samples is tried, including
+// the samples of plain text and data formats that aren't code.
+//
+// The confidence, between 0 and 1, is the probability the classifier gives
+// its guess against the other candidates, weighted by the share of the
+// blob's tokens seen in the samples of that language. Text unlike any of the
+// samples gets a low one, as does a blob of only a few tokens.
+func Classify(blob []byte, candidates []*Language) (*Language, float64) {
+	return classifierSamples.classify(Tokenize(blob), candidates)
+}
+
+func (s *samples) classify(tokens []string, candidates []*Language) (*Language, float64) {
+	if len(tokens) == 0 {
+		return nil, 0
+	}
+
+	if len(candidates) == 0 {
+		candidates = s.languages()
+	}
+
+	var (
+		best        *Language
+		bestScore   float64
+		scores      []float64
+		tokensTotal = float64(s.TokensTotal)
+	)
+
+	for _, lang := range candidates {
+		counts, ok := s.Tokens[lang.Name]
+		if !ok {
+			continue
+		}
+
+		languageTokens := float64(s.LanguageTokens[lang.Name])
+		score := math.Log(float64(s.Languages[lang.Name]) / float64(s.LanguagesTotal))
+		for _, token := range tokens {
+			if count := counts[token]; count > 0 {
+				score += math.Log(float64(count) / languageTokens)
+			} else {
+				score += math.Log(1 / tokensTotal)
+			}
+		}
+
+		scores = append(scores, score)
+		if best == nil || score > bestScore {
+			best, bestScore = lang, score
+		}
+	}
+
+	if best == nil {
+		return nil, 0
+	}
+
+	// The scores are log probabilities, so this is the probability of the
+	// guess normalised over the candidates
+	sum := 0.0
+	for _, score := range scores {
+		sum += math.Exp(score - bestScore)
+	}
+
+	seen := 0
+	for _, token := range tokens {
+		if s.Tokens[best.Name][token] > 0 {
+			seen++
+		}
+	}
+
+	// A few tokens are little evidence of anything
+	evidence := float64(len(tokens)) / minClassifierTokens
+	if evidence > 1 {
+		evidence = 1
+	}
+
+	return best, (1 / sum) * evidence * float64(seen) / float64(len(tokens))
+}
+
+// languages returns the languages with samples, sorted by name so that ties
+// are broken the same way every time
+func (s *samples) languages() []*Language {
+	names := make([]string, 0, len(s.Tokens))
+	for name := range s.Tokens {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	languages := make([]*Language, 0, len(names))
+	for _, name := range names {
+		if lang, ok := Languages[name]; ok {
+			languages = append(languages, lang)
+		}
+	}
+
+	return languages
+}
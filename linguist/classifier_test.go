@@ -0,0 +1,57 @@
+package linguist_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/gitlab-elasticsearch-indexer/linguist"
+)
+
+func TestClassifyWithoutCandidates(t *testing.T) {
+	for _, tc := range []struct {
+		lang string
+		blob string
+	}{
+		{"Go", "package main\n\nimport \"fmt\"\n\nfunc main() {\n\tfmt.Println(\"hi\")\n}\n"},
+		{"Python", "def greet(name):\n    return name.upper()\n\nif __name__ == '__main__':\n    print(greet('x'))\n"},
+		{"Rust", "fn main() {\n    let v: Vec<i32> = Vec::new();\n    println!(\"{:?}\", v);\n}\n"},
+		{"Haskell", "module Main where\n\nmain :: IO ()\nmain = mapM_ print [1..10]\n"},
+	} {
+		lang, confidence := linguist.Classify([]byte(tc.blob), nil)
+		require.NotNil(t, lang, tc.lang)
+		require.Equal(t, tc.lang, lang.Name)
+		require.True(t, confidence > 0.5, "%s: %f", tc.lang, confidence)
+	}
+}
+
+func TestClassifyChoosesAmongCandidates(t *testing.T) {
+	candidates := linguist.DetectLanguageByExtension("foo.h")
+
+	lang, _ := linguist.Classify([]byte("class Foo {\npublic:\n    Foo();\n    virtual ~Foo();\n};\n"), candidates)
+	require.Equal(t, "C++", lang.Name)
+
+	lang, _ = linguist.Classify([]byte("typedef struct {\n\tint len;\n} buffer_t;\n"), candidates)
+	require.Equal(t, "C", lang.Name)
+
+	// Without samples of any candidate, there is nothing to go on
+	lang, confidence := linguist.Classify([]byte("int x;\n"), []*linguist.Language{linguist.Languages["Ada"]})
+	require.Nil(t, lang)
+	require.Zero(t, confidence)
+}
+
+func TestClassifyRecognisesText(t *testing.T) {
+	prose := "This project indexes repositories into Elasticsearch. To build it, run\nmake and then install the binary somewhere on your path.\n"
+
+	lang, _ := linguist.Classify([]byte(prose), nil)
+	require.Equal(t, "Text", lang.Name)
+
+	lang, confidence := linguist.Classify([]byte{}, nil)
+	require.Nil(t, lang)
+	require.Zero(t, confidence)
+}
+
+func TestClassifyHasLowConfidenceInFewTokens(t *testing.T) {
+	_, confidence := linguist.Classify([]byte("foo"), nil)
+	require.True(t, confidence < 0.5, "%f", confidence)
+}
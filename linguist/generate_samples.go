@@ -0,0 +1,136 @@
+//go:build ignore
+// +build ignore
+
+// This program trains the classifier on the sample files in
+// testdata/samples/<language name>, and writes the token counts to samples.go.
+// Another directory laid out the same way, like the samples directory of
+// github-linguist, can be passed instead. Languages this package doesn't know
+// are skipped.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"gitlab.com/gitlab-org/gitlab-elasticsearch-indexer/linguist"
+)
+
+func main() {
+	samplesDir := "testdata/samples"
+	if len(os.Args) > 1 {
+		samplesDir = os.Args[1]
+	}
+
+	dirs, err := ioutil.ReadDir(samplesDir)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var (
+		languages      = map[string]int{}
+		tokens         = map[string]map[string]int{}
+		languageTokens = map[string]int{}
+		languagesTotal int
+		tokensTotal    int
+	)
+
+	for _, dir := range dirs {
+		name := dir.Name()
+		if !dir.IsDir() {
+			continue
+		}
+
+		if _, ok := linguist.Languages[name]; !ok {
+			log.Printf("Skipping unknown language %q in %s", name, samplesDir)
+			continue
+		}
+
+		// Samples may be nested, like the filenames directories of
+		// github-linguist
+		tokens[name] = map[string]int{}
+		err := filepath.Walk(filepath.Join(samplesDir, name), func(path string, info os.FileInfo, err error) error {
+			if err != nil || info.IsDir() {
+				return err
+			}
+
+			blob, err := ioutil.ReadFile(path)
+			if err != nil {
+				return err
+			}
+
+			languages[name]++
+			languagesTotal++
+
+			for _, token := range linguist.Tokenize(blob) {
+				tokens[name][token]++
+				languageTokens[name]++
+				tokensTotal++
+			}
+
+			return nil
+		})
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by generate_samples.go. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&buf, "package linguist\n\n")
+	fmt.Fprintf(&buf, "var classifierSamples = &samples{\n")
+	fmt.Fprintf(&buf, "Languages: %s,\n", formatCounts(languages))
+	fmt.Fprintf(&buf, "LanguagesTotal: %d,\n", languagesTotal)
+	fmt.Fprintf(&buf, "Tokens: map[string]map[string]int{\n")
+	for _, name := range sortedKeys(tokens) {
+		fmt.Fprintf(&buf, "%q: %s,\n", name, formatCounts(tokens[name]))
+	}
+	fmt.Fprintf(&buf, "},\n")
+	fmt.Fprintf(&buf, "LanguageTokens: %s,\n", formatCounts(languageTokens))
+	fmt.Fprintf(&buf, "TokensTotal: %d,\n", tokensTotal)
+	fmt.Fprintf(&buf, "}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := ioutil.WriteFile("samples.go", src, 0644); err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Fprintf(os.Stderr, "Trained on %d samples of %d languages\n", languagesTotal, len(languages))
+}
+
+func formatCounts(counts map[string]int) string {
+	var buf bytes.Buffer
+	buf.WriteString("map[string]int{\n")
+
+	keys := make([]string, 0, len(counts))
+	for key := range counts {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		fmt.Fprintf(&buf, "%q: %d,\n", key, counts[key])
+	}
+	buf.WriteString("}")
+
+	return buf.String()
+}
+
+func sortedKeys(m map[string]map[string]int) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,168 @@
+package linguist
+
+import (
+	"bytes"
+	"path"
+	"regexp"
+	"strings"
+)
+
+var (
+	// Files that are generated by their name or directory alone
+	generatedPathRegexp = unionRegexp([]string{
+		// Xcode, CocoaPods and Carthage
+		`\.(nib|xcworkspacedata|xcuserstate)$`,
+		`^Pods/`,
+		`(^|/)Carthage/Build/`,
+
+		// .NET designer and SpecFlow files
+		`\.designer\.cs$`,
+		`\.feature\.cs$`,
+
+		// Installed packages
+		`(^|/)node_modules/`,
+		`(^|/)Godeps/`,
+
+		// Lockfiles of package managers
+		`(^|/)(composer\.lock|package-lock\.json|npm-shrinkwrap\.json|yarn\.lock|pnpm-lock\.yaml)$`,
+		`(^|/)(Gemfile\.lock|Cargo\.lock|Gopkg\.lock|glide\.lock|Pipfile\.lock|poetry\.lock)$`,
+
+		// Protocol buffers for Go
+		`\.pb\.go$`,
+	})
+
+	goGenerated     = regexp.MustCompile(`(?m)^// Code generated .* DO NOT EDIT\.$`)
+	sourceMapURL    = regexp.MustCompile(`^/[*/][#@] source(Mapping)?URL=`)
+	sourceMapName   = regexp.MustCompile(`(?i)\.(css|js)\.map$`)
+	sourceMapHeader = regexp.MustCompile(`^(\{"version":\d+,|/\*\* Begin line maps\. \*\*/\{)`)
+)
+
+// Lines that tools start the files they generate with, by extension
+var generatedHeaders = []struct {
+	extensions []string
+	lines      int
+	marker     string
+}{
+	{[]string{".js"}, 1, "// Generated by CoffeeScript"},
+	{[]string{".js"}, 5, "Generated by PEG.js"},
+	{[]string{".js"}, 5, "generated by jison"},
+	{[]string{".py", ".java", ".h", ".cc", ".cpp", ".m", ".rb", ".php"}, 3, "Generated by the protocol buffer compiler.  DO NOT EDIT!"},
+	{nil, 6, "Autogenerated by Thrift Compiler"},
+	{[]string{".c", ".cpp"}, 1, "Generated by Cython"},
+	{[]string{".rb"}, 1, "This file is automatically generated by Racc"},
+	{[]string{".java"}, 1, "The following code was generated by JFlex"},
+	{[]string{".rd"}, 1, "Generated by roxygen2: do not edit by hand"},
+	{[]string{".meta"}, 1, "fileFormatVersion: "},
+	{[]string{".mod"}, 1, "PCBNEW-LibModule-V"},
+	{[]string{".mod"}, 1, "GFORTRAN module version '"},
+}
+
+// IsGenerated tells whether the file at path was generated by a tool rather
+// than written by hand, as github-linguist does: by its path, e.g. lockfiles
+// and `node_modules/`, or by its content, e.g. minified JavaScript, source
+// maps and the headers of code generators such as protoc
+func IsGenerated(filename string, blob []byte) bool {
+	if generatedPathRegexp.MatchString(filename) {
+		return true
+	}
+
+	ext := strings.ToLower(path.Ext(filename))
+
+	switch ext {
+	case ".js", ".css":
+		if isMinified(blob) || hasSourceMap(blob) {
+			return true
+		}
+	case ".map":
+		return isSourceMap(filename, blob)
+	case ".go":
+		if goGenerated.Match(firstLinesBytes(blob, 40)) {
+			return true
+		}
+	case ".h":
+		if isJNIHeader(blob) {
+			return true
+		}
+	case ".yml":
+		if isVCRCassette(blob) {
+			return true
+		}
+	}
+
+	for _, header := range generatedHeaders {
+		if header.extensions != nil && !hasString(header.extensions, ext) {
+			continue
+		}
+
+		for _, line := range firstLines(blob, header.lines) {
+			if strings.Contains(line, header.marker) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// isMinified guesses that JavaScript or CSS with an average line length over
+// 110 characters was minified
+func isMinified(blob []byte) bool {
+	if len(blob) == 0 {
+		return false
+	}
+
+	lines := bytes.Count(blob, []byte("\n")) + 1
+	return len(blob)/lines > 110
+}
+
+func hasSourceMap(blob []byte) bool {
+	for _, line := range lastLines(blob, 2) {
+		if sourceMapURL.MatchString(line) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isSourceMap(filename string, blob []byte) bool {
+	if sourceMapName.MatchString(filename) {
+		return true
+	}
+
+	lines := firstLines(blob, 1)
+	return len(lines) > 0 && sourceMapHeader.MatchString(lines[0])
+}
+
+func isJNIHeader(blob []byte) bool {
+	lines := firstLines(blob, 2)
+
+	return len(lines) == 2 &&
+		strings.Contains(lines[0], "/* DO NOT EDIT THIS FILE - it is machine generated */") &&
+		strings.Contains(lines[1], "#include <jni.h>")
+}
+
+func isVCRCassette(blob []byte) bool {
+	for _, line := range lastLines(blob, 2) {
+		if strings.Contains(line, "recorded_with: VCR") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// firstLinesBytes returns the first n lines of blob as a single slice
+func firstLinesBytes(blob []byte, n int) []byte {
+	return []byte(strings.Join(firstLines(blob, n), "\n"))
+}
+
+func hasString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+
+	return false
+}
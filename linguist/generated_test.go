@@ -0,0 +1,61 @@
+package linguist_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/gitlab-elasticsearch-indexer/linguist"
+)
+
+func TestIsGenerated(t *testing.T) {
+	for _, tc := range []struct {
+		path string
+		blob string
+	}{
+		// By path
+		{"package-lock.json", "{}"},
+		{"frontend/yarn.lock", "# yarn lockfile v1\n"},
+		{"composer.lock", "{}"},
+		{"Cargo.lock", "[[package]]\n"},
+		{"web/node_modules/left-pad/index.js", "module.exports = leftPad;\n"},
+		{"api/service.pb.go", "package api\n"},
+		{"Forms/Main.designer.cs", "partial class Main {}\n"},
+
+		// By content
+		{"public/app.js", strings.Repeat("var a=1;", 50)},
+		{"public/app.css", "body{color:red}\n/*# sourceMappingURL=app.css.map */\n"},
+		{"public/app.js", "var a = 1;\n//# sourceMappingURL=app.js.map\n"},
+		{"public/app.js.map", "{}"},
+		{"public/bundle.map", `{"version":3,"sources":[]}`},
+		{"app.js", "// Generated by CoffeeScript 1.12.7\n(function() {})();\n"},
+		{"parser.js", "/*\n * Generated by PEG.js 0.10.0.\n */\n"},
+		{"api/bindata.go", "// Code generated by go-bindata. DO NOT EDIT.\npackage api\n"},
+		{"api/bindata.go", "// Code generated by go-bindata. DO NOT EDIT.\r\npackage api\r\n"},
+		{"api/api.go", "// Copyright 2020 The Authors\n\n// Code generated by stringer. DO NOT EDIT.\n\npackage api\n"},
+		{"api/service.py", "# Generated by the protocol buffer compiler.  DO NOT EDIT!\n# source: service.proto\n"},
+		{"gen/Service.java", "/**\n * Autogenerated by Thrift Compiler (0.9.3)\n */\n"},
+		{"native/Foo.h", "/* DO NOT EDIT THIS FILE - it is machine generated */\n#include <jni.h>\n"},
+		{"spec/cassettes/get.yml", "---\nhttp_interactions: []\nrecorded_with: VCR 3.0.3\n"},
+		{"Assets/Player.cs.meta", "fileFormatVersion: 2\nguid: 0123\n"},
+	} {
+		require.True(t, linguist.IsGenerated(tc.path, []byte(tc.blob)), tc.path)
+	}
+
+	for _, tc := range []struct {
+		path string
+		blob string
+	}{
+		{"main.go", "package main\n\n// Code is generated elsewhere\nfunc main() {}\n"},
+		{"gen.go", "// Code generated by hand, then edited.\npackage gen\n"},
+		{"gen.go", "package gen\n\n// Code generated by go generate. DO NOT EDIT. Except here.\n"},
+		{"public/app.js", "var a = 1;\nvar b = 2;\n"},
+		{"public/app.js", ""},
+		{"README.md", "Generated by the protocol buffer compiler.  DO NOT EDIT!\n"},
+		{"Gemfile", "source 'https://rubygems.org'\n"},
+		{"maps/world.map", "not a source map\n"},
+	} {
+		require.False(t, linguist.IsGenerated(tc.path, []byte(tc.blob)), tc.path)
+	}
+}
@@ -0,0 +1,352 @@
+package linguist
+
+import (
+	"path"
+	"regexp"
+)
+
+// rule picks language if every pattern matches the blob, or always if there
+// are none
+type rule struct {
+	language string
+	patterns []*regexp.Regexp
+}
+
+// disambiguation holds the rules for languages that share extensions, which
+// are tried in order. They follow heuristics.yml of github-linguist, with the
+// lookarounds RE2 lacks rewritten.
+type disambiguation struct {
+	extensions []string
+	rules      []rule
+}
+
+// heuristicsLimit bounds how much of a blob the patterns look at
+const heuristicsLimit = 50 * 1024
+
+func pick(language string, patterns ...string) rule {
+	out := rule{language: language}
+	for _, pattern := range patterns {
+		out.patterns = append(out.patterns, regexp.MustCompile(`(?m)`+pattern))
+	}
+
+	return out
+}
+
+// Patterns used for several extensions
+const (
+	cppPattern        = `^\s*#\s*include <(cstdint|string|vector|map|list|array|bitset|queue|stack|forward_list|unordered_map|unordered_set|(i|o|io)stream)>|^\s*template\s*<|^[ \t]*(try|constexpr)\b|^[ \t]*catch\s*\(|^[ \t]*(class|(using[ \t]+)?namespace)\s+\w+|^[ \t]*(private|public|protected):$|std::\w+`
+	objectiveCPattern = `^\s*(@(interface|class|protocol|property|end|synchronised|selector|implementation)\b|#import\s+.+\.h[">])`
+	perl5Pattern      = `\buse\s+(?:strict\b|v?5\.)`
+	perl6Pattern      = `^\s*(?:use\s+v6\b|\bmodule\b|\b(?:my\s+)?class\b)`
+	commonLispPattern = `^\s*\((?i:defun|in-package|defpackage) `
+	glslPattern       = `^\s*(#version|precision|uniform|varying|vec[234])`
+	renderScript      = `#include|#pragma\s+(rs|version)|__attribute__`
+	xmlPattern        = `(?i:^\s*(<\?xml|xmlns))`
+)
+
+var disambiguations = []disambiguation{
+	{[]string{".asc"}, []rule{
+		pick("Public Key", `^(----[- ]BEGIN|ssh-(rsa|dss)) `),
+		pick("AsciiDoc", `^[=-]+(\s|$)|\{\{[A-Za-z]`),
+		pick("AGS Script", `^(//.+|((import|export)\s+)?(function|int|float|char)\s+((room|repeatedly|on|game)_)?([A-Za-z]+[A-Za-z_0-9]+)\s*[;(])`),
+	}},
+	{[]string{".b"}, []rule{
+		pick("Limbo", `^\s*implement\s+[a-zA-Z0-9]+\s*;`),
+		pick("Brainfuck", `^[-+<>.,\[\]\s]+$`),
+	}},
+	{[]string{".bb"}, []rule{
+		pick("BlitzBasic", `(^\s*; |End Function)`),
+		pick("BitBake", `^\s*(# |include|require)\b`),
+	}},
+	{[]string{".bf"}, []rule{
+		pick("HyPhy", `(?i:^\s*(function|lfunction|LikelihoodFunction|DataSet|DataSetFilter|Model|Tree)\b)`),
+		pick("Brainfuck", `^[-+<>.,\[\]\s]+$`),
+	}},
+	{[]string{".brd", ".sch"}, []rule{
+		pick("Eagle", `<!DOCTYPE eagle`, `<eagle `),
+		pick("KiCad", `^(EESchema|PCBNEW|\(kicad_pcb)`),
+	}},
+	{[]string{".ch"}, []rule{
+		pick("xBase", `^\s*#\s*(?i:if|ifdef|ifndef|define|command|xcommand|translate|xtranslate|include|pragma|undef)\b`),
+	}},
+	{[]string{".cl"}, []rule{
+		pick("Common Lisp", commonLispPattern),
+		pick("Cool", `^class`),
+		pick("OpenCL", `/\* |// |^\}`),
+	}},
+	{[]string{".cls"}, []rule{
+		pick("Visual Basic", `^\s*VERSION [0-9]\.[0-9] CLASS`),
+		pick("TeX", `^\s*\\(?:NeedsTeXFormat|ProvidesClass)\{`),
+		pick("OpenEdge ABL", `(?i:^\s*(USING|CLASS)\s+[\w.]+(\s+INHERITS\s+[\w.]+)?\s*:)`),
+		pick("Apex", `(?i:\b(public|private|global)\s+(with|without)\s+sharing\b|\bSystem\.debug\(|@isTest\b)`),
+	}},
+	{[]string{".cp"}, []rule{
+		pick("Component Pascal", `^\s*MODULE\s+\w+\s*;|\bEND\s+\w+\s*\.`),
+		pick("C++", `^\s*#\s*(include|define|ifn?def)\b|`+cppPattern),
+	}},
+	{[]string{".cs"}, []rule{
+		pick("Smalltalk", `![\w\s]+methodsFor: `),
+		pick("C#", `^(\s*namespace\s*[\w.]+\s*\{|\s*//|\s*using\s+[\w.]+\s*;)`),
+	}},
+	{[]string{".d"}, []rule{
+		pick("D", `^module\s+[\w.]*\s*;|import\s+[\w\s,.:]*;|\w+\s+\w+\s*\(.*\)(?:\(.*\))?\s*\{[^}]*\}|unittest\s*(?:\(.*\))?\s*\{[^}]*\}`),
+		pick("DTrace", `^(\w+:\w*:\w*:\w*|BEGIN|END|provider\s+|(tick|profile)-\w+\s+\{[^}]*\}|#pragma\s+D\s+(option|attributes|depends_on)\s|#pragma\s+ident)`),
+		pick("Makefile", `([/\\].*:\s+.*\s\\$|: \\$|^[ %]:|^[\w\s/\\.]+\w+\.\w+\s*:\s+[\w\s/\\.]+\w+\.\w+)`),
+	}},
+	{[]string{".ecl"}, []rule{
+		pick("ECLiPSe", `^[^#]+:-`),
+		pick("ECL", `:=`),
+	}},
+	{[]string{".f", ".for"}, []rule{
+		pick("Forth", `^: `),
+		pick("FORTRAN", `^(?i:[c*][^abd-z]|      (subroutine|program|end|data)\s|\s*!)`),
+	}},
+	{[]string{".fr"}, []rule{
+		pick("Forth", `^(: |also |new-device|previous )`),
+		pick("Frege", `^\s*(import|module|package|data|type) `),
+		pick("Text"),
+	}},
+	{[]string{".frag"}, []rule{
+		pick("GLSL", glslPattern),
+		pick("JavaScript", `\b(function|var|let|const)\b|=>`),
+	}},
+	{[]string{".fs"}, []rule{
+		pick("Forth", `^(: |new-device)`),
+		pick("F#", `^\s*(#light|import|let|module|namespace|open|type)`),
+		pick("GLSL", glslPattern),
+		pick("Filterscript", renderScript),
+	}},
+	{[]string{".g"}, []rule{
+		pick("G-code", `^[MG][0-9]+\s`),
+		pick("GAP", `\s*(Declare|BindGlobal|KeyDependentOperation|InstallMethod|function\()`),
+	}},
+	{[]string{".gd"}, []rule{
+		pick("GAP", `\s*(Declare|BindGlobal|KeyDependentOperation)`),
+		pick("GDScript", `\s*(extends|var|const|enum|func|class|signal|tool|yield|assert|onready)`),
+	}},
+	{[]string{".gml"}, []rule{
+		pick("XML", xmlPattern),
+		pick("Graph Modeling Language", `(?i:^\s*(graph|node)\s+\[$)`),
+		pick("Game Maker Language"),
+	}},
+	{[]string{".gs"}, []rule{
+		pick("Gosu", `^uses (java|gw)\.`),
+		pick("JavaScript"),
+	}},
+	{[]string{".h"}, []rule{
+		pick("Objective-C", objectiveCPattern),
+		pick("C++", cppPattern),
+	}},
+	{[]string{".hh"}, []rule{
+		pick("Hack", `<\?hh`),
+		pick("C++"),
+	}},
+	{[]string{".inc"}, []rule{
+		pick("PHP", `^<\?(?:php)?`),
+		pick("POV-Ray SDL", `^\s*#(declare|local|macro|while)\s`),
+		pick("SourcePawn", `^\s*#\s*(pragma\s+(semicolon|newdecls)|include\s+<sourcemod>)|\bpublic\s+(Plugin|Action)\b`),
+		pick("HTML", `(?i:<!doctype html|<html|<(div|span|table|p)[\s>])`),
+	}},
+	{[]string{".j"}, []rule{
+		pick("Objective-J", `@import|@implementation`),
+		pick("Jasmin", `^\s*\.(class|method|super)\b`),
+	}},
+	{[]string{".l"}, []rule{
+		pick("Common Lisp", `\(def(un|macro)\s`),
+		pick("Lex", `^(%[%{}]xs|<.*>)`),
+		pick("Groff", `^\.[A-Za-z]{2}(\s|$)`),
+		pick("PicoLisp", `^\((de|class|rel|code|data|must)\s`),
+	}},
+	{[]string{".lisp", ".lsp"}, []rule{
+		pick("Common Lisp", commonLispPattern),
+		pick("NewLisp", `^\s*\(define `),
+	}},
+	{[]string{".ls"}, []rule{
+		pick("LoomScript", `^\s*package\s*[\w./*\s]*\s*\{`),
+		pick("LiveScript"),
+	}},
+	{[]string{".m"}, []rule{
+		pick("Objective-C", objectiveCPattern),
+		pick("Mercury", `:- module`),
+		pick("MUF", `^: `),
+		pick("M", `^\s*;`),
+		pick("Mathematica", `\(\*`, `\*\)$`),
+		pick("Matlab", `^\s*%`),
+		pick("Limbo", `^\w+\s*:\s*module\s*\{`),
+	}},
+	{[]string{".mm"}, []rule{
+		pick("XML", `<\?xml version=`),
+		pick("Objective-C++"),
+	}},
+	{[]string{".mod"}, []rule{
+		pick("XML", `<!ENTITY `),
+		pick("Modula-2", `^\s*(?i:MODULE|END) [\w.]+;`),
+		pick("Linux Kernel Module", `^\s*(kernel|extra|updates)?/?[\w/-]+\.ko\s*$`),
+		pick("AMPL"),
+	}},
+	{[]string{".moo"}, []rule{
+		pick("Mercury", `:- module`),
+		pick("Moocode"),
+	}},
+	{[]string{".ms"}, []rule{
+		pick("Groff", `^[.'][A-Za-z]{2}(\s|$)`),
+		pick("GAS", `(^|\s)\.(include|globa?l)\s|^\s*\.[A-Za-z][_A-Za-z0-9]*:`),
+		pick("MAXScript"),
+	}},
+	{[]string{".n"}, []rule{
+		pick("Groff", `^[.']`),
+		pick("Nemerle", `^(module|namespace|using)\s`),
+	}},
+	{[]string{".ncl"}, []rule{
+		pick("Text", `THE_TITLE`),
+		pick("NCL"),
+	}},
+	{[]string{".nl"}, []rule{
+		pick("NL", `^(b|g)[0-9]+ `),
+		pick("NewLisp"),
+	}},
+	{[]string{".php"}, []rule{
+		pick("Hack", `<\?hh`),
+		pick("PHP", `<\?[^h]`),
+	}},
+	{[]string{".pl"}, []rule{
+		pick("Prolog", `^[^#]*:-`),
+		pick("Perl", perl5Pattern),
+		pick("Perl6", perl6Pattern),
+	}},
+	{[]string{".pm"}, []rule{
+		pick("Perl", perl5Pattern),
+		pick("Perl6", perl6Pattern),
+	}},
+	{[]string{".pluginspec"}, []rule{
+		pick("XML", xmlPattern),
+		pick("Ruby"),
+	}},
+	{[]string{".pod"}, []rule{
+		pick("Pod", `^=\w+\b`),
+		pick("Perl"),
+	}},
+	{[]string{".pp"}, []rule{
+		pick("Pascal", `^\s*end[.;]`),
+		pick("Puppet", `^\s+\w+\s+=>\s`),
+	}},
+	{[]string{".pro"}, []rule{
+		pick("Prolog", `^[^\[#]+:-`),
+		pick("INI", `last_client=`),
+		pick("QMake", `HEADERS`, `SOURCES`),
+		pick("IDL", `^\s*(?i:function|pro|compile_opt) \w[ \w,:]*$`),
+	}},
+	{[]string{".r"}, []rule{
+		pick("Rebol", `(?i:\bRebol\b)`),
+		pick("R", `<-|^\s*#`),
+	}},
+	{[]string{".rs"}, []rule{
+		pick("Rust", `^(use |fn |mod |pub |macro_rules|impl|#!?\[)`),
+		pick("RenderScript", renderScript),
+	}},
+	{[]string{".sc"}, []rule{
+		pick("SuperCollider", `(?i:\^(this|super)\.)|(?i:^\s*(\+|\*)\s*\w+\s*\{)|(?i:^\s*~\w+\s*=\.)`),
+		pick("Scala", `^\s*import (scala|java)\.|(?i:\bdef\s+\w+\s*[(\[])|(?i:\b(object|class|trait)\s+\w+\s+(extends|\{))`),
+	}},
+	{[]string{".sls"}, []rule{
+		pick("Scheme", `^\s*\((define|library|import)\b`),
+		pick("SaltStack"),
+	}},
+	{[]string{".sql"}, []rule{
+		pick("PLpgSQL", `(?i:^\\i\b|AS\s+\$\$|LANGUAGE\s+'?plpgsql'?|BEGIN(\s+WORK)?\s*;)`),
+		pick("SQLPL", `(?i:(alter module)|(language sql)|(begin( NOT)+ atomic)|signal SQLSTATE '[0-9]+')`),
+		pick("PLSQL", `(?i:\$\$PLSQL_|XMLTYPE|systimestamp|\.nextval|CONNECT\s+BY|AUTHID\s+(DEFINER|CURRENT_USER)|constructor\W+function)`),
+		pick("SQL"),
+	}},
+	{[]string{".st"}, []rule{
+		pick("HTML", `(?i:<!doctype html|<html)`),
+		pick("Smalltalk"),
+	}},
+	{[]string{".t"}, []rule{
+		pick("Perl", perl5Pattern),
+		pick("Perl6", `^\s*(?:use\s+v6\b|\bmodule\b|\bmy\s+class\b)`),
+		pick("Turing", `^\s*%[ \t]+|^\s*var\s+\w+(\s*:\s*\w+)?\s*:=\s*\w+`),
+	}},
+	{[]string{".ts"}, []rule{
+		pick("XML", `<TS\b`),
+		pick("TypeScript"),
+	}},
+	{[]string{".tsx"}, []rule{
+		pick("XML", `(?i:^\s*<\?xml\s+version)`),
+		pick("TypeScript"),
+	}},
+	{[]string{".tst"}, []rule{
+		pick("GAP", `gap> `),
+		pick("Scilab"),
+	}},
+	{[]string{".v"}, []rule{
+		pick("Coq", `(?:^|\s)(?:Proof|Qed)\.(?:$|\s)|(?:^|\s)Require[ \t]+(Import|Export)\s`),
+		pick("Verilog", "^[ \\t]*module\\s+[^\\s()]+\\s+#?\\(|^[ \\t]*`(?:define|ifdef|ifndef|include|timescale)|^[ \\t]*always[ \\t]+@|^[ \\t]*initial[ \\t]+(begin|@)"),
+	}},
+	{[]string{".vhost"}, []rule{
+		pick("ApacheConf", `(?i:<VirtualHost\b)`),
+		pick("Nginx", `\bserver\s*\{`),
+	}},
+}
+
+var disambiguationsByExtension map[string][]rule
+
+func init() {
+	disambiguationsByExtension = make(map[string][]rule)
+	for _, d := range disambiguations {
+		for _, rule := range d.rules {
+			if Languages[rule.language] == nil {
+				panic("linguist: unknown language in heuristics: " + rule.language)
+			}
+		}
+
+		for _, ext := range d.extensions {
+			disambiguationsByExtension[ext] = d.rules
+		}
+	}
+}
+
+// DetectLanguageByHeuristics picks one of candidates, the languages a file
+// may be written in, by looking at its content. It returns the language of
+// the first rule for the extension that matches, or nothing if none does.
+func DetectLanguageByHeuristics(filename string, blob []byte, candidates []*Language) []*Language {
+	rules := disambiguationsByExtension[path.Ext(filename)]
+	if len(rules) == 0 || len(candidates) < 2 {
+		return nil
+	}
+
+	if len(blob) > heuristicsLimit {
+		blob = blob[:heuristicsLimit]
+	}
+
+	for _, rule := range rules {
+		lang := findLanguage(candidates, rule.language)
+		if lang == nil || !rule.matches(blob) {
+			continue
+		}
+
+		return []*Language{lang}
+	}
+
+	return nil
+}
+
+func (ru rule) matches(blob []byte) bool {
+	for _, pattern := range ru.patterns {
+		if !pattern.Match(blob) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func findLanguage(langs []*Language, name string) *Language {
+	for _, lang := range langs {
+		if lang.Name == name {
+			return lang
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package linguist_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/gitlab-elasticsearch-indexer/linguist"
+)
+
+// Samples in testdata/heuristics are in a directory named after their
+// language
+func TestHeuristicsDisambiguateSamples(t *testing.T) {
+	root := filepath.Join("testdata", "heuristics")
+	count := 0
+
+	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+
+		blob, err := ioutil.ReadFile(path)
+		require.NoError(t, err)
+
+		want := filepath.Base(filepath.Dir(path))
+		lang := linguist.DetectLanguage(info.Name(), blob)
+		require.NotNil(t, lang, path)
+		require.Equal(t, want, lang.Name, path)
+
+		count++
+		return nil
+	})
+
+	require.NoError(t, err)
+	require.NotZero(t, count)
+}
+
+func TestHeuristicsWithoutMatch(t *testing.T) {
+	blob := []byte("nothing to see here\n")
+
+	require.Nil(t, linguist.DetectLanguageByHeuristics("foo.h", blob, linguist.DetectLanguageByExtension("foo.h")))
+	require.Equal(t, "C", linguist.DetectLanguage("foo.h", blob).Name)
+	require.Nil(t, linguist.DetectLanguageByHeuristics("foo.go", blob, linguist.DetectLanguageByExtension("foo.go")))
+}
+
+func TestAmbiguousDetectionIsDeterministic(t *testing.T) {
+	langs := linguist.DetectLanguageByExtension("foo.m")
+	require.True(t, len(langs) > 1)
+
+	var names []string
+	for _, lang := range langs {
+		names = append(names, lang.Name)
+	}
+	require.True(t, sort.StringsAreSorted(names))
+
+	blob := []byte("nothing to see here\n")
+	first := linguist.DetectLanguage("foo.m", blob)
+	for i := 0; i < 10; i++ {
+		require.Equal(t, first, linguist.DetectLanguage("foo.m", blob))
+	}
+}
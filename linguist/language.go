@@ -2,6 +2,8 @@ package linguist
 
 import (
 	"path"
+	"sort"
+	"strings"
 )
 
 // There's no YAML support in the Go stdlib, so use ruby instead.
@@ -24,8 +26,10 @@ type Language struct {
 }
 
 var (
-	languagesByExtension map[string][]*Language
-	languagesByFilename  map[string][]*Language
+	languagesByExtension   map[string][]*Language
+	languagesByFilename    map[string][]*Language
+	languagesByInterpreter map[string][]*Language
+	languagesByAlias       map[string][]*Language
 )
 
 func init() {
@@ -42,6 +46,43 @@ func init() {
 			languagesByFilename[filename] = append(languagesByFilename[filename], lang)
 		}
 	}
+
+	languagesByInterpreter = make(map[string][]*Language)
+	for _, lang := range Languages {
+		for _, interpreter := range lang.Interpreters {
+			languagesByInterpreter[interpreter] = append(languagesByInterpreter[interpreter], lang)
+		}
+	}
+
+	// Like github-linguist, every language can be found by its lowercased
+	// name with dashes for spaces, e.g. "emacs-lisp"
+	languagesByAlias = make(map[string][]*Language)
+	for _, lang := range Languages {
+		aliases := append([]string{strings.Replace(strings.ToLower(lang.Name), " ", "-", -1)}, lang.Aliases...)
+		for _, alias := range aliases {
+			alias = strings.ToLower(alias)
+			languagesByAlias[alias] = appendLanguage(languagesByAlias[alias], lang)
+		}
+	}
+
+	// Languages is a map, so sort by name to detect the same language for
+	// the same file every time
+	for _, index := range []map[string][]*Language{languagesByExtension, languagesByFilename, languagesByInterpreter, languagesByAlias} {
+		for _, langs := range index {
+			sort.Slice(langs, func(i, j int) bool { return langs[i].Name < langs[j].Name })
+		}
+	}
+}
+
+// appendLanguage appends lang to langs unless it is there already
+func appendLanguage(langs []*Language, lang *Language) []*Language {
+	for _, l := range langs {
+		if l == lang {
+			return langs
+		}
+	}
+
+	return append(langs, lang)
 }
 
 // and returns only the languges present in both A and B
@@ -59,6 +100,21 @@ func and(a, b []*Language) []*Language {
 	return out
 }
 
+// FindLanguage returns the language with the given name or alias, ignoring
+// case, e.g. "C++", "cpp" or "emacs lisp", or nil if there is none
+func FindLanguage(name string) *Language {
+	if lang, ok := Languages[name]; ok {
+		return lang
+	}
+
+	langs := languagesByAlias[strings.Replace(strings.ToLower(name), " ", "-", -1)]
+	if len(langs) == 0 {
+		return nil
+	}
+
+	return langs[0]
+}
+
 func DetectLanguageByFilename(filename string) []*Language {
 	return languagesByFilename[path.Base(filename)]
 }
@@ -67,29 +123,46 @@ func DetectLanguageByExtension(filename string) []*Language {
 	return languagesByExtension[path.Ext(filename)]
 }
 
+// strategy returns the languages a file may be written in, or none if it
+// can't tell. candidates are those found by the strategies before.
+type strategy func(filename string, blob []byte, candidates []*Language) []*Language
+
+// strategies are tried in the order of github-linguist
+var strategies = []strategy{
+	func(_ string, blob []byte, _ []*Language) []*Language { return DetectLanguageByModeline(blob) },
+	func(_ string, blob []byte, _ []*Language) []*Language { return DetectLanguageByShebang(blob) },
+	func(filename string, _ []byte, _ []*Language) []*Language { return DetectLanguageByFilename(filename) },
+	func(filename string, _ []byte, _ []*Language) []*Language { return DetectLanguageByExtension(filename) },
+	DetectLanguageByHeuristics,
+}
+
+// DetectLanguage tries each strategy in turn, as github-linguist does. The
+// first one to find a single language wins. When one finds several, the next
+// ones choose among them, and if several are left, the classifier does.
 func DetectLanguage(filename string, blob []byte) *Language {
-	// TODO: github-linguist uses a range of strategies not replicated here.
-	// It does the following:
-	//
-	//   * modelines
-	//   * shebangs
-	//   * filename / extension (we have these)
-	//   * heuristics
-	//   * classifier
-
-	byFilename := DetectLanguageByFilename(filename)
-	if len(byFilename) == 1 {
-		return byFilename[0]
+	var candidates []*Language
+	for _, strategy := range strategies {
+		found := strategy(filename, blob, candidates)
+		if len(candidates) > 0 {
+			found = and(candidates, found)
+		}
+
+		switch {
+		case len(found) == 1:
+			return found[0]
+		case len(found) > 1:
+			candidates = found
+		}
 	}
 
-	byExtension := DetectLanguageByExtension(filename)
-	if len(byFilename) > 1 {
-		byExtension = and(byFilename, byExtension)
+	if len(candidates) == 0 {
+		return nil
 	}
 
-	if len(byExtension) > 0 {
-		return byExtension[0]
+	// The classifier only knows languages it has samples of
+	if lang, _ := Classify(blob, candidates); lang != nil {
+		return lang
 	}
 
-	return nil
+	return candidates[0]
 }
@@ -41,6 +41,50 @@ func TestCommonLanguagesAreDetectedByExtension(t *testing.T) {
 	}
 }
 
+func TestDetectLanguagePrecedence(t *testing.T) {
+	for _, tc := range []struct {
+		file string
+		blob string
+		lang string
+	}{
+		// Extensionless scripts
+		{"bin/deploy", "#!/usr/bin/env bash\nset -e\n", "Shell"},
+		{"bin/console", "#!/usr/bin/env ruby\n", "Ruby"},
+
+		// Modelines win over shebangs, which win over the extension
+		{"bin/deploy", "#!/bin/sh\n# vim: ft=python\n", "Python"},
+		{"script.txt", "#!/usr/bin/env python\n", "Python"},
+		{"foo.rb", "# -*- mode: python -*-\n", "Python"},
+
+		// Unknown modes and interpreters fall back to the extension
+		{"foo.rb", "#!/usr/bin/env nobody-will-make-this-interpreter\n", "Ruby"},
+		{"foo.rb", "# vim: ft=nobodywillmakethislanguage\n", "Ruby"},
+	} {
+		lang := linguist.DetectLanguage(tc.file, []byte(tc.blob))
+		require.NotNil(t, lang, tc.file)
+		require.Equal(t, tc.lang, lang.Name, tc.file)
+	}
+
+	require.Nil(t, linguist.DetectLanguage("bin/deploy", []byte("echo hi\n")))
+}
+
+func TestFindLanguage(t *testing.T) {
+	for name, lang := range map[string]string{
+		"Ruby":       "Ruby",
+		"ruby":       "Ruby",
+		"cpp":        "C++",
+		"Emacs Lisp": "Emacs Lisp",
+		"emacs lisp": "Emacs Lisp",
+		"elisp":      "Emacs Lisp",
+	} {
+		found := linguist.FindLanguage(name)
+		require.NotNil(t, found, name)
+		require.Equal(t, lang, found.Name, name)
+	}
+
+	require.Nil(t, linguist.FindLanguage("nobody-will-make-this-language"))
+}
+
 func TestImaginaryLanguageIsntRecognised(t *testing.T) {
 	lang := linguist.DetectLanguageByFilename("foo.absolutely-nobody-will-make-this-extension")
 	require.Nil(t, lang)
@@ -0,0 +1,201 @@
+package linguist
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// Modelines are looked for in the first and last lines of a file, as Vim and
+// Emacs do
+const modelineSearchScope = 5
+
+var (
+	// `vim:`, `vi:`, `vim600:`, `Vim:` or ` ex:`, which needs whitespace
+	// before it as "ex:" might be short for "example:"
+	vimModelineStart = regexp.MustCompile(`(?i)(?:(?:^|[ \t])vi(?:m[<=>]?\d+|m)?|[ \t]ex):`)
+
+	// The form compatible with Vi, whose options end with a colon:
+	// `vim: set ft=ruby:`
+	vimSetForm   = regexp.MustCompile(`^[ \t]*set?[ \t]([^:]+):`)
+	vimSetPrefix = regexp.MustCompile(`^[ \t]*set?[ \t]`)
+
+	// Whitespace is allowed before "=", as in `vim: ft =ruby`
+	vimAssignment = regexp.MustCompile(`[ \t]+=`)
+
+	validMode = regexp.MustCompile(`^[\w+-]+$`)
+)
+
+// Modeline returns the mode set by a Vim or Emacs modeline in the first or
+// last lines of blob, e.g. "ruby" for `# vim: set ft=ruby:` or
+// `# -*- mode: ruby -*-`, or "" if there is none
+func Modeline(blob []byte) string {
+	lines := firstLines(blob, modelineSearchScope)
+	lines = append(lines, lastLines(blob, modelineSearchScope)...)
+
+	for _, line := range lines {
+		if mode := emacsModeline(line); mode != "" {
+			return mode
+		}
+
+		if mode := vimModeline(line); mode != "" {
+			return mode
+		}
+	}
+
+	return ""
+}
+
+// DetectLanguageByModeline returns the language whose name or alias is the
+// mode set by a modeline
+func DetectLanguageByModeline(blob []byte) []*Language {
+	mode := Modeline(blob)
+	if mode == "" {
+		return nil
+	}
+
+	return languagesByAlias[strings.ToLower(mode)]
+}
+
+// emacsModeline parses `-*- ruby -*-` and `-*- foo: bar; mode: ruby -*-`
+func emacsModeline(line string) string {
+	start := strings.Index(line, "-*-")
+	if start < 0 {
+		return ""
+	}
+
+	vars := line[start+3:]
+	end := strings.Index(vars, "-*-")
+	if end < 0 {
+		return ""
+	}
+	vars = vars[:end]
+
+	if !strings.Contains(vars, ":") {
+		return checkMode(strings.TrimSpace(vars))
+	}
+
+	for _, variable := range strings.Split(vars, ";") {
+		parts := strings.SplitN(variable, ":", 2)
+		if len(parts) == 2 && strings.EqualFold(strings.TrimSpace(parts[0]), "mode") {
+			return checkMode(strings.TrimSpace(parts[1]))
+		}
+	}
+
+	return ""
+}
+
+// vimModeline parses `vim: ft=ruby`, `vim: set filetype=ruby:` and the like.
+// The last filetype or syntax option wins, as it does in Vim.
+func vimModeline(line string) string {
+	loc := vimModelineStart.FindStringIndex(line)
+	if loc == nil {
+		return ""
+	}
+
+	rest := line[loc[1]:]
+
+	var options []string
+	if match := vimSetForm.FindStringSubmatch(rest); match != nil {
+		options = vimOptions(match[1], false)
+	} else if vimSetPrefix.MatchString(rest) {
+		// `set` without a closing colon isn't a modeline
+		return ""
+	} else {
+		options = vimOptions(rest, true)
+	}
+
+	mode := ""
+	for _, option := range options {
+		parts := strings.SplitN(option, "=", 2)
+		if len(parts) != 2 {
+			continue
+		}
+
+		switch strings.ToLower(parts[0]) {
+		case "ft", "filetype", "syntax":
+			if isWord(parts[1]) {
+				mode = parts[1]
+			}
+		}
+	}
+
+	return mode
+}
+
+// vimOptions splits options on whitespace, and on colons unless they are in
+// the `set` form. Backslashes escape separators: `titlestring=\ ft=ruby` is a
+// single option.
+func vimOptions(s string, colons bool) []string {
+	s = vimAssignment.ReplaceAllString(s, "=")
+
+	var options []string
+	var option strings.Builder
+	escaped := false
+
+	for _, r := range s {
+		switch {
+		case escaped:
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == ' ' || r == '\t' || (colons && r == ':'):
+			if option.Len() > 0 {
+				options = append(options, option.String())
+				option.Reset()
+			}
+			continue
+		}
+
+		option.WriteRune(r)
+	}
+
+	if option.Len() > 0 {
+		options = append(options, option.String())
+	}
+
+	return options
+}
+
+func checkMode(mode string) string {
+	if !validMode.MatchString(mode) {
+		return ""
+	}
+
+	return mode
+}
+
+func isWord(s string) bool {
+	if s == "" {
+		return false
+	}
+
+	for _, r := range s {
+		if r != '_' && !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// lastLines returns up to n lines from the end of blob, without line endings,
+// ignoring a trailing newline
+func lastLines(blob []byte, n int) []string {
+	blob = bytes.TrimSuffix(blob, []byte("\n"))
+
+	var lines []string
+	for len(lines) < n && len(blob) > 0 {
+		line := blob
+		if start := bytes.LastIndexByte(blob, '\n'); start >= 0 {
+			line, blob = blob[start+1:], blob[:start]
+		} else {
+			blob = nil
+		}
+
+		lines = append([]string{strings.TrimSuffix(string(line), "\r")}, lines...)
+	}
+
+	return lines
+}
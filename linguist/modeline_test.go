@@ -0,0 +1,73 @@
+package linguist_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/gitlab-elasticsearch-indexer/linguist"
+)
+
+func TestModeline(t *testing.T) {
+	for _, tc := range []struct {
+		line string
+		mode string
+	}{
+		// Emacs
+		{"# -*- ruby -*-", "ruby"},
+		{"# -*- mode: ruby -*-", "ruby"},
+		{"# -*-mode:ruby-*-", "ruby"},
+		{"/* -*- coding: utf-8; mode: c++; tab-width: 4 -*- */", "c++"},
+		{"; -*- Mode : Emacs-Lisp ; -*-", "Emacs-Lisp"},
+		{"# -*- coding: utf-8 -*-", ""},
+		{"# -*- ruby", ""},
+
+		// Vim
+		{"# vim: ft=ruby", "ruby"},
+		{"# vim: set ft=ruby:", "ruby"},
+		{"# vim: se filetype=python :", "python"},
+		{"# vim: noai:ts=4:sw=4:syntax=python", "python"},
+		{"# vim: ft   =ruby", "ruby"},
+		{"# Vim: set syntax=perl ft=python noexpandtab:", "python"},
+		{"# vim600: ft=ruby", "ruby"},
+		{"# vi: ft=ruby", "ruby"},
+		{"# ex: ft=ruby", "ruby"},
+		{"# vim: set ft=ruby", ""},
+		{"# vim: titlestring=\\ ft=ruby", ""},
+		{"# example: ft=ruby", ""},
+		{"# navim: ft=ruby", ""},
+		{"# vim: ft=", ""},
+	} {
+		require.Equal(t, tc.mode, linguist.Modeline([]byte("\n"+tc.line+"\n")), tc.line)
+	}
+}
+
+func TestModelineSearchScope(t *testing.T) {
+	filler := strings.Repeat("x = 1\n", 5)
+
+	require.Equal(t, "ruby", linguist.Modeline([]byte("# vim: ft=ruby\n"+filler+filler)))
+	require.Equal(t, "ruby", linguist.Modeline([]byte(filler+filler+"# vim: ft=ruby\n")))
+	require.Equal(t, "ruby", linguist.Modeline([]byte(filler+filler+"# vim: ft=ruby")))
+	require.Equal(t, "", linguist.Modeline([]byte(filler+"# vim: ft=ruby\n"+filler)))
+}
+
+func TestDetectLanguageByModeline(t *testing.T) {
+	for _, tc := range []struct {
+		line string
+		lang string
+	}{
+		{"# vim: ft=ruby", "Ruby"},
+		{"# -*- mode: Python -*-", "Python"},
+		{"/* -*- c++ -*- */", "C++"},
+		{"// vim: ft=cpp", "C++"},
+		{"; -*- mode: emacs-lisp -*-", "Emacs Lisp"},
+		{"# vim: ft=sh", "Shell"},
+	} {
+		langs := linguist.DetectLanguageByModeline([]byte(tc.line + "\n"))
+		require.Len(t, langs, 1, tc.line)
+		require.Equal(t, tc.lang, langs[0].Name, tc.line)
+	}
+
+	require.Empty(t, linguist.DetectLanguageByModeline([]byte("# vim: ft=nobodywillmakethislanguage\n")))
+}
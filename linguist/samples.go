@@ -0,0 +1,8870 @@
+// Code generated by generate_samples.go. DO NOT EDIT.
+
+package linguist
+
+var classifierSamples = &samples{
+	Languages: map[string]int{
+		"C":           14,
+		"C#":          3,
+		"C++":         6,
+		"CSV":         4,
+		"Go":          18,
+		"Haskell":     3,
+		"INI":         13,
+		"Java":        3,
+		"JavaScript":  14,
+		"Lua":         14,
+		"Matlab":      3,
+		"Objective-C": 4,
+		"PHP":         3,
+		"Perl":        12,
+		"Python":      14,
+		"Ruby":        14,
+		"Rust":        12,
+		"Shell":       11,
+		"Text":        5,
+	},
+	LanguagesTotal: 170,
+	Tokens: map[string]map[string]int{
+		"C": map[string]int{
+			"#define":                            7,
+			"#else":                              2,
+			"#endif":                             7,
+			"#error":                             1,
+			"#ifdef":                             5,
+			"#ifndef":                            2,
+			"#include":                           44,
+			"#pragma":                            3,
+			"&":                                  37,
+			"&&":                                 6,
+			"(":                                  179,
+			")":                                  180,
+			"*":                                  5,
+			"**arg":                              2,
+			"**argv":                             2,
+			"**env":                              1,
+			"**tlsbase":                          1,
+			"**tlsg":                             1,
+			"*addr":                              2,
+			"*buf":                               4,
+			"*data":                              2,
+			"*get_ver":                           1,
+			"*goact":                             2,
+			"*handle":                            1,
+			"*head":                              1,
+			"*n":                                 1,
+			"*next":                              1,
+			"*off":                               1,
+			"*oldgoact":                          2,
+			"*p":                                 1,
+			"*push":                              1,
+			"*tlsg":                              1,
+			"+":                                  2,
+			"-":                                  9,
+			"...":                                1,
+			"//":                                 8,
+			"//go":                               9,
+			";":                                  191,
+			"<":                                  2,
+			"<android/log.h>":                    1,
+			"<dlfcn.h>":                          1,
+			"<errno.h>":                          3,
+			"<pthread.h>":                        2,
+			"<signal.h>":                         3,
+			"<stdarg.h>":                         1,
+			"<stddef.h>":                         3,
+			"<stdint.h>":                         4,
+			"<stdio.h>":                          3,
+			"<stdlib.h>":                         6,
+			"<string.h>":                         4,
+			"<sys/mman.h>":                       1,
+			"<windows.h>":                        1,
+			"ANDROID_LOG_FATAL":                  1,
+			"BUFFER_EMPTY":                       1,
+			"BUFFER_H":                           2,
+			"CRITICAL_SECTION":                   1,
+			"Context":                            1,
+			"CreateEvent":                        1,
+			"DWORD":                              1,
+			"EnterCriticalSection":               5,
+			"FALSE":                              1,
+			"GCC":                                3,
+			"HANDLE":                             1,
+			"IMAGE_GUARD_SECURITY_COOKIE_UNUSED": 3,
+			"INFINITE":                           1,
+			"InitializeCriticalSection":          1,
+			"InterlockedDecrement":               1,
+			"InterlockedExchangeAdd":             2,
+			"InterlockedIncrement":               2,
+			"LONG":                               2,
+			"LeaveCriticalSection":               5,
+			"MAP_FAILED":                         1,
+			"NULL":                               6,
+			"PTHREAD_COND_INITIALIZER":           1,
+			"PTHREAD_MUTEX_INITIALIZER":          1,
+			"RTLD_LAZY":                          1,
+			"SA_RESTORER":                        2,
+			"SA_SIGINFO":                         2,
+			"SS_DISABLE":                         1,
+			"Sleep":                              1,
+			"Symbolizer":                         1,
+			"TLS_SLOT_APP":                       1,
+			"TLS_SLOT_APP*sizeof":                1,
+			"TRUE":                               1,
+			"Traceback":                          1,
+			"ULONGLONG":                          1,
+			"WIN32_LEAN_AND_MEAN":                1,
+			"WaitForSingleObject":                1,
+			"[":                                  6,
+			"]":                                  6,
+			"_SIG_WORDS":                         1,
+			"_WIN64":                             1,
+			"__ANDROID__":                        2,
+			"__ATOMIC_CONSUME":                   7,
+			"__ATOMIC_RELEASE":                   1,
+			"__CYGWIN__":                         1,
+			"__android_log_vprint":               1,
+			"__atomic_load_n":                    7,
+			"__atomic_store_n":                   1,
+			"__attribute__":                      5,
+			"__bits":                             1,
+			"__cgo_topofstack":                   2,
+			"__loongarch__":                      1,
+			"_cgo_get_context_function":          2,
+			"_cgo_get_symbolizer_function":       2,
+			"_cgo_get_traceback_function":        2,
+			"_cgo_is_runtime_initialized":        2,
+			"_cgo_maybe_run_preinit":             2,
+			"_cgo_preinit_init":                  2,
+			"_cgo_release_context":               1,
+			"_cgo_topofstack":                    1,
+			"_cgo_tsan_acquire":                  9,
+			"_cgo_tsan_release":                  5,
+			"_cgo_wait_runtime_init_done":        2,
+			"_load_config_used":                  4,
+			"_rt0_ppc64_aix_lib":                 2,
+			"abort":                              3,
+			"act":                                6,
+			"act.sa_handler":                     2,
+			"act.sa_sigaction":                   2,
+			"addr":                               2,
+			"amd64":                              4,
+			"ap":                                 7,
+			"arg":                                14,
+			"arg.Context":                        3,
+			"argc":                               3,
+			"argv":                               1,
+			"arm64":                              2,
+			"buf":                                3,
+			"buffer_append":                      1,
+			"buffer_free":                        1,
+			"buffer_init":                        1,
+			"buffer_len":                         1,
+			"buffer_t":                           5,
+			"build":                              9,
+			"cap":                                2,
+			"cgoContextArg":                      3,
+			"cgoSetTracebackFunctionsArg*":       1,
+			"cgoSymbolizerArg*":                  2,
+			"cgoTracebackArg*":                   2,
+			"cgo_context_function":               5,
+			"cgo_symbolizer_function":            3,
+			"cgo_traceback_function":             3,
+			"char":                               4,
+			"char*":                              1,
+			"clearenv":                           1,
+			"const":                              7,
+			"constructor":                        1,
+			"ctxt":                               3,
+			"diagnostic":                         3,
+			"dlclose":                            1,
+			"dlopen":                             1,
+			"dlsym":                              1,
+			"done":                               4,
+			"else":                               3,
+			"err":                                1,
+			"errno":                              1,
+			"extern":                             3,
+			"fatalf":                             3,
+			"fd":                                 2,
+			"flags":                              6,
+			"for":                                1,
+			"format":                             5,
+			"fprintf":                            3,
+			"freebsd":                            2,
+			"g":                                  1,
+			"get_ver":                            2,
+			"go_sigaction_t":                     6,
+			"go_sigset_t":                        2,
+			"goact":                              6,
+			"handle":                             4,
+			"handler":                            4,
+			"i":                                  6,
+			"i*sizeof":                           1,
+			"if":                                 22,
+			"ignored":                            3,
+			"inittls":                            1,
+			"inline":                             1,
+			"int":                                19,
+			"int32_t":                            8,
+			"intptr_t":                           2,
+			"k":                                  2,
+			"len":                                4,
+			"length":                             4,
+			"libinit":                            2,
+			"linux":                              3,
+			"long":                               1,
+			"longcall":                           3,
+			"loong64":                            2,
+			"magic1":                             3,
+			"main":                               1,
+			"malloc":                             1,
+			"mask":                               3,
+			"memset":                             5,
+			"mmap":                               1,
+			"munmap":                             1,
+			"netbsd":                             1,
+			"nil":                                7,
+			"node":                               5,
+			"off":                                1,
+			"offset":                             2,
+			"oldact":                             6,
+			"p":                                  3,
+			"pfn":                                15,
+			"ppc64":                              1,
+			"ppc64le":                            2,
+			"prot":                               2,
+			"pthread_cond_t":                     1,
+			"pthread_cond_wait":                  1,
+			"pthread_g":                          2,
+			"pthread_key_create":                 1,
+			"pthread_key_destructor":             2,
+			"pthread_key_t":                      2,
+			"pthread_mutex_lock":                 1,
+			"pthread_mutex_t":                    1,
+			"pthread_mutex_unlock":               1,
+			"pthread_setspecific":                1,
+			"r":                                  3,
+			"restorer":                           2,
+			"ret":                                8,
+			"return":                             18,
+			"runtime_init_cond":                  2,
+			"runtime_init_cs":                    12,
+			"runtime_init_done":                  6,
+			"runtime_init_mu":                    4,
+			"runtime_init_once_done":             4,
+			"runtime_init_once_gate":             3,
+			"runtime_init_wait":                  4,
+			"runtime_rt0_go":                     2,
+			"setenv":                             1,
+			"sigaction":                          4,
+			"sigaltstack":                        1,
+			"signum":                             2,
+			"size_t":                             9,
+			"sizeof":                             8,
+			"ss":                                 4,
+			"ss.ss_flags":                        1,
+			"stack_t":                            1,
+			"static":                             21,
+			"status":                             3,
+			"stderr":                             4,
+			"struct":                             21,
+			"threadentry_platform":               1,
+			"typedef":                            4,
+			"uint32_t":                           2,
+			"uint64_t":                           2,
+			"uint8_t":                            1,
+			"uintptr_t":                          14,
+			"unix":                               4,
+			"unsetenv":                           1,
+			"unsigned":                           1,
+			"unused":                             1,
+			"va_end":                             2,
+			"va_list":                            1,
+			"va_start":                           2,
+			"value":                              2,
+			"vfprintf":                           1,
+			"void":                               66,
+			"void*":                              2,
+			"volatile":                           2,
+			"while":                              3,
+			"windows":                            1,
+			"x_cgo_call_symbolizer_function":     2,
+			"x_cgo_call_traceback_function":      2,
+			"x_cgo_clearenv":                     1,
+			"x_cgo_mmap":                         1,
+			"x_cgo_munmap":                       1,
+			"x_cgo_pthread_key_created":          4,
+			"x_cgo_set_traceback_functions":      1,
+			"x_cgo_setenv":                       1,
+			"x_cgo_sigaction":                    2,
+			"x_cgo_unsetenv":                     1,
+			"{":                                  67,
+			"||":                                 10,
+			"}":                                  49,
+		},
+		"C#": map[string]int{
+			"(":                                    151,
+			")":                                    151,
+			"*":                                    1,
+			"+":                                    2,
+			".Replace":                             1,
+			";":                                    82,
+			"<":                                    1,
+			"<Item>":                               3,
+			"<string>":                             4,
+			"Add":                                  1,
+			"ArgumentNullException":                1,
+			"ArraySubType":                         1,
+			"COMException":                         1,
+			"ClassInterface":                       1,
+			"ClassInterfaceType.None":              1,
+			"Clone":                                1,
+			"CoClass":                              1,
+			"ComImport":                            9,
+			"ComInterfaceType.InterfaceIsIUnknown": 7,
+			"Complete":                             1,
+			"Console.WriteLine":                    3,
+			"EnumAllInstances":                     1,
+			"EnumInstances":                        1,
+			"Flags":                                1,
+			"GetBranch":                            1,
+			"GetChip":                              1,
+			"GetDescription":                       1,
+			"GetDisplayName":                       1,
+			"GetEnginePath":                        1,
+			"GetId":                                1,
+			"GetInstallDate":                       1,
+			"GetInstallationName":                  1,
+			"GetInstallationPath":                  1,
+			"GetInstallationVersion":               1,
+			"GetInstanceForCurrentProcess":         1,
+			"GetInstanceForPath":                   1,
+			"GetInstanceId":                        1,
+			"GetIsExtension":                       1,
+			"GetLanguage":                          1,
+			"GetNames":                             1,
+			"GetPackages":                          1,
+			"GetProduct":                           1,
+			"GetProductPath":                       1,
+			"GetProperties":                        1,
+			"GetState":                             1,
+			"GetType":                              1,
+			"GetUniqueId":                          1,
+			"GetValue":                             1,
+			"GetVersion":                           1,
+			"Guid":                                 9,
+			"IEnumSetupInstances":                  5,
+			"IEnumerable":                          1,
+			"ISetupConfiguration":                  4,
+			"ISetupConfiguration2":                 4,
+			"ISetupInstance":                       5,
+			"ISetupInstance2":                      4,
+			"ISetupPackageReference":               4,
+			"ISetupPropertyStore":                  2,
+			"In":                                   7,
+			"InStock":                              1,
+			"InstanceJson":                         2,
+			"InstanceState":                        2,
+			"InterfaceType":                        7,
+			"Inventory":                            2,
+			"IsComplete":                           1,
+			"IsLaunchable":                         1,
+			"Item":                                 3,
+			"JsonString":                           4,
+			"List":                                 6,
+			"Local":                                1,
+			"Main":                                 2,
+			"MarshalAs":                            38,
+			"Name":                                 2,
+			"Next":                                 1,
+			"NoErrors":                             1,
+			"NoRebootRequired":                     1,
+			"None":                                 1,
+			"Out":                                  1,
+			"Price":                                2,
+			"PrintJson":                            1,
+			"Program":                              1,
+			"Quantity":                             2,
+			"Registered":                           1,
+			"Reset":                                1,
+			"ResolvePath":                          1,
+			"SafeArraySubType":                     3,
+			"SetupConfiguration":                   2,
+			"SetupConfigurationClass":              2,
+			"Shop":                                 2,
+			"Skip":                                 1,
+			"String.Format":                        4,
+			"StringBuilder":                        2,
+			"System":                               3,
+			"System.Collections.Generic":           2,
+			"System.Linq":                          1,
+			"System.Runtime.InteropServices":       1,
+			"System.Runtime.InteropServices.ComTypes.FILETIME": 1,
+			"System.Text":                           1,
+			"System.Threading.Tasks":                1,
+			"Task":                                  1,
+			"Task.Delay":                            1,
+			"TotalValue":                            1,
+			"UnmanagedType.BStr":                    16,
+			"UnmanagedType.Interface":               6,
+			"UnmanagedType.LPArray":                 1,
+			"UnmanagedType.LPWStr":                  3,
+			"UnmanagedType.SafeArray":               3,
+			"UnmanagedType.Struct":                  1,
+			"UnmanagedType.U4":                      6,
+			"UnmanagedType.VariantBool":             3,
+			"VarEnum.VT_BSTR":                       1,
+			"VarEnum.VT_UNKNOWN":                    2,
+			"VisualStudioConfiguration":             1,
+			"[":                                     73,
+			"]":                                     73,
+			"_items":                                1,
+			"_items.Add":                            1,
+			"_items.Sum":                            1,
+			"_items.Where":                          1,
+			"args":                                  1,
+			"async":                                 1,
+			"await":                                 1,
+			"bool":                                  3,
+			"catch":                                 1,
+			"celt":                                  2,
+			"class":                                 5,
+			"decimal":                               2,
+			"e":                                     1,
+			"e.Next":                                1,
+			"enum":                                  1,
+			"foreach":                               2,
+			"get":                                   3,
+			"i":                                     2,
+			"i.Price":                               1,
+			"i.Quantity":                            2,
+			"id":                                    2,
+			"if":                                    2,
+			"in":                                    2,
+			"instances":                             1,
+			"instances.Add":                         1,
+			"instances.ToArray":                     1,
+			"int":                                   7,
+			"interface":                             8,
+			"internal":                              1,
+			"inventory":                             1,
+			"inventory.Add":                         1,
+			"inventory.InStock":                     1,
+			"inventory.TotalValue":                  1,
+			"item":                                  5,
+			"json":                                  1,
+			"json.Append":                           5,
+			"json.ToString":                         1,
+			"lcid":                                  2,
+			"m":                                     1,
+			"nameof":                                1,
+			"namespace":                             3,
+			"new":                                   9,
+			"null":                                  1,
+			"object":                                1,
+			"out":                                   2,
+			"package":                               1,
+			"package.GetId":                         1,
+			"packages":                              1,
+			"packages.Add":                          1,
+			"packages.ToArray":                      1,
+			"path":                                  3,
+			"pceltFetched":                          4,
+			"private":                               4,
+			"public":                                20,
+			"pwszName":                              1,
+			"pwszRelativePath":                      1,
+			"query":                                 2,
+			"query2":                                1,
+			"query2.EnumAllInstances":               1,
+			"readonly":                              1,
+			"return":                                33,
+			"rgelt":                                 4,
+			"s":                                     1,
+			"s.Replace":                             1,
+			"set":                                   3,
+			"setupInstance2":                        1,
+			"setupInstance2.GetInstallationPath":    1,
+			"setupInstance2.GetInstallationVersion": 1,
+			"setupInstance2.GetPackages":            1,
+			"static":                                6,
+			"string":                                28,
+			"string.Join":                           2,
+			"throw":                                 1,
+			"true":                                  1,
+			"try":                                   1,
+			"typeof":                                1,
+			"uint":                                  1,
+			"using":                                 9,
+			"var":                                   2,
+			"version":                               2,
+			"void":                                  5,
+			"while":                                 1,
+			"{":                                     34,
+			"}":                                     34,
+		},
+		"C++": map[string]int{
+			"##":                    1,
+			"#NAME":                 1,
+			"#define":               5,
+			"#else":                 1,
+			"#endif":                4,
+			"#if":                   2,
+			"#ifdef":                1,
+			"#ifndef":               1,
+			"#include":              13,
+			"#pragma":               3,
+			"#undef":                1,
+			"&":                     9,
+			"(":                     154,
+			")":                     154,
+			"*":                     10,
+			"*Py_UNUSED":            7,
+			"*args":                 1,
+			"*const_obj":            1,
+			"*m_obj":                1,
+			"*module":               1,
+			"*obj":                  2,
+			"*one":                  1,
+			"*result":               1,
+			"*str":                  1,
+			"*type":                 2,
+			"+":                     14,
+			"-":                     12,
+			"//":                    1,
+			";":                     125,
+			"<":                     4,
+			"<Circle>":              1,
+			"<Shape>":               1,
+			"<VirtualPyObject*>":    1,
+			"<b>":                   1,
+			"<delayimp.h>":          1,
+			"<iostream>":            1,
+			"<memory>":              1,
+			"<stdexcept>":           1,
+			"<string.h>":            1,
+			"<string>":              2,
+			"<typename>":            1,
+			"<unsigned>":            1,
+			"<vector>":              1,
+			"<void*>":               1,
+			"<windows.h>":           1,
+			"A":                     4,
+			"B":                     3,
+			"Caller":                1,
+			"Circle":                2,
+			"DelayLoadInfo*":        1,
+			"E":                     3,
+			"FARPROC":               2,
+			"FUNC_NAME":             2,
+			"GetModuleHandle":       1,
+			"HMODULE":               1,
+			"HOST_BINARY":           1,
+			"METH_NOARGS":           3,
+			"METH_VARARGS":          1,
+			"Matrix":                5,
+			"NAME":                  10,
+			"NDEBUG":                1,
+			"NULL":                  3,
+			"PyArg_ParseTuple":      1,
+			"PyDoc_STRVAR":          2,
+			"PyErr_Format":          1,
+			"PyExc_AssertionError":  1,
+			"PyInit_":               1,
+			"PyLong_AsLong":         1,
+			"PyLong_FromLong":       1,
+			"PyMODINIT_FUNC":        1,
+			"PyMethodDef":           1,
+			"PyModuleDef":           1,
+			"PyModuleDef_HEAD_INIT": 1,
+			"PyModuleDef_Init":      1,
+			"PyModuleDef_Slot":      1,
+			"PyModule_AddIntMacro":  1,
+			"PyObject":              22,
+			"PyObject*":             2,
+			"PyObject_CallMethod":   3,
+			"PyObject_Init":         1,
+			"PyTuple_GET_ITEM":      1,
+			"PyTuple_GET_SIZE":      1,
+			"PyTuple_Type":          2,
+			"PyTypeObject":          2,
+			"PyType_FromSpec":       1,
+			"PyType_Slot":           1,
+			"PyType_Spec":           1,
+			"PyUnicode_1BYTE_KIND":  1,
+			"PyUnicode_Check":       1,
+			"PyUnicode_DATA":        2,
+			"PyUnicode_FromString":  1,
+			"PyUnicode_GET_LENGTH":  1,
+			"PyUnicode_KIND":        1,
+			"PyUnicode_READ":        2,
+			"PyUnicode_READ_CHAR":   1,
+			"Py_BuildValue":         1,
+			"Py_DECREF":             10,
+			"Py_INCREF":             3,
+			"Py_REFCNT":             3,
+			"Py_RETURN_NONE":        3,
+			"Py_TPFLAGS_DEFAULT":    1,
+			"Py_TYPE":               2,
+			"Py_XDECREF":            2,
+			"Py_XINCREF":            2,
+			"Py_mod_exec":           1,
+			"Py_ssize_t":            1,
+			"Py_tp_free":            1,
+			"STR":                   3,
+			"Shape":                 3,
+			"StrongRef":             4,
+			"T":                     2,
+			"VirtualPyObject":       11,
+			"VirtualPyObject*":      1,
+			"VirtualPyObject_Slots": 2,
+			"VirtualPyObject_Spec":  2,
+			"WIN32_LEAN_AND_MEAN":   2,
+			"WINAPI":                1,
+			"[":                     12,
+			"]":                     12,
+			"_FUNC_NAME":            2,
+			"_MSC_VER":              1,
+			"_Py_NULL":              17,
+			"_STR":                  2,
+			"__cplusplus":           3,
+			"__pfnDliNotifyHook2":   2,
+			"_stricmp":              1,
+			"_testcppext_add":       2,
+			"_testcppext_add_doc":   2,
+			"_testcppext_doc":       2,
+			"_testcppext_exec":      2,
+			"_testcppext_methods":   2,
+			"_testcppext_module":    2,
+			"_testcppext_slots":     2,
+			"a":                     2,
+			"area":                  2,
+			"args":                  4,
+			"assert":                16,
+			"b":                     1,
+			"bar":                   1,
+			"c":                     5,
+			"call":                  1,
+			"callback_":             2,
+			"class":                 10,
+			"cols":                  3,
+			"cols_":                 5,
+			"const":                 12,
+			"const_data":            2,
+			"const_obj":             6,
+			"constexpr":             1,
+			"data":                  3,
+			"data_":                 3,
+			"dealloc":               2,
+			"decltype":              1,
+			"default":               1,
+			"delete":                2,
+			"dliNotePreLoadLibrary": 1,
+			"double":                5,
+			"e":                     1,
+			"enum":                  4,
+			"event":                 2,
+			"explicit":              1,
+			"foo":                   3,
+			"for":                   3,
+			"i":                     7,
+			"if":                    12,
+			"info":                  2,
+			"inline":                1,
+			"instance_count":        6,
+			"int":                   8,
+			"int*":                  1,
+			"internal_data":         4,
+			"invalid_argument":      1,
+			"j":                     7,
+			"k":                     3,
+			"kind":                  4,
+			"linalg":                1,
+			"load_exe_hook":         2,
+			"long":                  2,
+			"m":                     3,
+			"m_obj":                 4,
+			"main":                  1,
+			"make_circle":           1,
+			"make_unique":           1,
+			"managed":               2,
+			"module":                8,
+			"name":                  2,
+			"namespace":             1,
+			"new":                   2,
+			"nodiscard":             2,
+			"noexcept":              1,
+			"nullptr":               2,
+			"o":                     2,
+			"obj":                   9,
+			"off":                   1,
+			"once":                  1,
+			"one":                   1,
+			"operator":              3,
+			"operator*":             1,
+			"other":                 1,
+			"other.cols_":           2,
+			"other.rows_":           1,
+			"override":              2,
+			"pop":                   1,
+			"private":               3,
+			"public":                7,
+			"push":                  1,
+			"r":                     4,
+			"radius":                4,
+			"radius_":               4,
+			"refcnt":                4,
+			"reinterpret_cast":      1,
+			"res":                   2,
+			"result":                11,
+			"return":                24,
+			"rows":                  3,
+			"rows_":                 3,
+			"run":                   1,
+			"set_internal_data":     2,
+			"size_t":                9,
+			"sizeof":                1,
+			"static":                11,
+			"static_cast":           2,
+			"std":                   15,
+			"str":                   8,
+			"string":                3,
+			"strong_ref":            5,
+			"struct":                1,
+			"szDll":                 1,
+			"template":              1,
+			"test_api_casts":        2,
+			"test_unicode":          2,
+			"test_virtual_object":   2,
+			"this":                  4,
+			"throw":                 1,
+			"type":                  4,
+			"ukind":                 2,
+			"unique_ptr":            1,
+			"unsigned":              2,
+			"virtual":               5,
+			"void":                  7,
+			"void*":                 3,
+			"{":                     57,
+			"}":                     54,
+		},
+		"CSV": map[string]int{
+			"-":            455,
+			"A":            1,
+			"Aardvark":     1,
+			"Artful":       1,
+			"B":            1,
+			"Badger":       1,
+			"Beaver":       1,
+			"Bionic":       1,
+			"Bo":           1,
+			"Bookworm":     1,
+			"Breezy":       1,
+			"Bullseye":     1,
+			"Buster":       1,
+			"Buzz":         1,
+			"C":            1,
+			"Cosmic":       1,
+			"Cuttlefish":   1,
+			"D":            1,
+			"Dapper":       1,
+			"Dingo":        1,
+			"Disco":        1,
+			"Drake":        1,
+			"Duke":         1,
+			"E":            1,
+			"Edgy":         1,
+			"Eft":          1,
+			"Eoan":         1,
+			"Ermine":       1,
+			"Etch":         1,
+			"Experimental": 1,
+			"F":            1,
+			"Fawn":         1,
+			"Feisty":       1,
+			"Focal":        1,
+			"Forky":        1,
+			"Fossa":        1,
+			"G":            1,
+			"Gibbon":       1,
+			"Gorilla":      1,
+			"Groovy":       1,
+			"Gutsy":        1,
+			"H":            1,
+			"Hamm":         1,
+			"Hardy":        1,
+			"Hedgehog":     1,
+			"Heron":        1,
+			"Hippo":        1,
+			"Hirsute":      1,
+			"Hoary":        1,
+			"I":            1,
+			"Ibex":         1,
+			"Impish":       1,
+			"Indri":        1,
+			"Intrepid":     1,
+			"J":            1,
+			"Jackalope":    1,
+			"Jammy":        1,
+			"Jaunty":       1,
+			"Jellyfish":    1,
+			"Jessie":       1,
+			"Karmic":       1,
+			"Kinetic":      1,
+			"Koala":        1,
+			"Kudu":         1,
+			"LTS":          10,
+			"Lenny":        1,
+			"Lobster":      1,
+			"Lucid":        1,
+			"Lunar":        1,
+			"Lynx":         1,
+			"Mantic":       1,
+			"Maverick":     1,
+			"Meerkat":      1,
+			"Minotaur":     1,
+			"Narwhal":      1,
+			"Natty":        1,
+			"Noble":        1,
+			"Numbat":       1,
+			"Ocelot":       1,
+			"Oneiric":      1,
+			"Oracular":     1,
+			"Oriole":       1,
+			"Pangolin":     1,
+			"Plucky":       1,
+			"Potato":       1,
+			"Precise":      1,
+			"Puffin":       1,
+			"Quantal":      1,
+			"Questing":     1,
+			"Quetzal":      1,
+			"Quokka":       1,
+			"Raring":       1,
+			"Rex":          1,
+			"Ringtail":     1,
+			"Salamander":   1,
+			"Sarge":        1,
+			"Saucy":        1,
+			"Sid":          1,
+			"Slink":        1,
+			"Squeeze":      1,
+			"Stretch":      1,
+			"Tahr":         1,
+			"Trixie":       1,
+			"Trusty":       1,
+			"Unicorn":      1,
+			"Utopic":       1,
+			"Vervet":       1,
+			"Vivid":        1,
+			"Warthog":      1,
+			"Warty":        1,
+			"Werewolf":     1,
+			"Wheezy":       1,
+			"Wily":         1,
+			"Woody":        1,
+			"Xenial":       1,
+			"Xerus":        1,
+			"Yak":          1,
+			"Yakkety":      1,
+			"Zapus":        1,
+			"Zesty":        1,
+			"artful":       1,
+			"bionic":       1,
+			"bo":           1,
+			"bookworm":     1,
+			"breezy":       1,
+			"bullseye":     1,
+			"buster":       1,
+			"buzz":         1,
+			"codename":     2,
+			"comma":        1,
+			"cosmic":       1,
+			"created":      2,
+			"dapper":       1,
+			"disco":        1,
+			"duke":         1,
+			"edgy":         1,
+			"elts":         1,
+			"eoan":         1,
+			"eol":          7,
+			"esm":          1,
+			"etch":         1,
+			"experimental": 1,
+			"feisty":       1,
+			"focal":        1,
+			"forky":        1,
+			"groovy":       1,
+			"gutsy":        1,
+			"hamm":         1,
+			"hardy":        1,
+			"hello":        1,
+			"hirsute":      1,
+			"hoary":        1,
+			"impish":       1,
+			"intrepid":     1,
+			"jammy":        1,
+			"jaunty":       1,
+			"jessie":       1,
+			"karmic":       1,
+			"kinetic":      1,
+			"legacy":       1,
+			"lenny":        1,
+			"lts":          1,
+			"lucid":        1,
+			"lunar":        1,
+			"mantic":       1,
+			"maverick":     1,
+			"natty":        1,
+			"noble":        1,
+			"oneiric":      1,
+			"oracular":     1,
+			"plucky":       1,
+			"potato":       1,
+			"precise":      1,
+			"quantal":      1,
+			"questing":     1,
+			"quote":        1,
+			"raring":       1,
+			"release":      2,
+			"rex":          1,
+			"sarge":        1,
+			"saucy":        1,
+			"series":       2,
+			"server":       1,
+			"sid":          1,
+			"slink":        1,
+			"squeeze":      1,
+			"stretch":      1,
+			"trixie":       1,
+			"trusty":       1,
+			"utopic":       1,
+			"version":      2,
+			"vivid":        1,
+			"warty":        1,
+			"wheezy":       1,
+			"wily":         1,
+			"woody":        1,
+			"xenial":       1,
+			"yakkety":      1,
+			"zesty":        1,
+		},
+		"Go": map[string]int{
+			"%":                              1,
+			"&":                              40,
+			"&&":                             48,
+			"(":                              773,
+			")":                              768,
+			"*":                              3,
+			"*Archive":                       3,
+			"*Element":                       24,
+			"*List":                          25,
+			"*Once":                          2,
+			"*Options":                       1,
+			"*ParseError":                    2,
+			"*PipeReader":                    3,
+			"*PipeWriter":                    3,
+			"*Queue":                         4,
+			"*Reader":                        7,
+			"*Replacer":                      5,
+			"*Scanner":                       9,
+			"*Writer":                        15,
+			"*appendSliceWriter":             1,
+			"*archive.Archive":               2,
+			"*bufio.Reader":                  1,
+			"*flag.CommandLine":              2,
+			"*genericReplacer":               3,
+			"*http.Request":                  1,
+			"*line":                          1,
+			"*onceError":                     2,
+			"*os.File":                       1,
+			"*pipe":                          6,
+			"*printSize":                     1,
+			"*printType":                     1,
+			"*r":                             6,
+			"*sortOrder":                     5,
+			"*store":                         1,
+			"*trieNode":                      6,
+			"*w":                             1,
+			"*xorshift":                      1,
+			"+":                              81,
+			"-":                              54,
+			"...":                            1,
+			"...any":                         1,
+			"...string":                      1,
+			".Addr":                          2,
+			".Decode":                        1,
+			".Encode":                        1,
+			".Init":                          1,
+			".Name":                          2,
+			".Size":                          2,
+			".add":                           1,
+			"/":                              1,
+			"//":                             85,
+			"//go":                           2,
+			"/2":                             2,
+			"/b.tabwidth":                    1,
+			";":                              53,
+			"<":                              49,
+			"<-p.done:>":                     1,
+			"<<":                             3,
+			"<=>":                            1,
+			"AlignRight":                     3,
+			"Archive":                        2,
+			"Back":                           1,
+			"Buffer":                         1,
+			"Bytes":                          1,
+			"Close":                          3,
+			"CloseWithError":                 2,
+			"Column":                         5,
+			"Comma":                          2,
+			"Comment":                        1,
+			"Debug":                          2,
+			"DiscardEmptyColumns":            2,
+			"Do":                             1,
+			"EOF":                            1,
+			"Element":                        3,
+			"Err":                            6,
+			"ErrAdvanceTooFar":               2,
+			"ErrBadPattern":                  6,
+			"ErrBadReadCount":                2,
+			"ErrBareQuote":                   2,
+			"ErrClosed":                      2,
+			"ErrClosedPipe":                  4,
+			"ErrDot":                         1,
+			"ErrFieldCount":                  3,
+			"ErrFinalToken":                  2,
+			"ErrNegativeAdvance":             2,
+			"ErrNotFound":                    2,
+			"ErrQuote":                       3,
+			"ErrTooLong":                     2,
+			"ErrTrailingComma":               1,
+			"Error":                          5,
+			"Escape":                         4,
+			"FieldPos":                       1,
+			"FieldsPerRecord":                1,
+			"FilterHTML":                     1,
+			"For":                            2,
+			"Front":                          1,
+			"Go":                             1,
+			"HasPrefix":                      1,
+			"Init":                           2,
+			"InputOffset":                    1,
+			"InsertAfter":                    1,
+			"InsertBefore":                   1,
+			"Interface":                      2,
+			"IsBoolFlag":                     1,
+			"Jar":                            1,
+			"LazyQuotes":                     1,
+			"Len":                            2,
+			"Less":                           1,
+			"Line":                           5,
+			"List":                           2,
+			"Load":                           1,
+			"Match":                          1,
+			"MaxScanTokenSize":               2,
+			"MoveAfter":                      1,
+			"MoveBefore":                     1,
+			"MoveToBack":                     1,
+			"MoveToFront":                    1,
+			"Mutex":                          1,
+			"New":                            3,
+			"NewReader":                      1,
+			"NewReplacer":                    1,
+			"NewScanner":                     1,
+			"Next":                           2,
+			"Once":                           1,
+			"Options":                        1,
+			"ParseError":                     5,
+			"Pattern":                        2,
+			"Pipe":                           1,
+			"PipeReader":                     2,
+			"PipeWriter":                     1,
+			"Pop":                            1,
+			"Prev":                           1,
+			"PublicSuffix":                   1,
+			"PublicSuffixList":               4,
+			"Push":                           1,
+			"PushBack":                       1,
+			"PushBackList":                   1,
+			"PushFront":                      1,
+			"PushFrontList":                  1,
+			"Queue":                          2,
+			"Read":                           2,
+			"ReadAll":                        1,
+			"Reader":                         2,
+			"Remove":                         1,
+			"Replace":                        2,
+			"Replacer":                       2,
+			"ReuseRecord":                    1,
+			"Scan":                           1,
+			"ScanBytes":                      1,
+			"ScanLines":                      2,
+			"ScanRunes":                      1,
+			"ScanWords":                      1,
+			"Scanner":                        2,
+			"ServeHTTP":                      1,
+			"Set":                            1,
+			"Sort":                           1,
+			"Split":                          1,
+			"SplitFunc":                      3,
+			"StartLine":                      5,
+			"Store":                          1,
+			"String":                         2,
+			"StripEscape":                    2,
+			"Swap":                           1,
+			"TabIndent":                      2,
+			"Text":                           1,
+			"TrailingComma":                  1,
+			"TrimLeadingSpace":               1,
+			"Unwrap":                         1,
+			"Usage":                          1,
+			"Value":                          2,
+			"Where":                          1,
+			"Write":                          2,
+			"WriteString":                    2,
+			"Writer":                         1,
+			"[":                              236,
+			"]":                              236,
+			"_":                              16,
+			"a":                              10,
+			"a.Entries":                      1,
+			"a.Lock":                         2,
+			"a.Unlock":                       2,
+			"a.err":                          3,
+			"accepted":                       1,
+			"action":                         1,
+			"add":                            1,
+			"addLine":                        1,
+			"address":                        3,
+			"advance":                        9,
+			"after":                          1,
+			"alias":                          1,
+			"allNewBytes":                    3,
+			"an":                             1,
+			"and":                            1,
+			"any":                            7,
+			"append":                         23,
+			"appendSliceWriter":              1,
+			"ar":                             7,
+			"ar.addFiles":                    2,
+			"ar.addPkgdef":                   1,
+			"ar.extractContents":             1,
+			"ar.files":                       2,
+			"ar.printContents":               1,
+			"ar.scan":                        3,
+			"ar.tableOfContents":             1,
+			"arHeader":                       1,
+			"archive.New":                    2,
+			"archive.Parse":                  1,
+			"arg":                            4,
+			"args":                           5,
+			"args...":                        1,
+			"as":                             1,
+			"at":                             7,
+			"at.next":                        2,
+			"atEOF":                          11,
+			"atomic.Bool":                    1,
+			"b":                              32,
+			"b.addLine":                      1,
+			"b.buf":                          11,
+			"b.cell":                         1,
+			"b.cell.htab":                    1,
+			"b.cell.size":                    3,
+			"b.cell.width":                   3,
+			"b.endChar":                      6,
+			"b.flags":                        6,
+			"b.format":                       1,
+			"b.lines":                        13,
+			"b.minwidth":                     2,
+			"b.oldnew":                       1,
+			"b.output":                       1,
+			"b.output.Write":                 1,
+			"b.padbytes":                     4,
+			"b.padding":                      2,
+			"b.pos":                          4,
+			"b.reset":                        1,
+			"b.tabwidth":                     6,
+			"b.updateWidth":                  1,
+			"b.widths":                       14,
+			"b.write0":                       7,
+			"b.writeLines":                   2,
+			"b.writeN":                       2,
+			"b.writePadding":                 3,
+			"bestPriority":                   3,
+			"between":                        1,
+			"bits.Len":                       1,
+			"bool":                           34,
+			"break":                          19,
+			"buf":                            8,
+			"bufio":                          1,
+			"bufio.ErrBufferFull":            2,
+			"bufio.NewReader":                1,
+			"bufio.NewScanner":               1,
+			"bufio.NewWriter":                2,
+			"build":                          3,
+			"buildOnce":                      1,
+			"bw":                             2,
+			"by":                             1,
+			"byte":                           48,
+			"byteReplacer":                   1,
+			"byteStringReplacer":             1,
+			"bytealg.IndexByteString":        1,
+			"bytes.IndexByte":                3,
+			"bytes.IndexFunc":                1,
+			"bytes.IndexRune":                1,
+			"c":                              3,
+			"c.":                             1,
+			"c.htab":                         1,
+			"c.size":                         7,
+			"c.width":                        5,
+			"cap":                            3,
+			"case":                           43,
+			"cell":                           6,
+			"cellw":                          5,
+			"ch":                             4,
+			"chan":                           5,
+			"chunk":                          44,
+			"close":                          3,
+			"closeRead":                      1,
+			"closeWrite":                     1,
+			"cmd/pack":                       1,
+			"col":                            4,
+			"column":                         5,
+			"commaLen":                       5,
+			"commands":                       1,
+			"compatibility":                  2,
+			"const":                          9,
+			"context.Context":                2,
+			"continue":                       10,
+			"cookiejar":                      1,
+			"copy":                           3,
+			"counter.CountFlags":             2,
+			"counter.Inc":                    5,
+			"counter.Open":                   4,
+			"cprtx":                          1,
+			"csv":                            1,
+			"ctx":                            2,
+			"ctx.Done":                       2,
+			"ctx.Err":                        2,
+			"d":                              1,
+			"d.Mode":                         1,
+			"data":                           39,
+			"data.Len":                       1,
+			"decimal":                        1,
+			"decreasingHint":                 1,
+			"default":                        8,
+			"defer":                          7,
+			"dir":                            5,
+			"discardable":                    3,
+			"doSlow":                         1,
+			"doc":                            1,
+			"domain":                         1,
+			"done":                           3,
+			"dropCR":                         3,
+			"dst":                            11,
+			"dump":                           1,
+			"e":                              32,
+			"e.Column":                       2,
+			"e.Err":                          5,
+			"e.Line":                         4,
+			"e.Name":                         1,
+			"e.Next":                         1,
+			"e.Prev":                         1,
+			"e.StartLine":                    2,
+			"e.Symbols":                      1,
+			"e.Value":                        3,
+			"e.list":                         9,
+			"e.list.root":                    2,
+			"e.next":                         6,
+			"e.next.prev":                    4,
+			"e.prev":                         6,
+			"e.prev.next":                    4,
+			"else":                           23,
+			"empties":                        1,
+			"end":                            1,
+			"endChar":                        1,
+			"endEscape":                      1,
+			"entries":                        4,
+			"entry":                          1,
+			"environments":                   1,
+			"err":                            130,
+			"err.Error":                      2,
+			"errInvalidDelim":                2,
+			"errRead":                        12,
+			"error":                          48,
+			"errorRune":                      2,
+			"errorf":                         4,
+			"errors.New":                     14,
+			"exec":                           1,
+			"execerrdot.IncNonDefault":       1,
+			"execerrdot.Value":               1,
+			"exitCode":                       3,
+			"f":                              11,
+			"f.Close":                        2,
+			"f.Entries":                      1,
+			"f.Name":                         4,
+			"f.PCLineTable":                  1,
+			"failed":                         11,
+			"fallthrough":                    1,
+			"false":                          31,
+			"field":                          12,
+			"field...":                       1,
+			"fieldIndexes":                   1,
+			"fieldPos":                       4,
+			"fieldPositions":                 1,
+			"file":                           25,
+			"file...":                        1,
+			"file.a":                         1,
+			"filePrefix":                     3,
+			"filepath.Base":                  1,
+			"filepath.IsAbs":                 1,
+			"filepath.IsLocal":               1,
+			"filepath.Join":                  1,
+			"filepath.SplitList":             1,
+			"files":                          5,
+			"findExecutable":                 3,
+			"flag.Arg":                       2,
+			"flag.Args":                      1,
+			"flag.Bool":                      2,
+			"flag.NArg":                      1,
+			"flag.Parse":                     2,
+			"flag.String":                    1,
+			"flag.Usage":                     3,
+			"flag.Var":                       1,
+			"flags":                          4,
+			"flushed":                        1,
+			"fmt.Fprint":                     1,
+			"fmt.Fprintf":                    16,
+			"fmt.Fprintln":                   3,
+			"fmt.Sprintf":                    3,
+			"fn":                             2,
+			"fn.Name":                        1,
+			"followed":                       1,
+			"for":                            50,
+			"format":                         3,
+			"found":                          5,
+			"from":                           1,
+			"fs.ErrPermission":               1,
+			"func":                           134,
+			"gen_sort_variants.go":           1,
+			"generate":                       1,
+			"genericReplacer":                2,
+			"getEsc":                         3,
+			"given":                          1,
+			"go":                             3,
+			"grc":                            1,
+			"helpText":                       2,
+			"hi":                             4,
+			"htab":                           3,
+			"http.Error":                     2,
+			"http.ListenAndServe":            1,
+			"http.MethodGet":                 1,
+			"http.MethodPost":                1,
+			"http.ResponseWriter":            1,
+			"http.StatusBadRequest":          1,
+			"http.StatusInternalServerError": 1,
+			"http.StatusMethodNotAllowed":    1,
+			"i":                              97,
+			"idx":                            3,
+			"if":                             202,
+			"ignoreRoot":                     2,
+			"import":                         16,
+			"in":                             2,
+			"increasingHint":                 1,
+			"index":                          6,
+			"information":                    1,
+			"init":                           1,
+			"inrange":                        4,
+			"insert":                         1,
+			"insertValue":                    1,
+			"int":                            69,
+			"int64":                          3,
+			"interface":                      7,
+			"io":                             1,
+			"io.EOF":                         6,
+			"io.ErrNoProgress":               1,
+			"io.ErrShortWrite":               1,
+			"io.Reader":                      3,
+			"io.Writer":                      4,
+			"iota":                           2,
+			"is":                             2,
+			"isSpace":                        3,
+			"item":                           3,
+			"items":                          2,
+			"j":                              23,
+			"json.NewDecoder":                1,
+			"json.NewEncoder":                1,
+			"k":                              2,
+			"key":                            14,
+			"keyNode":                        2,
+			"keyNode.add":                    1,
+			"keylen":                         2,
+			"l":                              31,
+			"l.Init":                         1,
+			"l.insert":                       1,
+			"l.insertValue":                  6,
+			"l.lazyInit":                     4,
+			"l.len":                          6,
+			"l.move":                         4,
+			"l.remove":                       1,
+			"l.root":                         5,
+			"l.root.next":                    4,
+			"l.root.prev":                    6,
+			"largest":                        1,
+			"lastRecord":                     1,
+			"lazyInit":                       1,
+			"len":                            106,
+			"lengthNL":                       5,
+			"limit":                          2,
+			"line":                           65,
+			"line...":                        3,
+			"line0":                          9,
+			"line1":                          6,
+			"lines":                          1,
+			"list":                           2,
+			"lo":                             4,
+			"log.Fatal":                      4,
+			"log.Fatalf":                     3,
+			"log.Print":                      1,
+			"log.Printf":                     2,
+			"log.SetFlags":                   3,
+			"log.SetPrefix":                  2,
+			"lookExtensions":                 1,
+			"lookPath":                       1,
+			"lookup":                         1,
+			"loop":                           3,
+			"m":                              7,
+			"m.IsDir":                        1,
+			"main":                           12,
+			"make":                           8,
+			"makeGenericReplacer":            2,
+			"makeSingleStringReplacer":       1,
+			"map":                            5,
+			"mapping":                        1,
+			"mark":                           8,
+			"mark.list":                      4,
+			"mark.prev":                      2,
+			"match":                          4,
+			"matchAll":                       2,
+			"matchChunk":                     4,
+			"matched":                        1,
+			"max":                            2,
+			"maxConsecutiveEmptyReads":       2,
+			"maxInt":                         1,
+			"maxInt/2":                       1,
+			"maxTokenSize":                   2,
+			"min":                            1,
+			"minwidth":                       4,
+			"mode":                           4,
+			"more":                           1,
+			"move":                           1,
+			"mu":                             2,
+			"n":                              71,
+			"name":                           20,
+			"name....":                       1,
+			"nchunk":                         3,
+			"negated":                        3,
+			"new":                            5,
+			"newBuf":                         3,
+			"newSize":                        6,
+			"newline":                        2,
+			"next":                           6,
+			"next.add":                       1,
+			"nextRune":                       3,
+			"nextSeqNum":                     1,
+			"nflag":                          5,
+			"nil":                            116,
+			"nm":                             4,
+			"noCopy":                         1,
+			"node":                           5,
+			"node.next":                      1,
+			"node.prefix":                    4,
+			"node.priority":                  2,
+			"node.table":                     2,
+			"node.value":                     1,
+			"none":                           1,
+			"nr":                             3,
+			"nrange":                         3,
+			"numLine":                        1,
+			"numeric":                        1,
+			"nw":                             3,
+			"o":                              9,
+			"o.doSlow":                       1,
+			"o.done.Load":                    2,
+			"o.done.Store":                   1,
+			"o.m.Lock":                       1,
+			"o.m.Unlock":                     1,
+			"objabi.AddVersionFlag":          1,
+			"objabi.Flagparse":               1,
+			"objfile.Open":                   2,
+			"of":                             1,
+			"offset":                         1,
+			"ok":                             7,
+			"old":                            1,
+			"oldnew":                         27,
+			"oldnew...":                      1,
+			"once":                           3,
+			"onceError":                      3,
+			"one":                            1,
+			"op":                             8,
+			"openArchive":                    6,
+			"optionally":                     1,
+			"options":                        1,
+			"order":                          1,
+			"orders":                         1,
+			"os.Args":                        15,
+			"os.Exit":                        6,
+			"os.Getenv":                      1,
+			"os.O_CREATE":                    3,
+			"os.O_RDONLY":                    3,
+			"os.O_RDWR":                      2,
+			"os.O_TRUNC":                     2,
+			"os.OpenFile":                    1,
+			"os.Stat":                        1,
+			"os.Stderr":                      6,
+			"os.Stdin":                       1,
+			"os.Stdout":                      3,
+			"osError":                        2,
+			"other":                          3,
+			"other.Back":                     1,
+			"other.Front":                    1,
+			"other.Len":                      2,
+			"output":                         4,
+			"output.":                        1,
+			"p":                              17,
+			"p.col":                          1,
+			"p.done":                         5,
+			"p.line":                         1,
+			"p.once.Do":                      2,
+			"p.rdCh":                         2,
+			"p.readCloseError":               2,
+			"p.rerr.Load":                    2,
+			"p.rerr.Store":                   1,
+			"p.werr.Load":                    2,
+			"p.werr.Store":                   1,
+			"p.wrCh":                         2,
+			"p.writeCloseError":              1,
+			"pack":                           1,
+			"package":                        18,
+			"pad":                            1,
+			"padbytes":                       1,
+			"padchar":                        3,
+			"padding":                        4,
+			"panic":                          8,
+			"parseField":                     10,
+			"path":                           10,
+			"pattern":                        20,
+			"pc":                             2,
+			"pdqsort":                        1,
+			"pipe":                           2,
+			"pos":                            26,
+			"pos.col":                        12,
+			"pos.line":                       2,
+			"pos0":                           4,
+			"position":                       3,
+			"preIdx":                         3,
+			"prefix":                         3,
+			"prefixNode":                     4,
+			"prev":                           4,
+			"print":                          7,
+			"printSize":                      1,
+			"printType":                      1,
+			"printUsage":                     3,
+			"priority":                       8,
+			"psList":                         1,
+			"q":                              3,
+			"q.ch":                           3,
+			"queue":                          1,
+			"quoteLen":                       8,
+			"r":                              74,
+			"r.Body":                         1,
+			"r.CloseWithError":               1,
+			"r.Comma":                        5,
+			"r.Comment":                      5,
+			"r.FieldsPerRecord":              4,
+			"r.LazyQuotes":                   3,
+			"r.Method":                       1,
+			"r.ReuseRecord":                  1,
+			"r.TrimLeadingSpace":             1,
+			"r.build":                        1,
+			"r.buildOnce":                    2,
+			"r.fieldIndexes":                 14,
+			"r.fieldPositions":               12,
+			"r.lastRecord":                   2,
+			"r.mapping":                      9,
+			"r.numLine":                      5,
+			"r.offset":                       2,
+			"r.oldnew":                       1,
+			"r.once.Do":                      2,
+			"r.pipe.closeRead":               1,
+			"r.pipe.read":                    1,
+			"r.r":                            1,
+			"r.r.ReadSlice":                  2,
+			"r.r.Replace":                    1,
+			"r.r.WriteString":                1,
+			"r.rawBuffer":                    5,
+			"r.readLine":                     2,
+			"r.readRecord":                   3,
+			"r.recordBuffer":                 17,
+			"r.replacements":                 2,
+			"r.root":                         2,
+			"r.root.add":                     1,
+			"r.root.table":                   1,
+			"r.tableSize":                    5,
+			"r.toReplace":                    2,
+			"range":                          15,
+			"rawBuffer":                      1,
+			"rdCh":                           1,
+			"read":                           1,
+			"readCloseError":                 1,
+			"readLine":                       1,
+			"readRecord":                     1,
+			"readSize":                       5,
+			"recLine":                        6,
+			"record":                         7,
+			"recordBuffer":                   1,
+			"records":                        4,
+			"remove":                         1,
+			"replacer":                       3,
+			"rerr":                           6,
+			"reset":                          1,
+			"rest":                           2,
+			"return":                         161,
+			"rn":                             3,
+			"root":                           2,
+			"run":                            2,
+			"rune":                           12,
+			"s":                              38,
+			"s.advance":                      1,
+			"s.buf":                          15,
+			"s.done":                         2,
+			"s.empties":                      4,
+			"s.end":                          13,
+			"s.err":                          9,
+			"s.items":                        2,
+			"s.maxTokenSize":                 3,
+			"s.mu.Lock":                      1,
+			"s.mu.Unlock":                    1,
+			"s.r.Read":                       1,
+			"s.scanCalled":                   3,
+			"s.setErr":                       7,
+			"s.split":                        2,
+			"s.start":                        13,
+			"s.token":                        4,
+			"scan":                           1,
+			"scanCalled":                     1,
+			"scanChunk":                      3,
+			"select":                         6,
+			"setErr":                         1,
+			"setOp":                          2,
+			"size":                           7,
+			"smallest":                       1,
+			"sort":                           4,
+			"sort.Slice":                     3,
+			"sortOrder":                      1,
+			"sortedHint":                     2,
+			"split":                          4,
+			"src":                            5,
+			"star":                           8,
+			"start":                          10,
+			"startBufSize":                   2,
+			"startEscape":                    1,
+			"stdin":                          1,
+			"stdin.Scan":                     1,
+			"stdin.Text":                     1,
+			"stdout":                         3,
+			"stdout.Flush":                   1,
+			"store":                          2,
+			"str":                            2,
+			"strconv.ParseUint":              1,
+			"string":                         62,
+			"strings":                        1,
+			"strings.Contains":               2,
+			"strings.TrimPrefix":             1,
+			"struct":                         23,
+			"switch":                         12,
+			"sym":                            1,
+			"sym.Addr":                       1,
+			"sym.Code":                       1,
+			"sym.Type":                       1,
+			"symbol":                         2,
+			"syms":                           12,
+			"sync":                           1,
+			"sync.Mutex":                     4,
+			"sync.Once":                      2,
+			"synonym":                        1,
+			"syscall.EISDIR":                 1,
+			"syscall.ENOSYS":                 1,
+			"syscall.EPERM":                  1,
+			"t":                              7,
+			"t.next":                         6,
+			"t.next.add":                     2,
+			"t.prefix":                       12,
+			"t.priority":                     2,
+			"t.table":                        7,
+			"t.value":                        1,
+			"tab":                            1,
+			"tab.PCToLine":                   1,
+			"table":                          1,
+			"tableSize":                      1,
+			"tabs":                           2,
+			"tabwidth":                       4,
+			"tabwriter":                      1,
+			"terminateCell":                  1,
+			"text":                           2,
+			"text...":                        1,
+			"textw":                          3,
+			"the":                            2,
+			"this":                           11,
+			"to":                             1,
+			"toReplace":                      1,
+			"token":                          11,
+			"tool":                           1,
+			"trieNode":                       7,
+			"true":                           20,
+			"type":                           33,
+			"uint":                           5,
+			"uint64":                         4,
+			"unicode.IsSpace":                1,
+			"unitchecker.Main":               1,
+			"unix":                           1,
+			"unix.Eaccess":                   1,
+			"unix.X_OK":                      1,
+			"unknownHint":                    1,
+			"updateWidth":                    1,
+			"usage":                          13,
+			"usageMessage":                   2,
+			"useTabs":                        5,
+			"utf8.DecodeRune":                4,
+			"utf8.DecodeRuneInString":        3,
+			"utf8.FullRune":                  1,
+			"utf8.RuneCount":                 1,
+			"utf8.RuneError":                 3,
+			"utf8.RuneLen":                   1,
+			"utf8.RuneSelf":                  1,
+			"utf8.ValidRune":                 1,
+			"v":                              17,
+			"val":                            9,
+			"validDelim":                     3,
+			"validateLookPath":               1,
+			"value":                          3,
+			"var":                            32,
+			"vbar":                           2,
+			"verbose":                        5,
+			"vet.Suite...":                   1,
+			"w":                              29,
+			"w.CloseWithError":               1,
+			"w.Flush":                        1,
+			"w.WriteHeader":                  1,
+			"w.r.pipe.closeWrite":            1,
+			"w.r.pipe.write":                 1,
+			"werr":                           6,
+			"width":                          17,
+			"widths":                         1,
+			"with":                           2,
+			"wrCh":                           1,
+			"wrMu":                           1,
+			"write":                          1,
+			"write0":                         1,
+			"writeCloseError":                1,
+			"writeLines":                     1,
+			"writeN":                         1,
+			"writePadding":                   1,
+			"xorshift":                       1,
+			"{":                              467,
+			"|":                              3,
+			"||":                             28,
+			"}":                              464,
+		},
+		"Haskell": map[string]int{
+			"(":                22,
+			")":                21,
+			"+":                11,
+			"-":                10,
+			".":                2,
+			"..":               2,
+			"<":                1,
+			"<->":              1,
+			"Bool":             1,
+			"Data.Char":        1,
+			"Data.List":        1,
+			"Data.Map.Strict":  1,
+			"Data.Ord":         1,
+			"Down":             1,
+			"EQ":               1,
+			"Eq":               1,
+			"False":            1,
+			"Functor":          1,
+			"GT":               1,
+			"IO":               1,
+			"Int":              1,
+			"LT":               1,
+			"Leaf":             8,
+			"Main":             1,
+			"Map":              1,
+			"Map.Map":          1,
+			"Map.empty":        1,
+			"Map.insertWith":   1,
+			"Node":             9,
+			"Ord":              2,
+			"Show":             1,
+			"String":           2,
+			"Tree":             10,
+			"True":             1,
+			"[":                3,
+			"]":                3,
+			"_":                6,
+			"_.0001":           1,
+			"_0.0001":          1,
+			"_0001":            1,
+			"_000_000":         1,
+			"_0xffff":          1,
+			"_1":               1,
+			"_1000000":         1,
+			"_140_857e":        1,
+			"_23":              1,
+			"_485.332_89":      1,
+			"_592_653_589_793": 1,
+			"__000000":         1,
+			"__e":              1,
+			"_e":               1,
+			"_ff":              1,
+			"a":                13,
+			"as":               1,
+			"b":                1,
+			"b01_":             1,
+			"b01_0000__0000":   1,
+			"b_01":             1,
+			"b__01":            1,
+			"c":                3,
+			"case":             1,
+			"compare":          1,
+			"comparing":        1,
+			"contents":         1,
+			"data":             1,
+			"deriving":         1,
+			"do":               1,
+			"f":                4,
+			"fmap":             4,
+			"foldr":            1,
+			"import":           4,
+			"insert":           6,
+			"instance":         1,
+			"isAlpha":          2,
+			"l":                9,
+			"main":             2,
+			"map":              1,
+			"member":           6,
+			"module":           2,
+			"n":                1,
+			"normalise":        2,
+			"o":                1,
+			"o700_":            1,
+			"o7_77":            1,
+			"o_700":            1,
+			"o__700":           1,
+			"of":               1,
+			"otherwise":        2,
+			"putStrLn":         1,
+			"qualified":        1,
+			"r":                9,
+			"show":             1,
+			"sortBy":           1,
+			"t":                1,
+			"t@":               1,
+			"toList":           6,
+			"toLower":          2,
+			"w":                2,
+			"where":            4,
+			"wordFreq":         2,
+			"words":            1,
+			"x":                16,
+			"x__ffff":          1,
+			"x_ffff":           1,
+			"y":                7,
+			"|":                6,
+		},
+		"INI": map[string]int{
+			"*":                                  2,
+			"*.sh":                               1,
+			"*.txt":                              1,
+			"-":                                  29,
+			"./testdata/expired.json":            2,
+			"./testdata/nonexpire.json":          2,
+			"./testdata/verybad.json":            2,
+			".properties":                        1,
+			".tox":                               1,
+			"/.local/":                           1,
+			"//en.wikipedia.org/":                1,
+			"/Include":                           5,
+			"/Lib":                               4,
+			"/Lib/site":                          4,
+			"/Python":                            5,
+			"/Scripts":                           3,
+			"/bin":                               4,
+			"/boltons":                           1,
+			"/etc":                               1,
+			"/include":                           1,
+			"/include/python":                    6,
+			"/lib/python":                        18,
+			"/lib/python/site":                   2,
+			"/site":                              8,
+			"/tests":                             1,
+			"/usr/lib":                           1,
+			"/usr/share":                         1,
+			"/var":                               1,
+			"English":                            1,
+			"False":                              10,
+			"I":                                  2,
+			"Lib.test.libregrtest.main.*":        1,
+			"Lib.test.libregrtest.run_workers.*": 1,
+			"Lib/_pyrepl":                        1,
+			"Lib/test/libregrtest":               1,
+			"Makefile":                           2,
+			"PASS":                               1,
+			"Python":                             1,
+			"Test":                               1,
+			"The":                                1,
+			"This":                               3,
+			"True":                               8,
+			"[":                                  38,
+			"]":                                  38,
+			"_abc.*":                             2,
+			"_opcode.*":                          2,
+			"_overlapped.*":                      2,
+			"_testcapi.*":                        2,
+			"_testinternalcapi.*":                2,
+			"a":                                  3,
+			"abiflags":                           2,
+			"accessKey":                          3,
+			"also":                               1,
+			"and":                                2,
+			"arrow":                              1,
+			"as":                                 1,
+			"auto":                               1,
+			"aws_access_key_id":                  5,
+			"aws_secret_access_key":              5,
+			"aws_session_token":                  1,
+			"bar":                                1,
+			"base":                               28,
+			"be":                                 1,
+			"call":                               1,
+			"calls":                              1,
+			"can":                                1,
+			"canvheight":                         1,
+			"canvwidth":                          1,
+			"carriage":                           1,
+			"cat":                                6,
+			"changedir":                          1,
+			"charset":                            2,
+			"check_untyped_defs":                 2,
+			"code":                               2,
+			"commands":                           1,
+			"comments.":                          1,
+			"confdir":                            1,
+			"continues":                          1,
+			"credential_process":                 6,
+			"data":                               8,
+			"datadir":                            1,
+			"default":                            3,
+			"demo.":                              1,
+			"deps":                               1,
+			"disable_error_code":                 1,
+			"disallow_any_generics":              1,
+			"disallow_incomplete_defs":           1,
+			"disallow_untyped_calls":             2,
+			"disallow_untyped_defs":              2,
+			"distribution.name":                  1,
+			"doctest":                            1,
+			"does":                               1,
+			"duplicateKey":                       2,
+			"empty":                              1,
+			"enable_error_code":                  2,
+			"encodedHelloInJapanese":             1,
+			"end_of_line":                        2,
+			"envlist":                            1,
+			"envsitepackagesdir":                 1,
+			"even":                               1,
+			"exclamation":                        1,
+			"explicit_package_bases":             2,
+			"expr":                               1,
+			"false":                              3,
+			"files":                              3,
+			"fillcolor":                          1,
+			"first":                              1,
+			"foo":                                1,
+			"for":                                1,
+			"global":                             1,
+			"graphics":                           1,
+			"has":                                1,
+			"have":                               2,
+			"height":                             1,
+			"hello":                              4,
+			"helloInJapanese":                    1,
+			"https":                              1,
+			"ignore":                             2,
+			"ignore_missing_imports":             2,
+			"include":                            8,
+			"indent_size":                        6,
+			"indent_style":                       5,
+			"insert_final_newline":               5,
+			"into":                               2,
+			"is":                                 1,
+			"language":                           1,
+			"leading":                            1,
+			"lf":                                 2,
+			"libdir":                             1,
+			"line":                               1,
+			"lines":                              1,
+			"linux":                              2,
+			"local":                              1,
+			"mark":                               1,
+			"mode":                               1,
+			"modules":                            1,
+			"mypy":                               5,
+			"n":                                  1,
+			"newline":                            1,
+			"no_token":                           1,
+			"non_expire":                         2,
+			"not":                                1,
+			"notFromCredProcAccess":              2,
+			"notFromCredProcSecret":              2,
+			"not_alone":                          2,
+			"nt":                                 1,
+			"nt_user":                            1,
+			"nvm_alias":                          2,
+			"of":                                 1,
+			"off":                                5,
+			"os2":                                1,
+			"os2_home":                           1,
+			"osx_framework_user":                 1,
+			"packages":                           14,
+			"part":                               1,
+			"passed.":                            1,
+			"platbase":                           3,
+			"platform":                           2,
+			"platinclude":                        4,
+			"platlib":                            8,
+			"platstdlib":                         8,
+			"posargs":                            1,
+			"posix_home":                         1,
+			"posix_prefix":                       1,
+			"posix_user":                         1,
+			"pretty":                             2,
+			"profile":                            2,
+			"purelib":                            8,
+			"py310":                              1,
+			"py311":                              1,
+			"py312":                              1,
+			"py313":                              1,
+			"py37":                               1,
+			"py39":                               1,
+			"py_version_nodot":                   5,
+			"py_version_short":                   16,
+			"pypy3":                              1,
+			"pytest":                             1,
+			"python_version":                     2,
+			"r":                                  1,
+			"redundant":                          1,
+			"resizemode":                         1,
+			"result_code":                        1,
+			"result_output":                      1,
+			"results":                            1,
+			"return":                             2,
+			"root":                               2,
+			"rrequirements":                      1,
+			"scripts":                            7,
+			"second":                             1,
+			"secret":                             3,
+			"shape":                              1,
+			"space":                              2,
+			"space_redirects":                    1,
+			"standard":                           1,
+			"statedir":                           1,
+			"stdlib":                             8,
+			"strict":                             2,
+			"strict_optional":                    1,
+			"switch_case_indent":                 1,
+			"t.":                                 1,
+			"tab":                                3,
+			"tab_width":                          1,
+			"test.*":                             2,
+			"test.txt":                           1,
+			"test/**/.urchin*":                   1,
+			"test/fast/Listing":                  2,
+			"test/fast/Unit":                     1,
+			"test/fixtures/actual/alias/empty":   1,
+			"test/fixtures/nvmrc/**":             1,
+			"testenv":                            1,
+			"tests/mocks/**":                     1,
+			"the":                                1,
+			"three":                              1,
+			"threeLines":                         1,
+			"title":                              1,
+			"token":                              1,
+			"topic":                              1,
+			"towLines":                           1,
+			"tox":                                1,
+			"toxinidir":                          1,
+			"trailing":                           1,
+			"trim_trailing_whitespace":           1,
+			"true":                               6,
+			"turtle":                             1,
+			"u3053":                              1,
+			"u3061":                              1,
+			"u306b":                              1,
+			"u306f":                              1,
+			"u3093":                              1,
+			"unset":                              1,
+			"used":                               1,
+			"userbase":                           28,
+			"utf":                                2,
+			"value":                              2,
+			"value0":                             1,
+			"value1":                             1,
+			"valueWithEscapes":                   1,
+			"versions/Running":                   2,
+			"warn_return_any":                    1,
+			"website":                            1,
+			"whitespace":                         2,
+			"width":                              1,
+			"with_colon":                         1,
+			"without":                            2,
+			"{":                                  86,
+			"}":                                  86,
+		},
+		"Java": map[string]int{
+			"(":                          35,
+			")":                          34,
+			"+":                          4,
+			".class":                     1,
+			";":                          30,
+			"<":                          3,
+			"<String>":                   1,
+			"@Alias":                     3,
+			"@Override":                  1,
+			"@RecomputeFieldValue":       3,
+			"@TargetClass":               3,
+			"ADDRESS_FIELD_OFFSET":       1,
+			"Account":                    4,
+			"ArrayList":                  1,
+			"BigDecimal":                 6,
+			"BigDecimal.ZERO":            1,
+			"CLEANER_FIELD_OFFSET":       1,
+			"IllegalArgumentException":   1,
+			"InsufficientFundsException": 3,
+			"List":                       1,
+			"Main":                       2,
+			"Object":                     1,
+			"REF_ELEMENT_SHIFT":          1,
+			"RecomputeFieldValue.Kind.ArrayIndexShift": 1,
+			"RecomputeFieldValue.Kind.Fieldoffset":     2,
+			"String":                                   4,
+			"System.err.println":                       1,
+			"System.out.println":                       2,
+			"TargetCleanerJava6":                       1,
+			"TargetPlatformDependent0":                 1,
+			"TargetUnsafeRefArrayAccess":               1,
+			"[":                                        2,
+			"]":                                        2,
+			"account":                                  2,
+			"account.deposit":                          1,
+			"account.withdraw":                         1,
+			"amount":                                   7,
+			"amount.signum":                            1,
+			"args":                                     1,
+			"balance":                                  5,
+			"balance.add":                              1,
+			"balance.compareTo":                        1,
+			"balance.subtract":                         1,
+			"catch":                                    1,
+			"class":                                    5,
+			"className":                                2,
+			"com.example.bank":                         2,
+			"com.oracle.svm.core.annotate.Alias":       1,
+			"com.oracle.svm.core.annotate.RecomputeFieldValue": 1,
+			"com.oracle.svm.core.annotate.Targetclass":         1,
+			"declClass":     1,
+			"declClassName": 2,
+			"deposit":       1,
+			"e":             1,
+			"e.getMessage":  1,
+			"example":       1,
+			"final":         6,
+			"getBalance":    1,
+			"history":       1,
+			"history.add":   2,
+			"if":            2,
+			"import":        7,
+			"inside":        1,
+			"int":           1,
+			"io.netty.util.internal.shaded.org.jctools.util.UnsafeRefArrayAccess.class": 1,
+			"it.":                  1,
+			"java.math.BigDecimal": 2,
+			"java.util.ArrayList":  1,
+			"java.util.List":       1,
+			"kind":                 3,
+			"long":                 2,
+			"main":                 1,
+			"new":                  6,
+			"owner":                5,
+			"package":              3,
+			"private":              6,
+			"public":               10,
+			"rest":                 1,
+			"return":               2,
+			"static":               5,
+			"synchronized":         2,
+			"test":                 1,
+			"this.owner":           1,
+			"throw":                2,
+			"throws":               1,
+			"toString":             1,
+			"try":                  1,
+			"void":                 4,
+			"withdraw":             1,
+			"{":                    17,
+			"}":                    15,
+		},
+		"JavaScript": map[string]int{
+			"#define":                 3,
+			"&&":                      20,
+			"(":                       1212,
+			")":                       1208,
+			"*":                       4,
+			"+":                       210,
+			"-":                       66,
+			".":                       1,
+			"...":                     3,
+			"...a":                    1,
+			"...args":                 6,
+			"...pathSegments":         1,
+			"...this.argsExecutable":  1,
+			".args":                   2,
+			".catch":                  1,
+			".concat":                 2,
+			".cpus":                   2,
+			".fill":                   1,
+			".findPython":             1,
+			".forEach":                2,
+			".getTime":                1,
+			".join":                   3,
+			".js/":                    1,
+			".json":                   1,
+			".length":                 3,
+			".lib":                    1,
+			".map":                    2,
+			".path":                   6,
+			".promises":               3,
+			".replace":                2,
+			".set":                    2,
+			".sort":                   1,
+			".split":                  2,
+			".then":                   2,
+			".trim":                   2,
+			".usage":                  1,
+			".userInfo":               1,
+			".username":               1,
+			".version":                1,
+			"/":                       19,
+			"/#.":                     1,
+			"//":                      2,
+			"/_/g":                    1,
+			"/g":                      3,
+			"/io":                     1,
+			"/m":                      3,
+			"/win":                    2,
+			";":                       316,
+			"<":                       5,
+			"AppData":                 1,
+			"Array":                   2,
+			"BEGIN":                   1,
+			"Boolean":                 4,
+			"C":                       2,
+			"CERTIFICATE":             2,
+			"Connection":              1,
+			"DataView":                3,
+			"Date":                    1,
+			"Date.now":                1,
+			"Development":             1,
+			"END":                     1,
+			"Error":                   33,
+			"EventEmitter":            2,
+			"FAIL":                    2,
+			"Files":                   1,
+			"Found":                   1,
+			"Gyp":                     3,
+			"Infinity":                1,
+			"JSON.parse":              1,
+			"JSON.stringify":          2,
+			"Listening":               1,
+			"Local":                   1,
+			"Map":                     2,
+			"Math.floor":              1,
+			"Math.min":                1,
+			"Missing":                 1,
+			"NODE_MAJOR_VERSION":      1,
+			"NODE_MINOR_VERSION":      1,
+			"NODE_PATCH_VERSION":      1,
+			"NaN":                     1,
+			"O_APPEND":                1,
+			"O_CREAT":                 1,
+			"O_DIRECTORY":             1,
+			"O_EXCL":                  1,
+			"O_RDWR":                  1,
+			"O_TRUNC":                 1,
+			"O_WRONLY":                1,
+			"Object.assign":           1,
+			"Object.defineProperty":   1,
+			"Object.keys":             2,
+			"PATH":                    3,
+			"Program":                 1,
+			"Programs":                2,
+			"Promise":                 4,
+			"Promise.all":             1,
+			"Python":                  14,
+			"PythonFinder":            3,
+			"REG_":                    1,
+			"Reflect.apply":           2,
+			"Reflect.construct":       1,
+			"Reflect.deleteProperty":  1,
+			"Reflect.get":             3,
+			"Reflect.set":             2,
+			"RegExp":                  1,
+			"Resource":                1,
+			"S":                       1,
+			"S.*":                     1,
+			"SKIP":                    4,
+			"ShaSum":                  3,
+			"String":                  17,
+			"String.raw":              1,
+			"TextDecoder":             1,
+			"TextEncoder":             1,
+			"Transform":               2,
+			"Uint8Array":              5,
+			"Uint8ClampedArray":       2,
+			"Use":                     1,
+			"W/g":                     1,
+			"WebAssembly.Instance":    1,
+			"[":                       90,
+			"]":                       90,
+			"_":                       3,
+			"_000":                    1,
+			"__dirname":               6,
+			"_flush":                  1,
+			"_gotest":                 1,
+			"_makeFuncWrapper":        1,
+			"_resume":                 1,
+			"_transform":              1,
+			"a":                       10,
+			"aboutme.html":            1,
+			"acc":                     3,
+			"add":                     1,
+			"addLog":                  1,
+			"addOpts":                 4,
+			"added":                   1,
+			"addonGypi":               2,
+			"addr":                    27,
+			"alert":                   1,
+			"aliases":                 1,
+			"already":                 1,
+			"an":                      2,
+			"and":                     1,
+			"app":                     2,
+			"app.get":                 1,
+			"app.listen":              1,
+			"app.post":                1,
+			"app.use":                 2,
+			"arch":                    15,
+			"arch.toLowerCase":        1,
+			"archLower":               4,
+			"arg":                     13,
+			"argc":                    2,
+			"args":                    20,
+			"args.map":                1,
+			"argsExecutable":          1,
+			"argsVersion":             1,
+			"arguments":               1,
+			"argv":                    23,
+			"argv.indexOf":            4,
+			"argv.length":             1,
+			"argv.map":                1,
+			"argv.push":               31,
+			"argv.shift":              1,
+			"argv.slice":              1,
+			"argv.some":               1,
+			"argv.splice":             2,
+			"argv.unshift":            3,
+			"argvPtrs":                1,
+			"argvPtrs.forEach":        1,
+			"argvPtrs.push":           4,
+			"arm64":                   2,
+			"array":                   4,
+			"async":                   33,
+			"at":                      1,
+			"atime":                   1,
+			"availVersion":            3,
+			"await":                   63,
+			"b":                       4,
+			"backOff":                 2,
+			"base":                    3,
+			"baseUrl":                 15,
+			"be":                      2,
+			"before":                  10,
+			"bitsre":                  2,
+			"bitsre.test":             1,
+			"bitsreV3":                2,
+			"bitsreV3.test":           1,
+			"break":                   4,
+			"buf":                     4,
+			"buf.length":              2,
+			"buffer":                  1,
+			"build":                   2,
+			"buildBinsDir":            7,
+			"buildDir":                8,
+			"buildType":               8,
+			"button":                  1,
+			"button.addEventListener": 1,
+			"bytes":                   2,
+			"bytes.length":            2,
+			"c":                       3,
+			"ca":                      1,
+			"ca.match":                1,
+			"cafile":                  4,
+			"callExport":              1,
+			"callback":                55,
+			"canGetHeaders":           3,
+			"candidate":               5,
+			"candidates":              8,
+			"candidates.length":       1,
+			"candidates.toString":     1,
+			"case":                    4,
+			"catch":                   32,
+			"chdir":                   1,
+			"check":                   8,
+			"check.before":            1,
+			"check.check":             1,
+			"checkCommand":            1,
+			"checkExecPath":           1,
+			"checkPyLauncher":         1,
+			"checking":                1,
+			"checks":                  2,
+			"checks.push":             3,
+			"checksum":                7,
+			"child":                   1,
+			"child.stdin.end":         1,
+			"childProcess":            1,
+			"childProcess.spawn":      1,
+			"chmod":                   1,
+			"chown":                   1,
+			"chunk":                   3,
+			"class":                   4,
+			"clean":                   2,
+			"clearTimeout":            1,
+			"close":                   1,
+			"closeSync":               2,
+			"code":                    15,
+			"command":                 16,
+			"commands":                4,
+			"commands.length":         4,
+			"commands.map":            1,
+			"commands.push":           1,
+			"commands.reduce":         1,
+			"commonGypi":              4,
+			"config":                  5,
+			"config.target_defaults.default_configuration": 1,
+			"config.variables.msbuild_path":                2,
+			"config.variables.nodedir":                     1,
+			"config.variables.python":                      1,
+			"config.variables.target_arch":                 1,
+			"configDefs":                                   1,
+			"configNames":                                  1,
+			"configNames.shift":                            1,
+			"configPath":                                   4,
+			"configPython":                                 2,
+			"configs":                                      1,
+			"configs.forEach":                              1,
+			"configs.push":                                 2,
+			"configure":                                    2,
+			"console.error":                                1,
+			"console.log":                                  3,
+			"console.warn":                                 2,
+			"const":                                        252,
+			"constants":                                    1,
+			"constructor":                                  4,
+			"contentShasums":                               8,
+			"continue":                                     3,
+			"continuing":                                   1,
+			"copy":                                         1,
+			"copyDirectory":                                3,
+			"could":                                        1,
+			"cp":                                           4,
+			"cp.execFile":                                  1,
+			"cp.on":                                        1,
+			"createBuildDir":                               2,
+			"createConfigFile":                             2,
+			"createConfigGypi":                             2,
+			"createWriteStream":                            2,
+			"created":                                      4,
+			"crypto":                                       1,
+			"crypto.createHash":                            1,
+			"crypto.getRandomValues":                       1,
+			"cwd":                                          3,
+			"d":                                            3,
+			"data":                                         2,
+			"data.replace":                                 1,
+			"debug":                                        2,
+			"decoder":                                      1,
+			"decoder.decode":                               2,
+			"defaultRelease":                               4,
+			"defaultRelease.headersUrl":                    3,
+			"defaultRelease.libUrl":                        3,
+			"defaultRelease.name.replace":                  1,
+			"defaultUrl":                                   4,
+			"defaultUrl.replace":                           1,
+			"defaultVersion":                               3,
+			"delete":                                       5,
+			"dest":                                         5,
+			"devDir":                                       13,
+			"devdir":                                       1,
+			"dir":                                          6,
+			"directory":                                    2,
+			"distBaseUrl":                                  8,
+			"doBuild":                                      3,
+			"doWhich":                                      3,
+			"document.addEventListener":                    1,
+			"document.createElement":                       1,
+			"document.getElementById":                      1,
+			"document.querySelector":                       1,
+			"does":                                         1,
+			"done":                                         1,
+			"download":                                     6,
+			"downloadNodeLib":                              2,
+			"downloadShasums":                              2,
+			"downloading":                                  3,
+			"dst":                                          4,
+			"dst.length":                                   2,
+			"dst.set":                                      2,
+			"e":                                            2,
+			"e.message":                                    1,
+			"eaccesFallback":                               4,
+			"else":                                         28,
+			"encoder":                                      1,
+			"encoder.encode":                               2,
+			"encoding":                                     2,
+			"enosys":                                       28,
+			"ensure":                                       1,
+			"entries":                                      2,
+			"entry":                                        1,
+			"entry.isDirectory":                            1,
+			"entry.isFile":                                 1,
+			"entry.name":                                   5,
+			"enumerable":                                   1,
+			"env":                                          3,
+			"env.TERM":                                     1,
+			"err":                                          68,
+			"err.code":                                     13,
+			"err.stack":                                    5,
+			"error":                                        2,
+			"errorLog":                                     2,
+			"event":                                        3,
+			"event.preventDefault":                         1,
+			"event.result":                                 1,
+			"exec":                                         6,
+			"execFile":                                     6,
+			"execPath":                                     11,
+			"executable":                                   4,
+			"executing":                                    3,
+			"expectShasums":                                6,
+			"express":                                      2,
+			"express.json":                                 1,
+			"express.static":                               1,
+			"ext":                                          4,
+			"extends":                                      2,
+			"extname":                                      3,
+			"extractCount":                                 3,
+			"extractErrors":                                3,
+			"f":                                            4,
+			"fail":                                         1,
+			"false":                                        10,
+			"fchmod":                                       1,
+			"fchown":                                       1,
+			"fd":                                           15,
+			"fetch":                                        3,
+			"file":                                         7,
+			"filePath":                                     1,
+			"filename":                                     5,
+			"files":                                        2,
+			"files.length":                                 1,
+			"filter":                                       2,
+			"finally":                                      1,
+			"findAccessibleSync":                           6,
+			"findConfigs":                                  3,
+			"findNodeDirectory":                            2,
+			"findPython":                                   4,
+			"findSolutionFile":                             2,
+			"findVisualStudio":                             2,
+			"flags":                                        1,
+			"for":                                          11,
+			"force":                                        2,
+			"format":                                       1,
+			"found":                                        1,
+			"foundLocalAppData":                            2,
+			"from":                                         1,
+			"fs":                                           6,
+			"fs.copyFile":                                  1,
+			"fs.mkdir":                                     5,
+			"fs.mkdtemp":                                   1,
+			"fs.readFile":                                  3,
+			"fs.readdir":                                   1,
+			"fs.rm":                                        4,
+			"fs.stat":                                      7,
+			"fs.symlink":                                   1,
+			"fs.unlink":                                    1,
+			"fs.writeFile":                                 1,
+			"fs.writeSync":                                 1,
+			"fstat":                                        1,
+			"fsync":                                        1,
+			"ftruncate":                                    1,
+			"fullPath":                                     4,
+			"function":                                     45,
+			"get":                                          4,
+			"getInt64":                                     12,
+			"getNodeDir":                                   2,
+			"getOsUserInfo":                                2,
+			"getegid":                                      1,
+			"geteuid":                                      1,
+			"getgid":                                       1,
+			"getgroups":                                    1,
+			"getuid":                                       1,
+			"gid":                                          3,
+			"glob":                                         2,
+			"globalThis":                                   2,
+			"globalThis.Go":                                1,
+			"globalThis.TextDecoder":                       1,
+			"globalThis.TextEncoder":                       1,
+			"globalThis.crypto":                            1,
+			"globalThis.fs":                                2,
+			"globalThis.path":                              2,
+			"globalThis.performance":                       1,
+			"globalThis.process":                           2,
+			"go":                                           6,
+			"go._pendingEvent":                             1,
+			"go._resume":                                   1,
+			"gojs":                                         1,
+			"guessedSolution":                              4,
+			"gyp":                                          14,
+			"gyp.commands.install":                         2,
+			"gyp.commands.remove":                          1,
+			"gyp.devDir":                                   6,
+			"gyp.opts":                                     3,
+			"gyp.opts.arch":                                1,
+			"gyp.opts.cafile":                              1,
+			"gyp.opts.debug":                               1,
+			"gyp.opts.disturl":                             1,
+			"gyp.opts.ensure":                              3,
+			"gyp.opts.jobs":                                1,
+			"gyp.opts.make":                                1,
+			"gyp.opts.node_engine":                         1,
+			"gyp.opts.nodedir":                             7,
+			"gyp.opts.nodedir.replace":                     1,
+			"gyp.opts.noproxy":                             1,
+			"gyp.opts.proxy":                               1,
+			"gyp.opts.python":                              1,
+			"gyp.opts.solution":                            1,
+			"gyp.opts.tarball":                             2,
+			"gyp.opts.target":                              2,
+			"gyp.opts.target_arch":                         1,
+			"gyp.package.installVersion":                   3,
+			"gyp.spawn":                                    2,
+			"gyp.todo.push":                                1,
+			"gyp.version":                                  1,
+			"gypScript":                                    2,
+			"hasLibUrl":                                    2,
+			"hasSln":                                       2,
+			"have":                                         1,
+			"headers":                                      1,
+			"headersTarballRange":                          2,
+			"help":                                         1,
+			"high":                                         2,
+			"i":                                            9,
+			"ia32":                                         2,
+			"id":                                           29,
+			"if":                                           149,
+			"in":                                           7,
+			"info":                                         2,
+			"install":                                      3,
+			"installVersion":                               4,
+			"installVersionFile":                           2,
+			"installVersionPath":                           2,
+			"installed":                                    1,
+			"instance":                                     3,
+			"instanceof":                                   6,
+			"is":                                           8,
+			"isDefaultVersion":                             2,
+			"isNaN":                                        4,
+			"isNamedForLegacyIojs":                         3,
+			"isNew":                                        2,
+			"isValid":                                      6,
+			"item":                                         1,
+			"item.title":                                   1,
+			"items":                                        4,
+			"items.forEach":                                1,
+			"items.length":                                 1,
+			"j":                                            10,
+			"jobs":                                         6,
+			"jobs.toUpperCase":                             2,
+			"k":                                            9,
+			"key":                                          7,
+			"keys":                                         3,
+			"keys.forEach":                                 1,
+			"lchown":                                       1,
+			"len":                                          7,
+			"length":                                       5,
+			"let":                                          54,
+			"li":                                           2,
+			"li.textContent":                               1,
+			"libPath":                                      6,
+			"libUrl":                                       6,
+			"libUrl32":                                     5,
+			"libUrl64":                                     5,
+			"libUrlArm64":                                  5,
+			"line":                                         1,
+			"line.trim":                                    1,
+			"link":                                         3,
+			"list":                                         1,
+			"list.appendChild":                             1,
+			"list.innerHTML":                               1,
+			"loadConfigGypi":                               2,
+			"loadSlice":                                    5,
+			"loadSliceOfValues":                            4,
+			"loadString":                                   6,
+			"loadValue":                                    19,
+			"localAppData":                                 3,
+			"location":                                     3,
+			"log":                                          11,
+			"log.error":                                    3,
+			"log.http":                                     2,
+			"log.info":                                     2,
+			"log.logger.isVisible":                         1,
+			"log.logger.level":                             2,
+			"log.resume":                                   1,
+			"log.silly":                                    9,
+			"log.stdout":                                   1,
+			"log.verbose":                                  55,
+			"log.warn":                                     4,
+			"log.withPrefix":                               1,
+			"loglevel":                                     1,
+			"logprefix":                                    12,
+			"low":                                          2,
+			"ls":                                           1,
+			"lstat":                                        1,
+			"m":                                            2,
+			"major":                                        2,
+			"majorMinor":                                   9,
+			"majorRe":                                      2,
+			"make":                                         1,
+			"makeCommand":                                  2,
+			"message":                                      5,
+			"minor":                                        2,
+			"minorRe":                                      2,
+			"mkdir":                                        1,
+			"mode":                                         3,
+			"module.exports":                               11,
+			"module.exports.Gyp":                           1,
+			"module.exports.usage":                         5,
+			"msec":                                         3,
+			"msg":                                          7,
+			"msgFormat":                                    4,
+			"mtime":                                        1,
+			"n":                                            9,
+			"n/":                                           1,
+			"n2":                                           1,
+			"name":                                         37,
+			"name.includes":                                1,
+			"name.indexOf":                                 1,
+			"name.replace":                                 1,
+			"name.substring":                               1,
+			"nanHead":                                      3,
+			"new":                                          57,
+			"next":                                         4,
+			"nl":                                           4,
+			"noProxy":                                      1,
+			"node":                                         2,
+			"nodeDir":                                      12,
+			"nodeExpFile":                                  5,
+			"nodeGypDir":                                   2,
+			"nodeLibFile":                                  3,
+			"nodeLibFile.replace":                          1,
+			"nodeLibPath":                                  2,
+			"nodeRootDir":                                  7,
+			"nodeVersionH":                                 1,
+			"nodeVersionH.match":                           3,
+			"nodedir":                                      1,
+			"noproxy":                                      1,
+			"nopt":                                         2,
+			"noretry":                                      3,
+			"normalizePath":                                4,
+			"not":                                          5,
+			"npm":                                          1,
+			"npmConfigPrefix":                              3,
+			"npmConfigPrefix.length":                       1,
+			"null":                                         12,
+			"num1":                                         1,
+			"num2":                                         1,
+			"of":                                           5,
+			"offset":                                       15,
+			"on":                                           1,
+			"onwarn":                                       3,
+			"open":                                         1,
+			"openSync":                                     2,
+			"opts":                                         7,
+			"opts.silent":                                  1,
+			"opts.stdio":                                   2,
+			"or":                                           2,
+			"os":                                           2,
+			"os.homedir":                                   1,
+			"os.tmpdir":                                    2,
+			"outRe":                                        1,
+			"outRe.exec":                                   1,
+			"outReValue":                                   2,
+			"outputBuf":                                    3,
+			"outputBuf.lastIndexOf":                        1,
+			"outputBuf.substring":                          2,
+			"outputDir":                                    3,
+			"overrideDistUrl":                              7,
+			"overrideDistUrl.replace":                      1,
+			"p":                                            6,
+			"package":                                      1,
+			"parseArgv":                                    1,
+			"parseInt":                                     6,
+			"patch":                                        2,
+			"patchRe":                                      2,
+			"path":                                         31,
+			"path.basename":                                1,
+			"path.dirname":                                 1,
+			"path.extname":                                 2,
+			"path.join":                                    12,
+			"path.normalize":                               1,
+			"path.relative":                                3,
+			"path.resolve":                                 20,
+			"pathExample":                                  1,
+			"pathSegments.join":                            1,
+			"performance.now":                              2,
+			"perm":                                         1,
+			"pid":                                          1,
+			"pipeline":                                     3,
+			"platformMake":                                 5,
+			"port":                                         3,
+			"position":                                     3,
+			"ppid":                                         1,
+			"prefix":                                       3,
+			"proc":                                         1,
+			"proc.on":                                      1,
+			"process.arch":                                 1,
+			"process.config.variables.node_prefix":         1,
+			"process.config.variables.use_prefix_to_find_headers": 1,
+			"process.cwd":                        2,
+			"process.env":                        4,
+			"process.env.GYP_MSVS_OVERRIDE_PATH": 1,
+			"process.env.GYP_MSVS_VERSION":       1,
+			"process.env.JOBS":                   1,
+			"process.env.LOCALAPPDATA":           2,
+			"process.env.MAKE":                   1,
+			"process.env.NODEJS_ORG_MIRROR":      2,
+			"process.env.PATH":                   2,
+			"process.env.PORT":                   1,
+			"process.env.PYTHON":                 2,
+			"process.env.PYTHONPATH":             3,
+			"process.env.ProgramFiles":           1,
+			"process.env.ProgramW6432":           1,
+			"process.env.SystemDrive":            1,
+			"process.env.SystemRoot":             1,
+			"process.env.USER":                   1,
+			"process.env.USERNAME":               1,
+			"process.env.ZOSLIB_INCLUDES":        2,
+			"process.jsEngine":                   1,
+			"process.platform":                   17,
+			"process.platform.indexOf":           1,
+			"process.release":                    2,
+			"process.version":                    5,
+			"process.versions.node":              1,
+			"processRelease":                     6,
+			"produced":                           2,
+			"profile":                            1,
+			"programFiles":                       6,
+			"programFilesX86":                    3,
+			"promises":                           4,
+			"proxy":                              2,
+			"ptr":                                4,
+			"pyLauncher":                         1,
+			"pypath":                             1,
+			"pypath.join":                        1,
+			"pypath.push":                        1,
+			"python":                             11,
+			"python.exe":                         8,
+			"range":                              1,
+			"range.test":                         1,
+			"re":                                 2,
+			"read":                               1,
+			"readCAFile":                         3,
+			"readFileSync":                       2,
+			"readdir":                            1,
+			"readlink":                           1,
+			"recursive":                          9,
+			"reg":                                3,
+			"regArgs":                            3,
+			"regGetValue":                        3,
+			"regSearchKeys":                      2,
+			"reject":                             5,
+			"release":                            4,
+			"release.name":                       4,
+			"release.semver":                     3,
+			"release.semver.prerelease":          1,
+			"release.shasumsUrl":                 2,
+			"release.tarballUrl":                 3,
+			"release.version":                    13,
+			"release.version.split":              1,
+			"release.versionDir":                 5,
+			"remove":                             2,
+			"rename":                             1,
+			"req":                                2,
+			"req.body.title":                     1,
+			"requestOpts":                        2,
+			"requestOpts.ca":                     1,
+			"require":                            60,
+			"res":                                7,
+			"res.body":                           2,
+			"res.json":                           1,
+			"res.status":                         8,
+			"res.text":                           1,
+			"res.url":                            1,
+			"resolve":                            9,
+			"resolveLibUrl":                      7,
+			"resource":                           1,
+			"response":                           2,
+			"response.json":                      1,
+			"result":                             12,
+			"return":                             88,
+			"rm":                                 1,
+			"rmdir":                              1,
+			"rollback":                           5,
+			"run":                                3,
+			"runGyp":                             2,
+			"s":                                  5,
+			"saddr":                              2,
+			"semver":                             5,
+			"semver.Range":                       1,
+			"semver.lt":                          1,
+			"semver.parse":                       3,
+			"semver.satisfies":                   1,
+			"semver.valid":                       1,
+			"semverRange":                        1,
+			"set":                                1,
+			"setInt32":                           1,
+			"setInt64":                           7,
+			"setTimeout":                         1,
+			"shasumsUrl":                         1,
+			"shell":                              5,
+			"shorthands":                         1,
+			"should":                             1,
+			"shouldDownloadTarball":              3,
+			"signal":                             3,
+			"silent":                             1,
+			"silly":                              1,
+			"solution":                           1,
+			"source":                             1,
+			"sp":                                 122,
+			"spawn":                              1,
+			"src":                                10,
+			"src.subarray":                       2,
+			"stat":                               1,
+			"static":                             1,
+			"status":                             2,
+			"stderr":                             5,
+			"stderr.trim":                        1,
+			"stdout":                             5,
+			"stdout.trim":                        1,
+			"storeValue":                         11,
+			"str":                                6,
+			"str.length":                         1,
+			"strPtr":                             3,
+			"strip":                              2,
+			"succeed":                            1,
+			"sum":                                2,
+			"super":                              2,
+			"switch":                             2,
+			"symlink":                            2,
+			"symlinkDestination":                 3,
+			"systemDrive":                        3,
+			"tar":                                1,
+			"tar.extract":                        2,
+			"tarExtractDir":                      9,
+			"tarPath":                            4,
+			"tarball":                            1,
+			"tarballUrl":                         4,
+			"target":                             2,
+			"targetLibPath":                      3,
+			"testCallExport":                     2,
+			"the":                                1,
+			"thin":                               1,
+			"this":                               7,
+			"this._callback":                     2,
+			"this._digester":                     1,
+			"this._digester.digest":              1,
+			"this._digester.update":              1,
+			"this._exitPromise":                  2,
+			"this._goRefCounts":                  6,
+			"this._idPool":                       2,
+			"this._idPool.pop":                   1,
+			"this._idPool.push":                  1,
+			"this._ids":                          2,
+			"this._ids.delete":                   1,
+			"this._ids.get":                      1,
+			"this._ids.set":                      1,
+			"this._inst":                         2,
+			"this._inst.exports.getsp":           7,
+			"this._inst.exports.mem.buffer":      5,
+			"this._inst.exports.resume":          1,
+			"this._inst.exports.run":             1,
+			"this._inst.exports.testExport":      1,
+			"this._inst.exports.testExport0":     1,
+			"this._nextCallbackTimeoutID":        3,
+			"this._pendingEvent":                 1,
+			"this._resolveExitPromise":           3,
+			"this._resume":                       2,
+			"this._scheduledTimeouts":            1,
+			"this._scheduledTimeouts.delete":     1,
+			"this._scheduledTimeouts.get":        1,
+			"this._scheduledTimeouts.has":        1,
+			"this._scheduledTimeouts.set":        1,
+			"this._values":                       6,
+			"this._values.length":                2,
+			"this.addLog":                        22,
+			"this.aliases":                       2,
+			"this.argsExecutable":                1,
+			"this.argsVersion":                   1,
+			"this.argv":                          2,
+			"this.argv.forEach":                  1,
+			"this.argv.length":                   1,
+			"this.argv.map":                      1,
+			"this.checkCommand":                  5,
+			"this.checkExecPath":                 3,
+			"this.checkPyLauncher":               1,
+			"this.commands":                      2,
+			"this.configDefs":                    1,
+			"this.configPython":                  3,
+			"this.devDir":                        1,
+			"this.env":                           4,
+			"this.env.NODE_GYP_FORCE_PYTHON":     2,
+			"this.env.PYTHON":                    2,
+			"this.errorLog":                      1,
+			"this.errorLog.join":                 1,
+			"this.errorLog.push":                 1,
+			"this.execFile":                      1,
+			"this.exit":                          2,
+			"this.exited":                        5,
+			"this.fail":                          2,
+			"this.importObject":                  1,
+			"this.log.error":                     1,
+			"this.log.info":                      1,
+			"this.log.silly":                     9,
+			"this.log.verbose":                   4,
+			"this.mem":                           2,
+			"this.mem.buffer":                    1,
+			"this.mem.getFloat64":                1,
+			"this.mem.getInt32":                  4,
+			"this.mem.getUint32":                 3,
+			"this.mem.setFloat64":                2,
+			"this.mem.setInt32":                  2,
+			"this.mem.setUint32":                 9,
+			"this.mem.setUint8":                  11,
+			"this.opts":                          2,
+			"this.opts.argv.remain.slice":        1,
+			"this.opts.loglevel":                 2,
+			"this.package.version":               1,
+			"this.pyLauncher":                    1,
+			"this.run":                           3,
+			"this.semverRange":                   2,
+			"this.shorthands":                    1,
+			"this.succeed":                       1,
+			"this.todo":                          1,
+			"this.version":                       1,
+			"this.win":                           4,
+			"this.winDefaultLocations":           1,
+			"this.winDefaultLocations.length":    1,
+			"this.writeSync":                     1,
+			"throw":                              51,
+			"timeOrigin":                         2,
+			"title":                              1,
+			"tmpdir":                             3,
+			"to":                                 6,
+			"toCheck":                            2,
+			"toCopy":                             4,
+			"toCopy.length":                      2,
+			"todo":                               3,
+			"todos":                              2,
+			"todos.length":                       1,
+			"todos.push":                         1,
+			"true":                               39,
+			"truncate":                           1,
+			"try":                                33,
+			"typeFlag":                           6,
+			"typeof":                             2,
+			"uid":                                3,
+			"umask":                              1,
+			"undefined":                          10,
+			"unlink":                             1,
+			"unsupported":                        1,
+			"url":                                4,
+			"url.parse":                          6,
+			"url.resolve":                        6,
+			"usage":                              1,
+			"userString":                         3,
+			"username":                           3,
+			"using":                              2,
+			"utimes":                             1,
+			"v":                                  26,
+			"val":                                3,
+			"valid":                              6,
+			"value":                              6,
+			"value.replace":                      1,
+			"var":                                3,
+			"ver":                                2,
+			"verbose":                            4,
+			"version":                            31,
+			"versionDir":                         1,
+			"versionMajor":                       4,
+			"versionPath":                        3,
+			"versionSemver":                      6,
+			"versionSemver.major":                8,
+			"versionSemver.version":              2,
+			"vsInfo":                             4,
+			"vsInfo.path":                        1,
+			"vsInfo.versionYear":                 1,
+			"w":                                  1,
+			"wasmMinDataAddr":                    2,
+			"which":                              2,
+			"while":                              1,
+			"win":                                30,
+			"winDefaultLocations":                1,
+			"winDefaultLocationsArray":           2,
+			"winDefaultLocationsArray.push":      2,
+			"with":                               1,
+			"withFileTypes":                      1,
+			"write":                              1,
+			"writeSync":                          1,
+			"x64":                                3,
+			"x86":                                3,
+			"zoslibIncDir":                       5,
+			"zoslibIncPath":                      9,
+			"{":                                  524,
+			"|":                                  5,
+			"||":                                 46,
+			"}":                                  525,
+		},
+		"Lua": map[string]int{
+			"#f":                 1,
+			"#msgs":              3,
+			"%":                  24,
+			"(":                  2365,
+			")":                  2364,
+			"*1":                 1,
+			"*2":                 1,
+			"*4":                 2,
+			"*Y":                 1,
+			"*h":                 1,
+			"+":                  150,
+			"-":                  183,
+			".":                  1,
+			"..":                 28,
+			"...":                24,
+			"..a":                1,
+			"..arg":              1,
+			"..c..d..":           2,
+			"..f":                7,
+			"..i":                1,
+			"..init":             1,
+			"..j..":              3,
+			"..string.rep":       1,
+			".__gc":              3,
+			".__index":           3,
+			".__mode":            1,
+			".__newindex":        2,
+			".alo":               1,
+			".currentline":       10,
+			".func":              2,
+			".get":               6,
+			".linedefined":       2,
+			".name":              1,
+			".set":               4,
+			".short_src":         9,
+			".source":            1,
+			".what":              5,
+			".x":                 3,
+			"/2":                 1,
+			"/4":                 1,
+			"/a":                 1,
+			";":                  270,
+			"<":                  51,
+			"<1>":                1,
+			"<2));>":             1,
+			"<2]>":               1,
+			"<3>":                1,
+			"<4>":                2,
+			"<=3+3,>":            1,
+			"<=>":                7,
+			"<=Op('a')))>":       1,
+			"<b>":                1,
+			"A":                  36,
+			"A..A..A..A":         1,
+			"A=":                 1,
+			"AA":                 12,
+			"AAAA":               3,
+			"Arr":                6,
+			"B":                  17,
+			"B.g":                4,
+			"B/A":                1,
+			"C":                  8,
+			"DIR":                3,
+			"DIR..":              1,
+			"DIR..n":             2,
+			"F":                  13,
+			"G":                  3,
+			"I":                  1,
+			"ID":                 1,
+			"Inventory":          8,
+			"Inventory.__index":  1,
+			"Inventory.new":      2,
+			"K":                  4,
+			"L":                  6,
+			"Lim":                5,
+			"M":                  6,
+			"Message":            5,
+			"NAME":               3,
+			"NX":                 4,
+			"Op":                 47,
+			"P1":                 5,
+			"P1.AA":              1,
+			"P1._G":              1,
+			"P1._PACKAGE":        2,
+			"P1.xuxu":            4,
+			"P1.xuxu._G":         1,
+			"P1.xuxu._PACKAGE":   1,
+			"REQUIRED":           2,
+			"SETLINEW":           1,
+			"SHEBANG#!lua":       1,
+			"Set":                22,
+			"T":                  7,
+			"T.checkmemory":      2,
+			"T.closestate":       1,
+			"T.d2s":              1,
+			"T.doonnewstack":     1,
+			"T.getref":           4,
+			"T.gsub":             5,
+			"T.hash":             1,
+			"T.listcode":         3,
+			"T.newstate":         1,
+			"T.newuserdata":      4,
+			"T.querytab":         6,
+			"T.ref":              6,
+			"T.resume":           2,
+			"T.s2d":              1,
+			"T.setyhook":         2,
+			"T.testC":            56,
+			"T.totalmem":         5,
+			"T.udataval":         2,
+			"T.unref":            5,
+			"T.upvalue":          6,
+			"U0":                 1,
+			"U1":                 5,
+			"U2":                 5,
+			"U3":                 1,
+			"U4":                 1,
+			"WX1":                3,
+			"WX2":                3,
+			"X":                  19,
+			"X._G":               1,
+			"X._M":               1,
+			"X._NAME":            1,
+			"X._PACKAGE":         1,
+			"X.a":                2,
+			"X.a._G":             1,
+			"X.a._M":             2,
+			"X.a._NAME":          2,
+			"X.a.b":              1,
+			"X.a.b._G":           1,
+			"X.a.b._M":           1,
+			"X.a.b._NAME":        1,
+			"X.a.b._PACKAGE":     1,
+			"X.a.b.c":            1,
+			"X.a.b.c._G":         1,
+			"X.a.b.c._M":         1,
+			"X.a.b.c._NAME":      1,
+			"X.a.b.c._PACKAGE":   1,
+			"X.a.b.c.x":          1,
+			"X.a.b.x":            2,
+			"X.a.x":              1,
+			"X.arg.n":            1,
+			"X.b":                1,
+			"X.c":                1,
+			"X.self":             1,
+			"X.x":                1,
+			"XX":                 1,
+			"Y":                  4,
+			"[":                  441,
+			"]":                  441,
+			"_":                  11,
+			"_G":                 26,
+			"_G.X":               2,
+			"_G.a":               2,
+			"_G.f":               3,
+			"_G.x":               10,
+			"_M":                 2,
+			"_M.x":               2,
+			"_NAME":              2,
+			"_PACKAGE..":         1,
+			"_X":                 2,
+			"__call":             1,
+			"__gc=":              3,
+			"__index":            5,
+			"__lt":               1,
+			"__metatable":        1,
+			"__mode":             2,
+			"__newindex":         1,
+			"__tostring":         1,
+			"_g":                 2,
+			"a":                  681,
+			"a*a":                1,
+			"a..b":               2,
+			"a..b..c..d":         1,
+			"a..string.format":   1,
+			"a..v":               1,
+			"a.___Glob":          1,
+			"a.a":                3,
+			"a.b":                1,
+			"a.b.c":              2,
+			"a.b.c.f1":           2,
+			"a.b.c.k":            1,
+			"a.n299":             1,
+			"a.name":             4,
+			"a.namewhat":         3,
+			"a.parent":           1,
+			"a.short_src":        1,
+			"a.t":                2,
+			"a.tostring":         1,
+			"a.val":              1,
+			"a.what":             1,
+			"a.x":                15,
+			"a.y":                7,
+			"a.z":                1,
+			"a/0":                1,
+			"a1":                 3,
+			"a24":                1,
+			"a240":               1,
+			"a241":               1,
+			"a242":               1,
+			"a243":               1,
+			"a244":               1,
+			"a245":               1,
+			"a246":               1,
+			"a247":               1,
+			"a248":               1,
+			"a249":               1,
+			"a3":                 4,
+			"a310":               1,
+			"a329":               1,
+			"a376":               1,
+			"a4":                 3,
+			"a66":                1,
+			"a=":                 4,
+			"aaa":                3,
+			"add":                7,
+			"all":                3,
+			"and":                392,
+			"arg":                9,
+			"arg.n":              3,
+			"asize":              7,
+			"assert":             667,
+			"aux":                3,
+			"b":                  289,
+			"b*2":                1,
+			"b.activelines":      4,
+			"b.func":             1,
+			"b.lastlinedefined":  3,
+			"b.linedefined":      4,
+			"b.name":             1,
+			"b.short_src":        1,
+			"b.val":              1,
+			"b.what":             1,
+			"b.x":                4,
+			"break":              19,
+			"c":                  123,
+			"c*b":                1,
+			"c..d":               2,
+			"c.x":                1,
+			"c=":                 1,
+			"call":               4,
+			"cap":                55,
+			"catter":             2,
+			"check":              19,
+			"check3":             4,
+			"checkequal":         4,
+			"checkmessage":       21,
+			"checkstackmessage":  4,
+			"checksyntax":        8,
+			"checktable":         2,
+			"checktraceback":     6,
+			"cl":                 2,
+			"clock":              2,
+			"close":              4,
+			"co":                 41,
+			"co_func":            2,
+			"collectgarbage":     43,
+			"comment":            1,
+			"cond":               2,
+			"coroutine":          1,
+			"coroutine.create":   10,
+			"coroutine.resume":   23,
+			"coroutine.running":  3,
+			"coroutine.status":   8,
+			"coroutine.wrap":     12,
+			"coroutine.yield":    18,
+			"count":              14,
+			"createfiles":        3,
+			"curr":               3,
+			"current_co":         4,
+			"d":                  65,
+			"d/":                 1,
+			"d=":                 1,
+			"debug":              1,
+			"debug.getfenv":      7,
+			"debug.gethook":      10,
+			"debug.getinfo":      41,
+			"debug.getlocal":     13,
+			"debug.getmetatable": 3,
+			"debug.getregistry":  1,
+			"debug.getupvalue":   5,
+			"debug.setfenv":      3,
+			"debug.sethook":      17,
+			"debug.setlocal":     4,
+			"debug.setmetatable": 7,
+			"debug.setupvalue":   3,
+			"debug.traceback":    9,
+			"deep":               13,
+			"do":                 124,
+			"dofile":             21,
+			"doit":               29,
+			"dostring":           12,
+			"dummy":              5,
+			"e":                  29,
+			"e=":                 1,
+			"each":               2,
+			"else":               35,
+			"elseif":             10,
+			"empty":              1,
+			"end":                482,
+			"eqtab":              4,
+			"equaltab":           3,
+			"err":                6,
+			"err_on_n":           1,
+			"error":              10,
+			"event":              2,
+			"example":            1,
+			"expand":             3,
+			"extra":              1,
+			"extras":             2,
+			"f":                  263,
+			"f.id":               1,
+			"f2":                 2,
+			"f=":                 1,
+			"fact":               7,
+			"false":              52,
+			"fat":                4,
+			"feijao":             1,
+			"ff":                 5,
+			"files":              10,
+			"filter":             2,
+			"findfield":          2,
+			"first":              3,
+			"foi":                14,
+			"foo":                22,
+			"foo1":               6,
+			"foo2":               3,
+			"for":                71,
+			"force":              1,
+			"format":             6,
+			"formatmem":          3,
+			"fs":                 3,
+			"function":           261,
+			"g":                  40,
+			"g.func":             1,
+			"g.name":             1,
+			"g.namewhat":         1,
+			"g.what":             1,
+			"g.x":                2,
+			"g1":                 3,
+			"gcinfo":             3,
+			"gen":                2,
+			"get":                2,
+			"getfenv":            10,
+			"getmetatable":       27,
+			"getupvalues":        3,
+			"glob":               8,
+			"goo":                4,
+			"h":                  11,
+			"hsize":              7,
+			"i":                  256,
+			"i*3":                1,
+			"i*4":                1,
+			"i..":                1,
+			"i..v":               1,
+			"i/c":                1,
+			"i=":                 14,
+			"if":                 95,
+			"ii":                 5,
+			"import":             2,
+			"in":                 20,
+			"init":               1,
+			"insert":             3,
+			"inv":                5,
+			"io":                 1,
+			"io.close":           2,
+			"io.open":            3,
+			"io.output":          2,
+			"io.read":            2,
+			"io.stderr":          2,
+			"io.stdin":           1,
+			"io.write":           4,
+			"ipairs":             4,
+			"iscfunction":        1,
+			"isfunction":         1,
+			"isnil":              1,
+			"isnull":             1,
+			"isnumber":           1,
+			"isstring":           1,
+			"istable":            1,
+			"isuserdata":         1,
+			"j":                  5,
+			"j30":                1,
+			"j300":               1,
+			"j301":               1,
+			"j302":               1,
+			"j303":               1,
+			"j304":               1,
+			"j305":               1,
+			"j306":               1,
+			"j307":               1,
+			"j308":               1,
+			"j309":               1,
+			"k":                  36,
+			"key":                7,
+			"l":                  30,
+			"l.asize":            1,
+			"l.currentline":      5,
+			"l.ff":               1,
+			"l.hsize":            1,
+			"l1":                 3,
+			"le":                 2,
+			"lib1":               1,
+			"lib1.id":            1,
+			"lib1.sub":           2,
+			"lib2.id":            1,
+			"lim":                22,
+			"lim..":              1,
+			"line":               10,
+			"lineerror":          10,
+			"lines":              2,
+			"load":               2,
+			"loadfile":           5,
+			"loadstring":         20,
+			"local":              310,
+			"long":               1,
+			"longs":              2,
+			"lots":               1,
+			"m":                  26,
+			"m..":                2,
+			"m.AA":               2,
+			"m._NAME":            2,
+			"m/1024":             4,
+			"math":               1,
+			"math.cos":           1,
+			"math.deg":           3,
+			"math.floor":         1,
+			"math.max":           1,
+			"math.mod":           3,
+			"math.randomseed":    1,
+			"math.sin":           13,
+			"maxlist":            7,
+			"module":             12,
+			"mostra":             1,
+			"mp":                 9,
+			"msg":                17,
+			"msg.msg":            1,
+			"msgs":               3,
+			"mt":                 7,
+			"mt.__add":           1,
+			"mt.__index":         2,
+			"n":                  104,
+			"n*":                 1,
+			"n*100/nlist":        1,
+			"n*f":                1,
+			"n*fact":             1,
+			"n*i":                1,
+			"n1":                 1,
+			"n10":                1,
+			"n100":               1,
+			"n11":                1,
+			"n12":                1,
+			"n13":                1,
+			"n14":                1,
+			"n15":                1,
+			"n16":                1,
+			"n17":                1,
+			"n18":                1,
+			"n19":                1,
+			"n2":                 1,
+			"n20":                1,
+			"n201":               1,
+			"n202":               1,
+			"n203":               1,
+			"n204":               1,
+			"n205":               1,
+			"n206":               1,
+			"n207":               1,
+			"n208":               1,
+			"n209":               1,
+			"n21":                1,
+			"n210":               1,
+			"n211":               1,
+			"n212":               1,
+			"n213":               1,
+			"n214":               1,
+			"n215":               1,
+			"n216":               1,
+			"n217":               1,
+			"n218":               1,
+			"n219":               1,
+			"n22":                1,
+			"n220":               1,
+			"n221":               1,
+			"n222":               1,
+			"n223":               1,
+			"n224":               1,
+			"n225":               1,
+			"n226":               1,
+			"n227":               1,
+			"n228":               1,
+			"n229":               1,
+			"n23":                1,
+			"n230":               1,
+			"n231":               1,
+			"n232":               1,
+			"n233":               1,
+			"n234":               1,
+			"n235":               1,
+			"n236":               1,
+			"n237":               1,
+			"n238":               1,
+			"n239":               1,
+			"n25":                1,
+			"n250":               1,
+			"n251":               1,
+			"n252":               1,
+			"n253":               1,
+			"n254":               1,
+			"n255":               1,
+			"n256":               1,
+			"n257":               1,
+			"n258":               1,
+			"n259":               1,
+			"n26":                1,
+			"n260":               1,
+			"n261":               1,
+			"n262":               1,
+			"n263":               1,
+			"n264":               1,
+			"n265":               1,
+			"n266":               1,
+			"n267":               1,
+			"n268":               1,
+			"n269":               1,
+			"n27":                1,
+			"n270":               1,
+			"n271":               1,
+			"n272":               1,
+			"n273":               1,
+			"n274":               1,
+			"n275":               1,
+			"n276":               1,
+			"n277":               1,
+			"n278":               1,
+			"n279":               1,
+			"n28":                1,
+			"n280":               1,
+			"n281":               1,
+			"n282":               1,
+			"n283":               1,
+			"n284":               1,
+			"n285":               1,
+			"n286":               1,
+			"n287":               1,
+			"n288":               1,
+			"n289":               1,
+			"n29":                1,
+			"n290":               1,
+			"n291":               1,
+			"n292":               1,
+			"n293":               1,
+			"n294":               1,
+			"n295":               1,
+			"n296":               1,
+			"n297":               1,
+			"n298":               1,
+			"n299":               1,
+			"n3":                 1,
+			"n31":                1,
+			"n311":               1,
+			"n312":               1,
+			"n313":               1,
+			"n314":               1,
+			"n315":               1,
+			"n316":               1,
+			"n317":               1,
+			"n318":               1,
+			"n319":               1,
+			"n32":                1,
+			"n320":               1,
+			"n321":               1,
+			"n322":               1,
+			"n323":               1,
+			"n324":               1,
+			"n325":               1,
+			"n326":               1,
+			"n327":               1,
+			"n328":               1,
+			"n33":                1,
+			"n330":               1,
+			"n331":               1,
+			"n332":               1,
+			"n333":               1,
+			"n334":               1,
+			"n335":               1,
+			"n336":               1,
+			"n337":               1,
+			"n338":               1,
+			"n339":               1,
+			"n34":                1,
+			"n340":               1,
+			"n341":               1,
+			"n343":               1,
+			"n344":               1,
+			"n345":               1,
+			"n346":               1,
+			"n347":               1,
+			"n348":               1,
+			"n349":               1,
+			"n35":                1,
+			"n350":               1,
+			"n351":               1,
+			"n352":               1,
+			"n354":               1,
+			"n355":               1,
+			"n356":               1,
+			"n357":               1,
+			"n358":               1,
+			"n359":               1,
+			"n36":                1,
+			"n360":               1,
+			"n361":               1,
+			"n362":               1,
+			"n363":               1,
+			"n364":               1,
+			"n365":               1,
+			"n366":               1,
+			"n368":               1,
+			"n369":               1,
+			"n37":                1,
+			"n370":               1,
+			"n371":               1,
+			"n372":               1,
+			"n373":               1,
+			"n374":               1,
+			"n375":               1,
+			"n377":               1,
+			"n378":               1,
+			"n379":               1,
+			"n38":                1,
+			"n380":               1,
+			"n381":               1,
+			"n382":               1,
+			"n383":               1,
+			"n384":               1,
+			"n385":               1,
+			"n386":               1,
+			"n387":               1,
+			"n388":               1,
+			"n389":               1,
+			"n39":                1,
+			"n390":               1,
+			"n391":               1,
+			"n392":               1,
+			"n393":               1,
+			"n394":               1,
+			"n395":               1,
+			"n396":               1,
+			"n397":               1,
+			"n398":               1,
+			"n399":               1,
+			"n4":                 1,
+			"n40":                1,
+			"n400":               1,
+			"n41":                1,
+			"n42":                1,
+			"n43":                1,
+			"n44":                1,
+			"n45":                1,
+			"n46":                1,
+			"n47":                1,
+			"n48":                1,
+			"n49":                1,
+			"n5":                 1,
+			"n50":                1,
+			"n51":                1,
+			"n52":                1,
+			"n53":                1,
+			"n54":                1,
+			"n55":                1,
+			"n56":                1,
+			"n57":                1,
+			"n58":                1,
+			"n59":                1,
+			"n6":                 1,
+			"n60":                1,
+			"n61":                1,
+			"n62":                1,
+			"n63":                1,
+			"n64":                1,
+			"n65":                1,
+			"n68":                1,
+			"n69":                1,
+			"n7":                 1,
+			"n70":                1,
+			"n71":                1,
+			"n72":                1,
+			"n73":                1,
+			"n74":                1,
+			"n75":                1,
+			"n76":                1,
+			"n77":                1,
+			"n78":                1,
+			"n79":                1,
+			"n8":                 1,
+			"n80":                1,
+			"n81":                1,
+			"n82":                1,
+			"n83":                1,
+			"n84":                1,
+			"n85":                1,
+			"n86":                1,
+			"n87":                1,
+			"n88":                1,
+			"n89":                1,
+			"n9":                 1,
+			"n90":                1,
+			"n91":                1,
+			"n92":                1,
+			"n93":                1,
+			"n94":                1,
+			"n95":                1,
+			"n96":                1,
+			"n97":                1,
+			"n98":                1,
+			"n99":                1,
+			"n=":                 1,
+			"name":               16,
+			"near":               1,
+			"neg":                5,
+			"nelem":              4,
+			"nelem/nlist":        1,
+			"nelem/t.hsize":      1,
+			"newproxy":           9,
+			"next":               19,
+			"nil":                253,
+			"nlist":              3,
+			"not":                122,
+			"o":                  2,
+			"of":                 2,
+			"ok":                 2,
+			"oldM":               2,
+			"oldglob":            3,
+			"oldpath":            2,
+			"op":                 7,
+			"or":                 56,
+			"os":                 1,
+			"os.clock":           2,
+			"os.remove":          4,
+			"os.setlocale":       1,
+			"os.tmpname":         2,
+			"p":                  24,
+			"p..":                2,
+			"p.preload.pl":       1,
+			"pack":               20,
+			"package":            5,
+			"package.cpath":      2,
+			"package.loaded":     1,
+			"package.loaded.A":   3,
+			"package.loaded.B":   1,
+			"package.loadlib":    2,
+			"package.path":       9,
+			"package.preload":    1,
+			"package.seeall":     4,
+			"pairs":              12,
+			"pcall":              20,
+			"pf":                 3,
+			"pl":                 1,
+			"pl.xuxu":            1,
+			"pop":                1,
+			"posextras":          2,
+			"preextras":          2,
+			"prefix":             2,
+			"print":              96,
+			"printlocks":         1,
+			"prog":               8,
+			"pt":                 2,
+			"pushcclosure":       2,
+			"pushnum":            6,
+			"pushvalue":          8,
+			"r":                  11,
+			"r.msg..":            1,
+			"r353":               1,
+			"rawequal":           2,
+			"rawget":             6,
+			"rawset":             4,
+			"read1":              3,
+			"remove":             2,
+			"removefiles":        3,
+			"rep":                2,
+			"rep129":             2,
+			"repeat":             13,
+			"replace":            4,
+			"require":            29,
+			"res":                11,
+			"res2":               1,
+			"ret2":               8,
+			"return":             218,
+			"rr":                 2,
+			"s":                  70,
+			"s*i":                1,
+			"s..":                5,
+			"s1":                 5,
+			"select":             6,
+			"self":               10,
+			"self.i":             2,
+			"self.items":         6,
+			"self.x":             2,
+			"set":                2,
+			"setfenv":            5,
+			"setmetatable":       33,
+			"several":            1,
+			"showmem":            5,
+			"spanning":           1,
+			"stack":              6,
+			"stackmsg":           2,
+			"stat":               6,
+			"stderr":             2,
+			"string":             2,
+			"string.dump":        2,
+			"string.find":        28,
+			"string.format":      15,
+			"string.gmatch":      2,
+			"string.gsub":        9,
+			"string.len":         2,
+			"string.match":       2,
+			"string.rep":         7,
+			"string.sub":         2,
+			"t":                  124,
+			"t.__add":            1,
+			"t.__call":           1,
+			"t.__concat":         1,
+			"t.__div":            1,
+			"t.__eq":             1,
+			"t.__index":          1,
+			"t.__le":             3,
+			"t.__lt":             2,
+			"t.__mod":            1,
+			"t.__mul":            1,
+			"t.__newindex":       2,
+			"t.__pow":            1,
+			"t.__sub":            1,
+			"t.__unm":            1,
+			"t.a":                2,
+			"t.asize":            1,
+			"t.b":                2,
+			"t.c":                2,
+			"t.f":                2,
+			"t.hsize":            2,
+			"t.n":                2,
+			"t.p":                1,
+			"t1":                 15,
+			"t1.__eq":            2,
+			"t1.__lt":            2,
+			"t2":                 12,
+			"t2.__eq":            1,
+			"t2.__lt":            1,
+			"t=":                 1,
+			"table":              1,
+			"table.getn":         19,
+			"table.insert":       7,
+			"table.remove":       3,
+			"table.sort":         1,
+			"tail":               1,
+			"tail.func":          1,
+			"tail.linedefined":   1,
+			"tail.short_src":     1,
+			"tail.what":          1,
+			"tb":                 2,
+			"tcheck":             14,
+			"test":               15,
+			"testamem":           11,
+			"testprog":           3,
+			"testrep":            10,
+			"then":               107,
+			"to":                 17,
+			"token":              4,
+			"tonumber":           3,
+			"tostring":           5,
+			"tr":                 7,
+			"true":               49,
+			"try":                8,
+			"tt":                 5,
+			"tt.__gc":            1,
+			"tt=":                1,
+			"turn":               4,
+			"type":               40,
+			"u":                  17,
+			"udval":              3,
+			"unlpack":            10,
+			"unpack":             16,
+			"until":              14,
+			"v":                  26,
+			"v1":                 2,
+			"val":                8,
+			"value":              2,
+			"w":                  5,
+			"when":               3,
+			"while":              29,
+			"write":              4,
+			"x":                  278,
+			"x*2":                1,
+			"x*fact":             1,
+			"x..":                1,
+			"x.a":                2,
+			"x.activelines":      4,
+			"x.currentline":      2,
+			"x.f":                1,
+			"x.func":             2,
+			"x.lastlinedefined":  1,
+			"x.linedefined":      1,
+			"x.n":                1,
+			"x.name":             2,
+			"x.nups":             1,
+			"x.source":           1,
+			"x.val":              2,
+			"x.what":             1,
+			"x=":                 2,
+			"xpcall":             4,
+			"xuxu":               1,
+			"xxx":                3,
+			"xxxx":               2,
+			"y":                  61,
+			"y=":                 1,
+			"z":                  15,
+			"z342":               1,
+			"z367":               1,
+			"z67":                1,
+			"{":                  241,
+			"}":                  237,
+		},
+		"Matlab": map[string]int{
+			"%":                1,
+			"(":                30,
+			")":                30,
+			"*":                1,
+			"*pi":              1,
+			"+":                3,
+			"-":                2,
+			".":                1,
+			"/":                2,
+			";":                18,
+			"<":                1,
+			"Moving":           1,
+			"SMOOTH":           1,
+			"a":                1,
+			"average":          1,
+			"disp":             1,
+			"end":              5,
+			"figure":           1,
+			"floor":            2,
+			"for":              2,
+			"function":         2,
+			"hi":               2,
+			"if":               1,
+			"k":                4,
+			"k1":               2,
+			"legend":           1,
+			"length":           1,
+			"linspace":         1,
+			"lo":               2,
+			"max":              1,
+			"mean":             2,
+			"min":              1,
+			"n":                3,
+			"nargin":           1,
+			"numel":            1,
+			"of":               1,
+			"p":                4,
+			"plot":             1,
+			"randn":            1,
+			"sin":              2,
+			"size":             2,
+			"smooth":           2,
+			"sprintf":          1,
+			"sqrt":             1,
+			"sum_from_1_to_10": 1,
+			"t":                6,
+			"vector":           1,
+			"vector_sum":       2,
+			"window":           4,
+			"x":                10,
+			"xlabel":           1,
+			"y":                6,
+			"ylabel":           1,
+			"z":                2,
+			"zeros":            1,
+		},
+		"Objective-C": map[string]int{
+			"#endif":                            1,
+			"#if":                               1,
+			"#import":                           5,
+			"&&":                                1,
+			"(":                                 24,
+			")":                                 24,
+			"*":                                 7,
+			"**argv":                            1,
+			"*cell":                             1,
+			"*darkAppearance":                   1,
+			"*items":                            1,
+			"*name":                             1,
+			"+":                                 1,
+			"-":                                 4,
+			";":                                 17,
+			"<AppKit/NSAppearance.h>":           1,
+			"<Foundation/Foundation.h>":         3,
+			"<NSCopying>":                       1,
+			"<NSString>":                        1,
+			"@":                                 5,
+			"@available":                        1,
+			"@end":                              3,
+			"@implementation":                   1,
+			"@interface":                        2,
+			"@property":                         3,
+			"BOOL":                              1,
+			"GoFunc":                            2,
+			"MAC_OS_X_VERSION_MIN_REQUIRED":     2,
+			"NO":                                1,
+			"NSAppearance":                      2,
+			"NSAppearanceNameDarkAqua":          1,
+			"NSArray":                           1,
+			"NSIndexPath":                       1,
+			"NSInteger":                         2,
+			"NSObject":                          1,
+			"NSString":                          3,
+			"NSUInteger":                        2,
+			"NS_ASSUME_NONNULL_BEGIN":           1,
+			"NS_ASSUME_NONNULL_END":             1,
+			"Person":                            1,
+			"UITableView":                       2,
+			"UITableViewCell":                   2,
+			"ViewController":                    2,
+			"[":                                 7,
+			"]":                                 7,
+			"age":                               3,
+			"appearanceNamed":                   1,
+			"argc":                              1,
+			"assign":                            1,
+			"cell":                              1,
+			"cell.textLabel.text":               1,
+			"cellForRowAtIndexPath":             1,
+			"char":                              1,
+			"copy":                              1,
+			"count":                             1,
+			"darkAppearance":                    1,
+			"defined":                           1,
+			"dequeueReusableCellWithIdentifier": 1,
+			"extern":                            1,
+			"forIndexPath":                      1,
+			"function":                          1,
+			"if":                                1,
+			"indexPath":                         2,
+			"indexPath.row":                     1,
+			"initWithName":                      1,
+			"instancetype":                      2,
+			"int":                               2,
+			"macOS":                             1,
+			"main":                              1,
+			"name":                              2,
+			"nonatomic":                         3,
+			"numberOfRowsInSection":             1,
+			"personWithName":                    1,
+			"reloadData":                        1,
+			"return":                            3,
+			"section":                           1,
+			"self.items":                        3,
+			"self.tableView":                    1,
+			"strong":                            1,
+			"super":                             1,
+			"tableView":                         5,
+			"viewDidLoad":                       2,
+			"void":                              3,
+			"{":                                 6,
+			"}":                                 6,
+		},
+		"PHP": map[string]int{
+			"(":            14,
+			")":            15,
+			"+":            1,
+			"-":            7,
+			";":            13,
+			"<?php>":       2,
+			"Request":      1,
+			"User":         1,
+			"[":            4,
+			"]":            4,
+			"_000":         1,
+			"as":           1,
+			"content":      2,
+			"create":       1,
+			"data":         2,
+			"doc":          4,
+			"docs":         2,
+			"echo":         1,
+			"foreach":      1,
+			"function":     1,
+			"getIterator":  1,
+			"id":           1,
+			"json_encode":  1,
+			"key":          1,
+			"modx":         1,
+			"n":            1,
+			"n2":           1,
+			"paginate":     1,
+			"posts":        2,
+			"preg_replace": 1,
+			"print_r":      1,
+			"public":       1,
+			"q":            2,
+			"redirect":     1,
+			"request":      2,
+			"return":       2,
+			"route":        1,
+			"set":          1,
+			"slugify":      1,
+			"store":        1,
+			"title":        2,
+			"user":         2,
+			"users":        1,
+			"validate":     1,
+			"view":         1,
+			"{":            3,
+			"}":            5,
+		},
+		"Perl": map[string]int{
+			"#":                      6,
+			"#debug":                 1,
+			"#define":                3,
+			"#machdep":               1,
+			"#s":                     1,
+			"#sg":                    2,
+			"#vfs":                   1,
+			"#vfsgenctl":             1,
+			"%":                      24,
+			"&":                      8,
+			"&&":                     32,
+			"(":                      665,
+			")":                      655,
+			"*":                      11,
+			"*/":                     3,
+			"*STDERR":                1,
+			"*STDOUT":                1,
+			"*_":                     1,
+			"*import":                1,
+			"+":                      103,
+			"-":                      85,
+			".":                      112,
+			".*":                     20,
+			".*/":                    2,
+			"...":                    1,
+			"./":                     3,
+			".go":                    1,
+			"/":                      112,
+			"//":                     12,
+			"//go":                   2,
+			"//s":                    1,
+			"//xs":                   1,
+			"/000000":                1,
+			"/g":                     6,
+			"/gc":                    1,
+			"/i":                     1,
+			"/r":                     2,
+			"/s":                     6,
+			"/sg":                    2,
+			"/sys":                   4,
+			"/sysnb":                 2,
+			"/xmgc":                  2,
+			";":                      792,
+			"<":                      26,
+			"<$script>":              1,
+			"<<":                     11,
+			"<=>":                    1,
+			"<@out;>":                2,
+			"<@ranges;>":             1,
+			"<HEADER>":               2,
+			"@":                      15,
+			"@ARGV":                  17,
+			"@EXPORT":                12,
+			"@EXPORT_OK":             2,
+			"@ISA":                   6,
+			"@_":                     41,
+			"@allwords":              8,
+			"@args":                  46,
+			"@ctls":                  2,
+			"@headers":               2,
+			"@in":                    6,
+			"@lines":                 6,
+			"@node":                  1,
+			"@nodeoid":               3,
+			"@oid":                   4,
+			"@out":                   8,
+			"@para":                  3,
+			"@perlclasses":           2,
+			"@pieces":                5,
+			"@posixclasses":          2,
+			"@pout":                  1,
+			"@ranges":                4,
+			"@raw":                   2,
+			"@rest":                  2,
+			"@result":                4,
+			"@ret":                   2,
+			"@suffices":              3,
+			"@t":                     3,
+			"@vars":                  3,
+			"@witharg":               2,
+			"@words":                 12,
+			"A":                      11,
+			"ARGV":                   26,
+			"Abbrev":                 1,
+			"Array":                  1,
+			"BEGIN":                  2,
+			"Basename":               2,
+			"CLEAR":                  2,
+			"CTLTYPE_":               1,
+			"CTL_":                   1,
+			"CTL_NAMES":              2,
+			"Carp":                   7,
+			"ComputeClass":           1,
+			"Counter":                1,
+			"DEFAULT":                1,
+			"DELETE":                 2,
+			"DESTROY":                1,
+			"DOS":                    3,
+			"ENV":                    3,
+			"EOF":                    12,
+			"EOH":                    8,
+			"EOM":                    2,
+			"EXISTS":                 2,
+			"EXTEND":                 2,
+			"Epoc":                   1,
+			"ExportLevel":            2,
+			"Exporter":               12,
+			"F":                      1,
+			"FETCH":                  5,
+			"FETCHSIZE":              5,
+			"File":                   2,
+			"Fileparse_fstype":       5,
+			"Fileparse_igncase":      2,
+			"For":                    1,
+			"G":                      4,
+			"GetOptions":             1,
+			"Getopt":                 5,
+			"HEADER":                 2,
+			"HELP_MESSAGE":           2,
+			"Long":                   1,
+			"MORE_OPTIONS":           1,
+			"MSDOS":                  3,
+			"MSWin32":                3,
+			"Now":                    1,
+			"O":                      1,
+			"OPTIONS":                1,
+			"OS2":                    2,
+			"OUTPUT_HELP_VERSION":    3,
+			"Options":                1,
+			"PERL_SINGLE_QUOTE":      1,
+			"PM":                     3,
+			"PM/g":                   1,
+			"POP":                    2,
+			"PROGRAM_ARG1":           1,
+			"PUSH":                   2,
+			"ParseWords":             1,
+			"Perl":                   1,
+			"PrintClass":             1,
+			"PrintClasses":           2,
+			"Ptr":                    1,
+			"S":                      5,
+			"S*":                     4,
+			"SHEBANG#!perl":          5,
+			"SHIFT":                  2,
+			"SPLICE":                 4,
+			"STANDARD_HELP_VERSION":  4,
+			"STANDARD_HELP_VERSION.": 1,
+			"STDERR":                 15,
+			"STDOUT":                 1,
+			"STORE":                  5,
+			"STORESIZE":              4,
+			"SUBVERSION":             1,
+			"SYS_":                   1,
+			"See":                    1,
+			"Std":                    4,
+			"StdArray":               1,
+			"TAINT":                  1,
+			"TIEARRAY":               1,
+			"Tabs":                   2,
+			"Text":                   5,
+			"The":                    1,
+			"Tie":                    2,
+			"UNSHIFT":                2,
+			"Usage":                  1,
+			"V":                      1,
+			"VERSION":                11,
+			"VERSION_MESSAGE":        2,
+			"WORD":                   2,
+			"Wrap":                   1,
+			"Z":                      3,
+			"Z/":                     1,
+			"Z/a":                    3,
+			"Z/gc":                   1,
+			"Z0":                     1,
+			"[":                      124,
+			"]":                      126,
+			"_":                      53,
+			"_/":                     1,
+			"_001":                   1,
+			"_32bit":                 17,
+			"_C_int":                 1,
+			"_NAMES":                 1,
+			"__END__":                3,
+			"__PACKAGE__":            3,
+			"_strip_trailing_sep":    7,
+			"_xlen":                  4,
+			"a":                      2,
+			"abbrev":                 6,
+			"about":                  1,
+			"accepted":               1,
+			"aix":                    4,
+			"allwords":               2,
+			"and":                    15,
+			"are":                    1,
+			"arg":                    2,
+			"args":                   12,
+			"argumentative":          10,
+			"arm":                    3,
+			"asm":                    10,
+			"asmfuncname":            3,
+			"asmrawfuncname":         3,
+			"b":                      1,
+			"backward":               1,
+			"basename":               28,
+			"be":                     1,
+			"binmode":                1,
+			"bless":                  2,
+			"body":                   6,
+			"break":                  5,
+			"build":                  2,
+			"build_sysctl":           3,
+			"call":                   2,
+			"calling":                1,
+			"carp":                   2,
+			"character":              1,
+			"chomp":                  6,
+			"chop":                   1,
+			"class":                  3,
+			"close":                  1,
+			"cmdline":                2,
+			"cname":                  1,
+			"columns":                7,
+			"compatibility":          1,
+			"continuing":             1,
+			"count":                  3,
+			"croak":                  3,
+			"ctl":                    3,
+			"ctl_map":                3,
+			"ctlname":                14,
+			"ctloid":                 1,
+			"ctls":                   3,
+			"d":                      6,
+			"darwin":                 6,
+			"ddb":                    1,
+			"ddb/db_var.h":           1,
+			"debug":                  6,
+			"defined":                17,
+			"delete":                 2,
+			"delim":                  8,
+			"delimiter":              2,
+			"details":                1,
+			"devspec":                1,
+			"devspec.":               1,
+			"die":                    5,
+			"dirname":                26,
+			"dirpath":                25,
+			"do_errno":               4,
+			"dragonfly":              3,
+			"due":                    1,
+			"dynimports":             4,
+			"e":                      3,
+			"else":                   42,
+			"elsif":                  42,
+			"eq":                     86,
+			"errors":                 9,
+			"errs":                   4,
+			"errvar":                 8,
+			"excessive":              1,
+			"exists":                 6,
+			"exit":                   8,
+			"expand":                 5,
+			"extp":                   1,
+			"extraimports":           4,
+			"f":                      1,
+			"failexpr":               1,
+			"field":                  3,
+			"fileparse":              7,
+			"fileparse_set_fstype":   2,
+			"fill":                   2,
+			"first":                  12,
+			"following":              1,
+			"for":                    12,
+			"foreach":                16,
+			"fs":                     2,
+			"fs.posix":               1,
+			"fullname":               11,
+			"func":                   6,
+			"funcname":               7,
+			"getopt":                 2,
+			"getopts":                3,
+			"glob":                   1,
+			"gofmt":                  3,
+			"grep":                   3,
+			"h":                      8,
+			"has_pod":                3,
+			"hash":                   12,
+			"hashref":                9,
+			"head1":                  1,
+			"header":                 4,
+			"help":                   4,
+			"help_mess":              2,
+			"hits":                   7,
+			"huge":                   5,
+			"hw":                     1,
+			"i":                      63,
+			"if":                     158,
+			"igncase":                2,
+			"import":                 5,
+			"in":                     4,
+			"increment":              1,
+			"index":                  2,
+			"ip":                     6,
+			"join":                   15,
+			"keep":                   7,
+			"kern":                   2,
+			"kern.forkstat":          1,
+			"kern.intrcnt":           1,
+			"kern.malloc":            1,
+			"kern.nchstats":          1,
+			"kern.seminfo":           1,
+			"kern.shminfo":           1,
+			"kern.timecounter":       1,
+			"kern.tty":               1,
+			"kern.watchdog":          1,
+			"key":                    3,
+			"keys":                   3,
+			"last":                   7,
+			"lc":                     1,
+			"lcfirst":                1,
+			"lead":                   9,
+			"len":                    20,
+			"length":                 18,
+			"libc":                   13,
+			"libcFunc":               1,
+			"line":                   16,
+			"lines":                  2,
+			"linknames":              3,
+			"list":                   10,
+			"ll":                     6,
+			"local":                  9,
+			"m":                      7,
+			"m#":                     2,
+			"main":                   5,
+			"map":                    4,
+			"may":                    1,
+			"merged":                 1,
+			"mib":                    5,
+			"mibentry":               2,
+			"min":                    3,
+			"modname":                6,
+			"more":                   1,
+			"my":                     222,
+			"myv":                    3,
+			"n":                      16,
+			"name":                   12,
+			"name.":                  1,
+			"nargs":                  1,
+			"nb":                     3,
+			"nc":                     2,
+			"ne":                     20,
+			"nested_quotewords":      2,
+			"net":                    3,
+			"net.bpf":                1,
+			"net.ifq":                1,
+			"net.inet":               1,
+			"net.inet.ah":            1,
+			"net.inet.carp":          1,
+			"net.inet.divert":        1,
+			"net.inet.esp":           1,
+			"net.inet.etherip":       1,
+			"net.inet.gre":           1,
+			"net.inet.icmp":          1,
+			"net.inet.igmp":          1,
+			"net.inet.ip":            1,
+			"net.inet.ip.ifq":        1,
+			"net.inet.ipcomp":        1,
+			"net.inet.ipip":          1,
+			"net.inet.mobileip":      1,
+			"net.inet.pfsync":        1,
+			"net.inet.pim":           1,
+			"net.inet.tcp":           1,
+			"net.inet.udp":           1,
+			"net.inet6":              1,
+			"net.inet6.divert":       1,
+			"net.inet6.icmp6":        1,
+			"net.inet6.ip6":          1,
+			"net.inet6.pim6":         1,
+			"net.inet6.tcp6":         1,
+			"net.inet6.udp6":         1,
+			"net.key":                1,
+			"net.mpls":               1,
+			"net.mpls.ifq":           1,
+			"net.pflow":              1,
+			"net.pfsync":             1,
+			"net.pipex":              1,
+			"net.rt":                 1,
+			"net/if.h":               1,
+			"net/if_pfsync.h":        1,
+			"net/pipex.h":            1,
+			"netbsd":                 3,
+			"netinet":                1,
+			"netinet/icmp6.h":        1,
+			"netinet/icmp_var.h":     1,
+			"netinet/igmp_var.h":     1,
+			"netinet/in.h":           1,
+			"netinet/ip_ah.h":        1,
+			"netinet/ip_carp.h":      1,
+			"netinet/ip_divert.h":    1,
+			"netinet/ip_esp.h":       1,
+			"netinet/ip_ether.h":     1,
+			"netinet/ip_gre.h":       1,
+			"netinet/ip_ipcomp.h":    1,
+			"netinet/ip_ipip.h":      1,
+			"netinet/pim_var.h":      1,
+			"netinet/tcp_var.h":      1,
+			"netinet/udp_var.h":      1,
+			"netinet6":               1,
+			"netinet6/in6.h":         1,
+			"netinet6/ip6_divert.h":  1,
+			"netinet6/pim6_var.h":    1,
+			"netmpls/mpls.h":         1,
+			"new":                    1,
+			"newsize":                4,
+			"newtags":                6,
+			"next":                   11,
+			"nl":                     8,
+			"nll":                    4,
+			"no":                     6,
+			"node":                   22,
+			"node_map":               3,
+			"nodename":               6,
+			"nonblock":               6,
+			"not":                    3,
+			"o":                      4,
+			"ob":                     3,
+			"obj":                    17,
+			"off":                    19,
+			"oid":                    2,
+			"old_shellwords":         2,
+			"open":                   4,
+			"openbsd":                4,
+			"options":                1,
+			"or":                     5,
+			"orig_type":              3,
+			"our":                    24,
+			"out":                    9,
+			"out_decl":               2,
+			"output_h":               4,
+			"overrides":              2,
+			"p":                      14,
+			"pM*":                    3,
+			"package":                19,
+			"paranoia.":              1,
+			"parse_line":             5,
+			"parseparam":             7,
+			"parseparamlist":         6,
+			"part":                   6,
+			"pat":                    1,
+			"pat//s":                 1,
+			"path":                   11,
+			"perldoc":                1,
+			"perlv":                  2,
+			"perlv.":                 1,
+			"pkg":                    2,
+			"plan9":                  4,
+			"pod":                    1,
+			"pop":                    4,
+			"pos":                    8,
+			"pp":                     4,
+			"print":                  29,
+			"printf":                 1,
+			"ps":                     2,
+			"ptrace":                 1,
+			"push":                   44,
+			"quote":                  5,
+			"quoted":                 5,
+			"quotewords":             2,
+			"qw":                     17,
+			"r":                      8,
+			"re":                     3,
+			"read":                   1,
+			"ref":                    10,
+			"reg":                    6,
+			"regexp":                 1,
+			"register":               1,
+			"remainder":              8,
+			"require":                10,
+			"rest":                   17,
+			"ret":                    11,
+			"return":                 37,
+			"returnvoid":             4,
+			"run":                    1,
+			"running":                1,
+			"s":                      29,
+			"s#":                     2,
+			"s*":                     12,
+			"s*/":                    2,
+			"s*//":                   2,
+			"s/":                     29,
+			"scalar":                 2,
+			"scr":                    2,
+			"script":                 1,
+			"seen":                   3,
+			"select":                 1,
+			"self":                   7,
+			"separator":              3,
+			"separator2":             5,
+			"shellwords":             2,
+			"shift":                  52,
+			"single":                 1,
+			"snippet":                6,
+			"solaris":                3,
+			"sort":                   3,
+			"splice":                 1,
+			"split":                  6,
+			"sprintf":                12,
+			"start":                  7,
+			"stdimports":             3,
+			"strconvfunc":            1,
+			"strconvtype":            1,
+			"strict":                 15,
+			"string":                 1,
+			"struct":                 1,
+			"sub":                    53,
+			"substr":                 9,
+			"suffix":                 6,
+			"syntax":                 1,
+			"sys/malloc.h":           1,
+			"sys/mount.h":            1,
+			"sys/namei.h":            1,
+			"sys/sem.h":              1,
+			"sys/shm.h":              1,
+			"sys/socket.h":           1,
+			"sys/sysctl.h":           1,
+			"sys/tty.h":              1,
+			"sys/vmmeter.h":          1,
+			"syscall":                2,
+			"syscalldot":             2,
+			"sysctl":                 4,
+			"sysctlMib":              1,
+			"sysname":                16,
+			"sysvarname":             3,
+			"sz":                     15,
+			"t":                      12,
+			"table":                  2,
+			"tabstop":                3,
+			"tags":                   6,
+			"tail":                   7,
+			"taint":                  6,
+			"text":                   55,
+			"to":                     1,
+			"together.":              1,
+			"trampolines":            3,
+			"try_exit":               3,
+			"type":                   42,
+			"uintptr":                1,
+			"undef":                  2,
+			"under":                  1,
+			"unexpand":               8,
+			"unless":                 21,
+			"unquoted":               4,
+			"unshift":                1,
+			"use":                    26,
+			"user":                   1,
+			"uvm/uvm_param.h":        1,
+			"uvm/uvm_swap_encrypt.h": 1,
+			"v":                      4,
+			"val":                    7,
+			"value":                  1,
+			"var":                    2,
+			"vardecls":               3,
+			"version":                3,
+			"version_mess":           3,
+			"vm":                     1,
+			"vm.swapencrypt":         1,
+			"w":                      2,
+			"w*":                     2,
+			"wantarray":              3,
+			"warn":                   1,
+			"warnif":                 2,
+			"warnings":               12,
+			"while":                  16,
+			"word":                   14,
+			"words":                  1,
+			"wrap":                   3,
+			"write":                  1,
+			"x":                      3,
+			"xp":                     8,
+			"y/A":                    3,
+			"y/a":                    1,
+			"z":                      4,
+			"z#":                     1,
+			"z/":                     10,
+			"z/A":                    1,
+			"{":                      396,
+			"|":                      16,
+			"||":                     15,
+			"}":                      400,
+		},
+		"Python": map[string]int{
+			"#": 27,
+			"####################################################################":     1,
+			"########################################################################": 1,
+			"#TODO":                                 1,
+			"#http":                                 1,
+			"#remove":                               1,
+			"#rsc":                                  1,
+			"%":                                     2,
+			"&":                                     4,
+			"(":                                     957,
+			")":                                     948,
+			"*":                                     12,
+			"**kwargs":                              1,
+			"**kws":                                 2,
+			"*6.0":                                  1,
+			"*args":                                 1,
+			"*b":                                    1,
+			"*g":                                    1,
+			"*hue*6.0":                              1,
+			"*i":                                    4,
+			"*l":                                    1,
+			"*nodes":                                1,
+			"*pos":                                  4,
+			"*predecessors":                         2,
+			"*q":                                    3,
+			"*r":                                    1,
+			"*width*":                               1,
+			"+":                                     116,
+			"-":                                     83,
+			".":                                     2,
+			".__init_subclass__":                    1,
+			".__next__":                             1,
+			".append":                               1,
+			".cast":                                 7,
+			".dereference":                          6,
+			".fields":                               2,
+			".format":                               8,
+			".join":                                 9,
+			".match":                                1,
+			".npredecessors":                        1,
+			".pointer":                              4,
+			".replace":                              1,
+			".rstrip":                               1,
+			".search":                               1,
+			".split":                                1,
+			".splitlines":                           1,
+			".startswith":                           1,
+			".string":                               3,
+			".strip":                                4,
+			".successors":                           1,
+			".target":                               2,
+			".threads":                              1,
+			".type":                                 4,
+			".type.sizeof":                          1,
+			".type.target":                          2,
+			".values":                               1,
+			"/":                                     12,
+			"//python3porting.com/differences.html": 1,
+			"/3.0":                                  2,
+			"/6.0":                                  1,
+			"<":                                     52,
+			"<=>":                                   4,
+			"@dataclass":                            1,
+			"@functools.lru_cache":                  1,
+			"@property":                             4,
+			"Adds":                                  1,
+			"As":                                    1,
+			"By":                                    1,
+			"ChainMap":                              1,
+			"ChanTypePrinter":                       3,
+			"Collapse":                              1,
+			"CycleError":                            2,
+			"DTypeFunc":                             2,
+			"Empty":                                 5,
+			"Entirely":                              1,
+			"Event":                                 2,
+			"Event.action.__doc__":                  1,
+			"Event.argument.__doc__":                1,
+			"Event.kwargs.__doc__":                  1,
+			"Event.priority.__doc__":                1,
+			"Event.sequence.__doc__":                1,
+			"Event.time.__doc__":                    1,
+			"Exception":                             9,
+			"Exception.__init__":                    1,
+			"False":                                 15,
+			"Fill":                                  1,
+			"Formatter":                             1,
+			"Full":                                  3,
+			"G_COPYSTACK":                           2,
+			"G_DEAD":                                4,
+			"G_DEADEXTRA":                           5,
+			"G_ENQUEUE_UNUSED":                      2,
+			"G_IDLE":                                2,
+			"G_MORIBUND_UNUSED":                     2,
+			"G_RUNNABLE":                            3,
+			"G_RUNNING":                             4,
+			"G_SCAN":                                8,
+			"G_SCANEXTRA":                           2,
+			"G_SCANRUNNABLE":                        2,
+			"G_SCANRUNNING":                         2,
+			"G_SCANSYSCALL":                         2,
+			"G_SCANWAITING":                         2,
+			"G_SYSCALL":                             4,
+			"G_WAITING":                             3,
+			"GenericAlias":                          1,
+			"GoCapFunc":                             2,
+			"GoIfaceCmd":                            2,
+			"GoLenFunc":                             2,
+			"GoroutineCmd":                          2,
+			"GoroutinesCmd":                         2,
+			"If":                                    3,
+			"IfacePrinter":                          2,
+			"ImportError":                           3,
+			"IndexError":                            1,
+			"KeyError":                              3,
+			"LifoQueue":                             1,
+			"List":                                  2,
+			"MapGroupSlots":                         2,
+			"MapTypePrinter":                        3,
+			"NetrcParseError":                       7,
+			"None":                                  97,
+			"Note":                                  1,
+			"ONE_SIXTH":                             2,
+			"ONE_THIRD":                             3,
+			"Optional":                              2,
+			"Otherwise":                             1,
+			"PriorityQueue":                         1,
+			"Queue":                                 3,
+			"Reformat":                              4,
+			"Remove":                                1,
+			"SHEBANG#!python3":                      1,
+			"STAR":                                  7,
+			"See":                                   2,
+			"SimpleQueue":                           4,
+			"SliceTypePrinter":                      3,
+			"SliceValue":                            5,
+			"StopIteration":                         2,
+			"StringIO":                              3,
+			"StringTypePrinter":                     2,
+			"TWO_THIRD":                             3,
+			"Tabs":                                  1,
+			"Template":                              1,
+			"Template.__init_subclass__":            1,
+			"TextWrapper":                           3,
+			"The":                                   1,
+			"This":                                  1,
+			"TopologicalSorter":                     1,
+			"True":                                  22,
+			"True.":                                 1,
+			"TypeError":                             1,
+			"UnicodeDecodeError":                    1,
+			"User":                                  1,
+			"ValidationError":                       2,
+			"ValueError":                            28,
+			"Wrap":                                  1,
+			"[":                                     242,
+			"]":                                     242,
+			"_":                                     1,
+			"_ChainMap":                             3,
+			"_NODE_DONE":                            3,
+			"_NODE_OUT":                             3,
+			"_NodeInfo":                             2,
+			"_PySimpleQueue":                        2,
+			"__about__":                             1,
+			"__all__":                               10,
+			"__bool__":                              1,
+			"__class_getitem__":                     2,
+			"__doc__":                               1,
+			"__future__":                            1,
+			"__getitem__":                           1,
+			"__init__":                              23,
+			"__init_subclass__":                     1,
+			"__iter__":                              1,
+			"__name__":                              3,
+			"__next__":                              1,
+			"__post_init__":                         1,
+			"__repr__":                              2,
+			"__slots__":                             1,
+			"__str__":                               1,
+			"_arg":                                  1,
+			"_bisect":                               1,
+			"_compile_pattern":                      3,
+			"_find_cycle":                           1,
+			"_find_unsafe":                          2,
+			"_fix_sentence_endings":                 1,
+			"_from_tty":                             3,
+			"_get":                                  3,
+			"_get_nodeinfo":                         1,
+			"_handle_long_word":                     1,
+			"_heapify_max":                          1,
+			"_heappop_max":                          1,
+			"_heapreplace_max":                      1,
+			"_init":                                 3,
+			"_invalid":                              1,
+			"_munge_whitespace":                     1,
+			"_netrclex":                             2,
+			"_parse":                                1,
+			"_print_tokens":                         3,
+			"_put":                                  3,
+			"_qsize":                                3,
+			"_queue":                                2,
+			"_re":                                   1,
+			"_re.IGNORECASE":                        1,
+			"_re.VERBOSE":                           1,
+			"_re.compile":                           1,
+			"_re.escape":                            1,
+			"_read_char":                            1,
+			"_security_check":                       1,
+			"_sentinel":                             4,
+			"_sentinel_dict":                        5,
+			"_siftdown":                             3,
+			"_siftdown_max":                         2,
+			"_siftup":                               5,
+			"_siftup_max":                           4,
+			"_split":                                1,
+			"_string":                               1,
+			"_string.formatter_field_name_split":    1,
+			"_string.formatter_parser":              1,
+			"_time":                                 2,
+			"_v":                                    4,
+			"_vformat":                              1,
+			"_whitespace":                           2,
+			"_wrap_chunks":                          1,
+			"a":                                     23,
+			"a.insert":                              2,
+			"account":                               3,
+			"action":                                6,
+			"add":                                   15,
+			"added":                                 1,
+			"adding":                                1,
+			"all":                                   3,
+			"and":                                   59,
+			"any":                                   3,
+			"appended":                              1,
+			"are":                                   9,
+			"arg":                                   5,
+			"arg.split":                             1,
+			"arg_used":                              2,
+			"argparse":                              1,
+			"argparse.ArgumentParser":               1,
+			"args":                                  15,
+			"args.files":                            2,
+			"args.n":                                1,
+			"argument":                              5,
+			"argv":                                  2,
+			"array_group_type":                      1,
+			"array_group_type.pointer":              1,
+			"array_ptr_table_type":                  1,
+			"array_ptr_table_type.pointer":          1,
+			"as":                                    13,
+			"ascii":                                 1,
+			"ascii_letters":                         2,
+			"ascii_lowercase":                       2,
+			"ascii_uppercase":                       2,
+			"assert":                                5,
+			"attrs":                                 2,
+			"authenticators":                        1,
+			"auto_arg_index":                        10,
+			"available":                             2,
+			"b":                                     17,
+			"bc":                                    6,
+			"be":                                    2,
+			"beginning":                             1,
+			"behaviour.":                            2,
+			"bid":                                   1,
+			"bisect":                                1,
+			"bisect_left":                           3,
+			"bisect_right":                          4,
+			"blank":                                 1,
+			"blk":                                   1,
+			"blk.function":                          1,
+			"block":                                 10,
+			"block=":                                1,
+			"blocking":                              2,
+			"bool":                                  2,
+			"both":                                  1,
+			"braceidpattern":                        1,
+			"break":                                 23,
+			"break_long_words":                      2,
+			"break_on_hyphens":                      2,
+			"but":                                   1,
+			"bytes":                                 2,
+			"c":                                     12,
+			"can":                                   1,
+			"cancel":                                1,
+			"cap":                                   1,
+			"capwords":                              1,
+			"ch":                                    19,
+			"character.":                            1,
+			"characters":                            3,
+			"characters.":                           1,
+			"chars":                                 1,
+			"check_unused_args":                     1,
+			"childpos":                              16,
+			"children":                              3,
+			"chunk":                                 8,
+			"chunk.rfind":                           1,
+			"chunks":                                29,
+			"chunks.append":                         2,
+			"chunks.reverse":                        1,
+			"class":                                 33,
+			"classmethod":                           2,
+			"cls":                                   1,
+			"cls.__dict__":                          1,
+			"cls.braceidpattern":                    1,
+			"cls.delimiter":                         1,
+			"cls.flags":                             1,
+			"cls.idpattern":                         2,
+			"cls.pattern":                           2,
+			"cmd":                                   4,
+			"cnt":                                   6,
+			"code":                                  1,
+			"collapsed.":                            1,
+			"collections":                           5,
+			"collections.Counter":                   2,
+			"colno":                                 3,
+			"columns":                               4,
+			"comments":                              2,
+			"common":                                2,
+			"considered":                            1,
+			"consist":                               1,
+			"containing":                            2,
+			"continue":                              19,
+			"conversion":                            8,
+			"convert":                               4,
+			"convert_field":                         1,
+			"converted":                             3,
+			"count":                                 3,
+			"count_words":                           3,
+			"counter":                               4,
+			"counter.most_common":                   1,
+			"counter.update":                        2,
+			"ctrl":                                  2,
+			"cur_len":                               10,
+			"cur_line":                              16,
+			"cur_line.append":                       3,
+			"curthr":                                1,
+			"curthr.switch":                         1,
+			"customize":                             2,
+			"cycle":                                 3,
+			"data":                                  1,
+			"dataclass":                             1,
+			"dataclasses":                           1,
+			"def":                                   181,
+			"default":                               5,
+			"default_factory":                       1,
+			"default_netrc":                         7,
+			"del":                                   7,
+			"delay":                                 5,
+			"delayfunc":                             4,
+			"delim":                                 1,
+			"delimiter":                             1,
+			"deque":                                 7,
+			"description":                           1,
+			"dict.fromkeys":                         2,
+			"digits":                                3,
+			"display":                               1,
+			"display_hint":                          5,
+			"display_name":                          1,
+			"do":                                    1,
+			"done":                                  1,
+			"drop_whitespace":                       2,
+			"dtype":                                 8,
+			"dtype_name":                            2,
+			"dynamic_gdb_type":                      4,
+			"dynamic_gdb_type.pointer":              1,
+			"dynamic_go_type":                       8,
+			"e":                                     2,
+			"edge":                                  1,
+			"elif":                                  50,
+			"else":                                  67,
+			"email":                                 1,
+			"empty":                                 4,
+			"encoding":                              2,
+			"end":                                   4,
+			"endpos":                                6,
+			"endtime":                               4,
+			"enter":                                 1,
+			"enterabs":                              1,
+			"entire":                                2,
+			"entryname":                             9,
+			"enumerate":                             1,
+			"equal":                                 1,
+			"error_leader":                          1,
+			"escapedstate":                          7,
+			"et":                                    2,
+			"event":                                 5,
+			"every":                                 1,
+			"except":                                21,
+			"expand_tabs":                           2,
+			"expanded":                              3,
+			"f":                                     21,
+			"f.name":                                1,
+			"field":                                 2,
+			"field_name":                            7,
+			"field_name.isdigit":                    1,
+			"fields":                                2,
+			"file":                                  12,
+			"filename":                              2,
+			"fill":                                  1,
+			"filter":                                1,
+			"finally":                               2,
+			"find_goroutine":                        2,
+			"first":                                 4,
+			"fit":                                   3,
+			"fiter":                                 4,
+			"fits":                                  3,
+			"fix":                                   1,
+			"fix_sentence_endings":                  2,
+			"fixed":                                 4,
+			"fixed.append":                          1,
+			"flags":                                 1,
+			"fld":                                   5,
+			"fn":                                    3,
+			"fnmatch":                               1,
+			"fnmatchcase":                           2,
+			"for":                                   51,
+			"form.":                                 1,
+			"format":                                2,
+			"format_field":                          1,
+			"format_spec":                           6,
+			"format_string":                         8,
+			"fowner":                                2,
+			"fp":                                    10,
+			"fp.fileno":                             1,
+			"fr":                                    2,
+			"from":                                  21,
+			"full":                                  1,
+			"functools":                             1,
+			"g":                                     17,
+			"gc":                                    6,
+			"gdb":                                   1,
+			"gdb.COMMAND_DATA":                      1,
+			"gdb.COMMAND_STACK":                     2,
+			"gdb.COMPLETE_NONE":                     2,
+			"gdb.COMPLETE_SYMBOL":                   1,
+			"gdb.Command":                           3,
+			"gdb.Command.__init__":                  3,
+			"gdb.Function":                          3,
+			"gdb.Function.__init__":                 3,
+			"gdb.TYPE_CODE_TYPEDEF":                 1,
+			"gdb.block_for_pc":                      1,
+			"gdb.current_objfile":                   1,
+			"gdb.error":                             8,
+			"gdb.execute":                           3,
+			"gdb.lookup_type":                       6,
+			"gdb.objfiles":                          1,
+			"gdb.parse_and_eval":                    14,
+			"gdb.selected_frame":                    1,
+			"gdb.selected_inferior":                 1,
+			"gdb.selected_thread":                   1,
+			"gdb.string_to_argv":                    1,
+			"get":                                   2,
+			"get_field":                             1,
+			"get_identifiers":                       1,
+			"get_nowait":                            2,
+			"get_ready":                             1,
+			"get_token":                             2,
+			"get_value":                             1,
+			"getattr":                               1,
+			"gf":                                    1,
+			"gf.name":                               1,
+			"gf.type":                               1,
+			"given":                                 2,
+			"go_type_ptr":                           2,
+			"go_type_ptr.cast":                      1,
+			"goid":                                  7,
+			"goid_str":                              3,
+			"goids":                                 3,
+			"goids.append":                          1,
+			"goobjfile":                             1,
+			"goobjfile.pretty_printers":             1,
+			"goobjfile.pretty_printers.append":      1,
+			"goobjfile.pretty_printers.extend":      1,
+			"graph":                                 2,
+			"graph.items":                           1,
+			"group":                                 10,
+			"group_slots":                           3,
+			"group_type":                            1,
+			"group_type.array":                      1,
+			"groups":                                4,
+			"groups.cast":                           1,
+			"groups.dereference":                    1,
+			"groups.type.target":                    1,
+			"groups_type":                           1,
+			"groups_type.fields":                    1,
+			"h":                                     15,
+			"h*6.0":                                 2,
+			"h/6.0":                                 2,
+			"has":                                   1,
+			"hasattr":                               1,
+			"have":                                  1,
+			"heap":                                  55,
+			"heap.append":                           1,
+			"heap.pop":                              2,
+			"heapify":                               1,
+			"heappop":                               3,
+			"heappush":                              3,
+			"heappushpop":                           1,
+			"heapq":                                 2,
+			"heapq.heapify":                         1,
+			"heapq.heappop":                         1,
+			"heapq.heappush":                        1,
+			"heapreplace":                           1,
+			"hexdigits":                             1,
+			"hi":                                    24,
+			"hls_to_rgb":                            1,
+			"host":                                  5,
+			"how":                                   2,
+			"hsv_to_rgb":                            1,
+			"hue":                                   7,
+			"hyphen":                                4,
+			"i":                                     76,
+			"i.node":                                1,
+			"i.npredecessors":                       1,
+			"id":                                    1,
+			"idpattern":                             1,
+			"ids":                                   3,
+			"ids.append":                            1,
+			"idx":                                   2,
+			"if":                                    255,
+			"iface_commontype":                      3,
+			"iface_dtype":                           4,
+			"iface_dtype_name":                      2,
+			"ifacematcher":                          2,
+			"import":                                39,
+			"in":                                    102,
+			"including":                             2,
+			"indent":                                8,
+			"indented":                              1,
+			"infile":                                5,
+			"initial_indent":                        2,
+			"inp":                                   8,
+			"insort":                                1,
+			"insort_left":                           1,
+			"insort_right":                          2,
+			"instream":                              6,
+			"int":                                   15,
+			"invoke":                                6,
+			"invoke_per_goid":                       1,
+			"io":                                    1,
+			"is":                                    70,
+			"is.":                                   1,
+			"is_active":                             1,
+			"is_admin":                              1,
+			"is_attr":                               2,
+			"is_eface":                              3,
+			"is_iface":                              3,
+			"is_valid":                              1,
+			"isinstance":                            5,
+			"it":                                    5,
+			"item":                                  28,
+			"iter":                                  2,
+			"itertools":                             1,
+			"its":                                   1,
+			"itstack":                               2,
+			"itstack.append":                        1,
+			"itstack.pop":                           1,
+			"j":                                     20,
+			"join":                                  2,
+			"joined":                                1,
+			"k":                                     15,
+			"keepends":                              1,
+			"key":                                   20,
+			"keyword":                               2,
+			"klass":                                 6,
+			"klass.pattern":                         3,
+			"klass.pattern.match":                   3,
+			"kwargs":                                19,
+			"kws":                                   6,
+			"l":                                     15,
+			"l*s":                                   1,
+			"lastelt":                               6,
+			"leading":                               2,
+			"left":                                  1,
+			"len":                                   38,
+			"length":                                3,
+			"letter":                                2,
+			"lex":                                   2,
+			"lex.commenters":                        1,
+			"lex.whitespace_split":                  1,
+			"lexer":                                 2,
+			"lexer.get_token":                       8,
+			"lexer.instream.readline":               3,
+			"lexer.lineno":                          6,
+			"lexer.push_token":                      1,
+			"line":                                  9,
+			"line.split":                            1,
+			"lineno":                                8,
+			"lines":                                 21,
+			"lines.":                                3,
+			"lines.append":                          2,
+			"linked_list":                           1,
+			"linkfield":                             2,
+			"list":                                  5,
+			"literal_text":                          3,
+			"lo":                                    30,
+			"lock":                                  2,
+			"login":                                 5,
+			"lookup_type":                           2,
+			"m":                                     3,
+			"m1":                                    10,
+			"m2":                                    10,
+			"macro":                                 2,
+			"main":                                  2,
+			"make":                                  1,
+			"makematcher":                           2,
+			"manager":                               1,
+			"many":                                  1,
+			"map":                                   3,
+			"mapping":                               12,
+			"match":                                 5,
+			"matcher":                               2,
+			"max":                                   2,
+			"max_lines":                             2,
+			"maxc":                                  20,
+			"maxsize":                               7,
+			"merge":                                 1,
+			"mid":                                   16,
+			"min":                                   2,
+			"minc":                                  8,
+			"mo":                                    6,
+			"mo.group":                              18,
+			"mo.start":                              1,
+			"monotonic":                             2,
+			"more":                                  4,
+			"msg":                                   3,
+			"n":                                     16,
+			"n//2":                                  2,
+			"n2i":                                   7,
+			"n2i.get":                               1,
+			"name":                                  19,
+			"named":                                 11,
+			"namedtuple":                            2,
+			"names":                                 4,
+			"nargs":                                 1,
+			"netrc":                                 2,
+			"new":                                   3,
+			"newfile":                               14,
+			"newitem":                               10,
+			"newline":                               3,
+			"newstream":                             7,
+			"next":                                  1,
+			"nextchar":                              45,
+			"no":                                    5,
+			"node":                                  26,
+			"node2stacki":                           5,
+			"node_group":                            2,
+			"nodeinfo":                              2,
+			"nodeinfo.npredecessors":                3,
+			"nodeinfo.successors":                   1,
+			"nodes":                                 1,
+			"non":                                   1,
+			"normalized":                            1,
+			"not":                                   65,
+			"now":                                   4,
+			"nowhitespace":                          3,
+			"obj":                                   33,
+			"obj.type":                              6,
+			"object":                                2,
+			"octdigits":                             1,
+			"of":                                    12,
+			"only":                                  1,
+			"open":                                  5,
+			"or":                                    23,
+			"ord":                                   2,
+			"os":                                    3,
+			"os.fstat":                              1,
+			"os.getuid":                             2,
+			"os.name":                               1,
+			"os.path":                               1,
+			"os.path.dirname":                       1,
+			"os.path.expanduser":                    1,
+			"os.path.isabs":                         1,
+			"os.path.join":                          2,
+			"os.path.normcase":                      4,
+			"other":                                 3,
+			"p":                                     7,
+			"paragraph":                             6,
+			"paragraph.":                            2,
+			"paramtypematch":                        4,
+			"parent":                                6,
+			"parentpos":                             6,
+			"parse":                                 1,
+			"parser":                                1,
+			"parser.add_argument":                   2,
+			"parser.parse_args":                     1,
+			"pass":                                  14,
+			"password":                              3,
+			"pat":                                   24,
+			"pat.find":                              1,
+			"pat_str":                               2,
+			"patsearch":                             1,
+			"pattern":                               8,
+			"pattern.match":                         1,
+			"pc":                                    18,
+			"pc.cast":                               3,
+			"pc_to_int":                             3,
+			"placeholder":                           3,
+			"pop":                                   2,
+			"pop_source":                            1,
+			"pos":                                   28,
+			"posix":                                 6,
+			"posixpath":                             2,
+			"possible":                              1,
+			"pred":                                  2,
+			"pred_info":                             1,
+			"pred_info.successors.append":           1,
+			"predecessors":                          3,
+			"prepare":                               1,
+			"presenting":                            1,
+			"prev_line":                             2,
+			"prev_lineno":                           2,
+			"print":                                 24,
+			"print_function":                        1,
+			"printable":                             1,
+			"priority":                              5,
+			"prop":                                  1,
+			"prop.st_mode":                          1,
+			"prop.st_uid":                           2,
+			"provided":                              2,
+			"ptr":                                   26,
+			"ptr_array_group_type":                  2,
+			"ptr_array_ptr_table_type":              2,
+			"ptr_group_type":                        4,
+			"ptr_table_type":                        1,
+			"ptr_table_type.array":                  1,
+			"punctuation":                           3,
+			"punctuation_chars":                     9,
+			"push_source":                           1,
+			"push_token":                            2,
+			"put":                                   1,
+			"put_nowait":                            2,
+			"pwd":                                   1,
+			"pwd.getpwuid":                          2,
+			"q":                                     11,
+			"qsize":                                 2,
+			"qualified":                             1,
+			"quote":                                 2,
+			"quoted":                                9,
+			"quoting":                               1,
+			"r":                                     40,
+			"raise":                                 43,
+			"range":                                 5,
+			"rangec":                                11,
+			"raw":                                   8,
+			"rc":                                    6,
+			"re":                                    5,
+			"re.ASCII":                              1,
+			"re.VERBOSE":                            1,
+			"re.compile":                            9,
+			"re.escape":                             1,
+			"re.sub":                                1,
+			"read_runtime_const":                    12,
+			"read_token":                            1,
+			"recursion_depth":                       3,
+			"remaining":                             6,
+			"rep":                                   8,
+			"replace_whitespace":                    2,
+			"repr":                                  6,
+			"res":                                   10,
+			"res.append":                            2,
+			"res_str":                               2,
+			"rest":                                  2,
+			"result":                                19,
+			"result.append":                         4,
+			"return":                                169,
+			"returned":                              1,
+			"returning":                             2,
+			"returnitem":                            8,
+			"reversed":                              2,
+			"reversed_chunks":                       3,
+			"reversed_chunks.pop":                   1,
+			"rgb_to_hls":                            1,
+			"rgb_to_hsv":                            1,
+			"rgb_to_yiq":                            1,
+			"rightpos":                              8,
+			"roles":                                 1,
+			"run":                                   1,
+			"s":                                     24,
+			"s*":                                    1,
+			"s*f":                                   1,
+			"s.replace":                             2,
+			"s.split":                               1,
+			"safe_substitute":                       1,
+			"save_frame":                            1,
+			"save_frame.select":                     1,
+			"saved_lineno":                          2,
+			"scheduler":                             1,
+			"seen":                                  3,
+			"seen.add":                              1,
+			"selected":                              1,
+			"self":                                  135,
+			"self._count":                           1,
+			"self._count.acquire":                   1,
+			"self._count.release":                   1,
+			"self._find_cycle":                      1,
+			"self._get":                             1,
+			"self._get_nodeinfo":                    2,
+			"self._handle_long_word":                1,
+			"self._init":                            1,
+			"self._invalid":                         1,
+			"self._lock":                            5,
+			"self._nfinished":                       3,
+			"self._node2info":                       5,
+			"self._node2info.get":                   1,
+			"self._node2info.values":                1,
+			"self._npassedout":                      3,
+			"self._parse":                           2,
+			"self._punctuation_chars":               2,
+			"self._pushback_chars":                  2,
+			"self._pushback_chars.append":           2,
+			"self._pushback_chars.pop":              1,
+			"self._put":                             1,
+			"self._qsize":                           8,
+			"self._queue":                           8,
+			"self._queue.append":                    1,
+			"self._queue.popleft":                   1,
+			"self._queue.remove":                    1,
+			"self._read_char":                       4,
+			"self._ready_nodes":                     9,
+			"self._ready_nodes.append":              1,
+			"self._ready_nodes.clear":               1,
+			"self._security_check":                  1,
+			"self._sequence_generator":              2,
+			"self._vformat":                         2,
+			"self.add":                              1,
+			"self.all_tasks_done":                   3,
+			"self.all_tasks_done.notify_all":        1,
+			"self.all_tasks_done.wait":              1,
+			"self.break_long_words":                 2,
+			"self.break_on_hyphens":                 3,
+			"self.check_unused_args":                1,
+			"self.commenters":                       3,
+			"self.convert_field":                    1,
+			"self.debug":                            13,
+			"self.delayfunc":                        2,
+			"self.delimiter":                        2,
+			"self.done":                             1,
+			"self.drop_whitespace":                  4,
+			"self.email":                            2,
+			"self.enterabs":                         1,
+			"self.eof":                              6,
+			"self.escape":                           5,
+			"self.escapedquotes":                    2,
+			"self.expand_tabs":                      2,
+			"self.filename":                         1,
+			"self.filestack":                        2,
+			"self.filestack.appendleft":             1,
+			"self.filestack.popleft":                1,
+			"self.fix_sentence_endings":             1,
+			"self.format_field":                     1,
+			"self.get":                              2,
+			"self.get_field":                        1,
+			"self.get_ready":                        1,
+			"self.get_token":                        3,
+			"self.get_value":                        1,
+			"self.hosts":                            9,
+			"self.hosts.keys":                       1,
+			"self.how":                              2,
+			"self.infile":                           8,
+			"self.initial_indent":                   3,
+			"self.instream":                         6,
+			"self.instream.close":                   1,
+			"self.instream.read":                    2,
+			"self.instream.readline":                2,
+			"self.invoke_per_goid":                  1,
+			"self.is_active":                        2,
+			"self.len":                              1,
+			"self.lineno":                           11,
+			"self.macros":                           4,
+			"self.macros.keys":                      1,
+			"self.max_lines":                        5,
+			"self.maxsize":                          5,
+			"self.msg":                              1,
+			"self.mutex":                            7,
+			"self.name.title":                       1,
+			"self.node":                             1,
+			"self.not_empty":                        2,
+			"self.not_empty.notify":                 1,
+			"self.not_empty.wait":                   2,
+			"self.not_full":                         1,
+			"self.not_full.notify":                  1,
+			"self.not_full.wait":                    2,
+			"self.npredecessors":                    1,
+			"self.parse":                            1,
+			"self.pattern":                          4,
+			"self.pattern.finditer":                 2,
+			"self.pattern.sub":                      2,
+			"self.placeholder":                      4,
+			"self.placeholder.lstrip":               1,
+			"self.pop_source":                       1,
+			"self.posix":                            15,
+			"self.prepare":                          1,
+			"self.punctuation_chars":                5,
+			"self.push_source":                      1,
+			"self.pushback":                         4,
+			"self.pushback.append":                  1,
+			"self.pushback.appendleft":              2,
+			"self.pushback.pop":                     1,
+			"self.pushback.popleft":                 1,
+			"self.put":                              2,
+			"self.queue":                            8,
+			"self.queue.append":                     2,
+			"self.queue.pop":                        1,
+			"self.queue.popleft":                    1,
+			"self.quotes":                           6,
+			"self.read_token":                       2,
+			"self.replace_whitespace":               2,
+			"self.roles":                            1,
+			"self.sentence_end_re.search":           1,
+			"self.source":                           3,
+			"self.sourcehook":                       1,
+			"self.state":                            30,
+			"self.subsequent_indent":                3,
+			"self.successors":                       1,
+			"self.tabsize":                          2,
+			"self.template":                         6,
+			"self.timefunc":                         3,
+			"self.token":                            20,
+			"self.unfinished_tasks":                 5,
+			"self.unicode_whitespace_trans":         1,
+			"self.val":                              27,
+			"self.val.address":                      1,
+			"self.val.type":                         3,
+			"self.val.type.strip_typedefs":          1,
+			"self.vformat":                          1,
+			"self.whitespace":                       7,
+			"self.whitespace_split":                 3,
+			"self.width":                            4,
+			"self.wordchars":                        6,
+			"self.wordchars.maketrans":              1,
+			"self.wordchars.translate":              1,
+			"self.wordsep_re.split":                 1,
+			"self.wordsep_simple_re.split":          1,
+			"sentence_end_re":                       1,
+			"sep":                                   3,
+			"sequence":                              1,
+			"set":                                   2,
+			"shlex":                                 4,
+			"single":                                6,
+			"so":                                    2,
+			"solely":                                1,
+			"source":                                1,
+			"sourcehook":                            1,
+			"sp":                                    6,
+			"sp.cast":                               3,
+			"space.":                                3,
+			"space_left":                            5,
+			"spaces":                                1,
+			"spec":                                  3,
+			"split":                                 1,
+			"split_command":                         2,
+			"st":                                    2,
+			"stack":                                 4,
+			"stack.append":                          1,
+			"stack.pop":                             1,
+			"startpos":                              8,
+			"stat":                                  5,
+			"stat.S_IRWXG":                          1,
+			"stat.S_IRWXO":                          1,
+			"static_order":                          1,
+			"status":                                5,
+			"still":                                 1,
+			"str":                                   31,
+			"str.capitalize":                        1,
+			"string":                                5,
+			"string.":                               1,
+			"string.expandtabs":                     2,
+			"strings":                               1,
+			"sts":                                   1,
+			"sts.get":                               1,
+			"stuff":                                 14,
+			"stuff.replace":                         1,
+			"subsequent_indent":                     2,
+			"substitute":                            1,
+			"successor":                             3,
+			"successor_info":                        1,
+			"successor_info.npredecessors":          2,
+			"sum":                                   1,
+			"sumc":                                  2,
+			"sumc/2.0":                              1,
+			"super":                                 1,
+			"sval":                                  2,
+			"sval.cap":                              1,
+			"sval.len":                              1,
+			"sys":                                   3,
+			"sys.argv":                              2,
+			"sys.stderr":                            1,
+			"sys.stdin":                             2,
+			"sys.version":                           1,
+			"t":                                     13,
+			"t.code":                                1,
+			"t.startswith":                          1,
+			"t.target":                              1,
+			"table":                                 4,
+			"table.dereference":                     1,
+			"table_type":                            1,
+			"table_type.fields":                     1,
+			"table_type.pointer":                    1,
+			"tables":                                2,
+			"tabs":                                  3,
+			"tabsize":                               2,
+			"task_done":                             1,
+			"template":                              2,
+			"text":                                  13,
+			"text.expandtabs":                       1,
+			"text.translate":                        1,
+			"textwrap.shorten":                      2,
+			"tf":                                    1,
+			"tf.name":                               1,
+			"tf.type":                               1,
+			"than":                                  4,
+			"that":                                  2,
+			"the":                                   16,
+			"them":                                  1,
+			"then":                                  2,
+			"they":                                  1,
+			"thr":                                   1,
+			"thr.ptid":                              1,
+			"thr.switch":                            1,
+			"threading":                             2,
+			"threading.Condition":                   3,
+			"threading.Lock":                        1,
+			"threading.RLock":                       1,
+			"threading.Semaphore":                   1,
+			"time":                                  16,
+			"time.sleep":                            1,
+			"timefunc":                              4,
+			"timeout":                               12,
+			"timeout=":                              1,
+			"to":                                    15,
+			"to_string":                             5,
+			"tok":                                   6,
+			"token":                                 12,
+			"toplevel":                              1,
+			"translate":                             3,
+			"treated":                               1,
+			"triple":                                1,
+			"truncate":                              1,
+			"try":                                   23,
+			"tt":                                    16,
+			"tt.startswith":                         1,
+			"tuple":                                 1,
+			"type":                                  1,
+			"type_size":                             2,
+			"typed":                                 1,
+			"typename":                              5,
+			"types":                                 2,
+			"types.GenericAlias":                    2,
+			"typing":                                1,
+			"uintptr_size":                          2,
+			"unfinished":                            4,
+			"unicode_whitespace_trans":              1,
+			"up":                                    1,
+			"used":                                  1,
+			"used_args":                             6,
+			"used_args.add":                         1,
+			"user":                                  2,
+			"v":                                     13,
+			"v*":                                    3,
+			"val":                                   24,
+			"val.cast":                              1,
+			"val.type":                              2,
+			"val.type.target":                       1,
+			"value":                                 7,
+			"var":                                   3,
+			"variable":                              1,
+			"varname":                               2,
+			"vars":                                  1,
+			"vformat":                               1,
+			"vp":                                    9,
+			"where":                                 1,
+			"while":                                 36,
+			"whitespace":                            13,
+			"whitespace.":                           1,
+			"width":                                 12,
+			"width.":                                1,
+			"will":                                  2,
+			"with":                                  18,
+			"word":                                  2,
+			"word.lower":                            1,
+			"word_punct":                            2,
+			"wordchars":                             1,
+			"words":                                 1,
+			"wordsep_re":                            1,
+			"wordsep_simple_re":                     1,
+			"wrap":                                  1,
+			"wrapped":                               5,
+			"wrapping":                              2,
+			"x":                                     21,
+			"x.name":                                1,
+			"x.type":                                1,
+			"xrange":                                4,
+			"y":                                     10,
+			"yield":                                 10,
+			"yiq_to_rgb":                            1,
+			"{":                                     9,
+			"|":                                     2,
+			"}":                                     10,
+		},
+		"Ruby": map[string]int{
+			"#":                                    9,
+			"%":                                    10,
+			"&":                                    6,
+			"&&":                                   8,
+			"(":                                    203,
+			")":                                    199,
+			"*SERIALIZE_KEYS":                      1,
+			"*args":                                1,
+			"*path.split":                          1,
+			"*path_parts":                          2,
+			"+":                                    9,
+			"-":                                    8,
+			".":                                    1,
+			".b":                                   3,
+			".chomp.b":                             1,
+			".commit":                              1,
+			".compact":                             1,
+			".diff":                                1,
+			".each":                                2,
+			".exists":                              1,
+			".force":                               1,
+			".freeze":                              4,
+			".id.presence":                         1,
+			".include":                             2,
+			".join":                                1,
+			".map":                                 2,
+			".scan":                                1,
+			".scheme":                              2,
+			".select":                              2,
+			".strip":                               1,
+			".tap":                                 2,
+			".target":                              1,
+			".to_i":                                3,
+			".to_pem":                              1,
+			".to_s":                                2,
+			".utc":                                 2,
+			".value":                               2,
+			".with_branch":                         2,
+			"/":                                    5,
+			"//":                                   2,
+			"/m.freeze":                            1,
+			"<":                                    5,
+			"<<":                                   6,
+			"@author_email":                        3,
+			"@author_name":                         3,
+			"@authored_date":                       2,
+			"@binary":                              1,
+			"@binary.nil":                          1,
+			"@branch_name":                         1,
+			"@certs":                               3,
+			"@committed_date":                      2,
+			"@committer_email":                     3,
+			"@committer_name":                      3,
+			"@data":                                2,
+			"@data.bytesize":                       1,
+			"@git_user":                            1,
+			"@gitaly_client":                       1,
+			"@gitaly_repository":                   5,
+			"@gl_id":                               2,
+			"@gl_username":                         2,
+			"@gl_wiki":                             1,
+			"@global_hooks_path":                   1,
+			"@head":                                1,
+			"@id":                                  2,
+			"@interceptors":                        4,
+			"@items":                               1,
+			"@loaded_all_data":                     1,
+			"@loaded_size":                         2,
+			"@logger":                              1,
+			"@message":                             3,
+			"@name":                                2,
+			"@newrev":                              3,
+			"@oldrev":                              1,
+			"@options":                             4,
+			"@output":                              1,
+			"@output.dup":                          1,
+			"@parent_ids":                          2,
+			"@patches":                             1,
+			"@path":                                2,
+			"@raw_commit":                          2,
+			"@raw_commit.body.dup":                 1,
+			"@raw_commit.body_size.zero":           1,
+			"@raw_commit.subject.dup":              1,
+			"@raw_index":                           1,
+			"@ref":                                 1,
+			"@repository":                          11,
+			"@repository.rev_parse_target":         1,
+			"@repository_relative_path":            1,
+			"@request_kwargs":                      1,
+			"@rugged_commit":                       1,
+			"@safe_message":                        1,
+			"@shard_path":                          2,
+			"@storage":                             1,
+			"@user":                                1,
+			"@wiki.ref":                            2,
+			"A/*":                                  1,
+			"ACTIONS":                              1,
+			"ACTION_OPTIONS":                       1,
+			"ActiveRecord":                         1,
+			"ArgumentError":                        1,
+			"Array":                                1,
+			"BEGIN":                                1,
+			"Blob":                                 2,
+			"Blob.batch_lfs_pointers":              2,
+			"BlobHelper":                           1,
+			"Branch":                               1,
+			"Bundler.with_clean_env":               1,
+			"CERTIFICATE":                          2,
+			"CLIENT_NAME":                          2,
+			"Certificate.new":                      1,
+			"ChannelCredentials.new":               1,
+			"Class.new":                            1,
+			"ClientInterceptor.instance":           1,
+			"Collections":                          1,
+			"CommandError":                         1,
+			"Commit":                               7,
+			"Commit.extract_signature":             1,
+			"Commit.new":                           1,
+			"CommitAuthor.new":                     1,
+			"CommitPatches":                        1,
+			"CommitService":                        1,
+			"Committer":                            1,
+			"CommitterWithHooks":                   1,
+			"Core":                                 1,
+			"DEFAULT_MODE":                         1,
+			"Digest":                               1,
+			"Dir":                                  1,
+			"END":                                  1,
+			"ENV":                                  5,
+			"EPIPE":                                1,
+			"EXECUTE_MODE":                         1,
+			"EncodingHelper":                       2,
+			"EncodingHelper.detect_libgit2_binary": 1,
+			"Enumerable":                           1,
+			"Errno":                                1,
+			"Error":                                1,
+			"File.exist":                           1,
+			"File.join":                            3,
+			"File.read":                            2,
+			"FindCommitRequest.new":                1,
+			"Forwardable":                          1,
+			"GL_PROTOCOL":                          2,
+			"GRPC":                                 3,
+			"Git":                                  30,
+			"GitCommit":                            2,
+			"GitCommit.new":                        1,
+			"Gitaly":                               12,
+			"GitalyRemoteRepository":               1,
+			"GitalyServer.client":                  1,
+			"GitalyServer.storage_path":            1,
+			"Gitlab":                               34,
+			"Gitlab.config.git.bin_path":           2,
+			"Gitlab.config.git.hooks_directory":    1,
+			"Gitlab.config.gitaly.bin_dir":         1,
+			"Gitlab.config.gitaly.ruby_dir":        1,
+			"Gitlab.config.gitlab_shell.path":      3,
+			"Gitlab.config.logging.dir":            1,
+			"Gitlab.config.logging.format":         1,
+			"Gitlab.config.logging.level":          1,
+			"GitlabProjects":                       1,
+			"GitlabProjects.new":                   1,
+			"GlId.gl_id_from_id_value":             1,
+			"Gollum":                               1,
+			"Google":                               1,
+			"HMAC.hexdigest":                       1,
+			"HasLocalBranchesRequest.new":          1,
+			"Hash":                                 1,
+			"Hook":                                 1,
+			"Hook.directory":                       1,
+			"Hook.new":                             1,
+			"HooksService":                         1,
+			"HttpAuth":                             1,
+			"Index":                                1,
+			"IndexError":                           1,
+			"InvalidArgument":                      1,
+			"InvalidError":                         1,
+			"LFS_POINTER_MAX_SIZE":                 3,
+			"LFS_POINTER_MIN_SIZE":                 2,
+			"Labkit":                               2,
+			"LfsChanges":                           1,
+			"Linguist":                             1,
+			"MAX_COMMIT_MESSAGE_DISPLAY_SIZE":      1,
+			"MAX_DATA_DISPLAY_SIZE":                2,
+			"MIN_SHA_LENGTH":                       1,
+			"Migrator.migrate":                     1,
+			"NoRepository":                         1,
+			"ObjectError":                          1,
+			"OdbError":                             2,
+			"Open3.capture3":                       1,
+			"Open3.popen3":                         1,
+			"OpenSSL":                              4,
+			"OpenSSLError":                         1,
+			"OperationError":                       1,
+			"OperationService.new":                 2,
+			"PEM_REXP":                             2,
+			"Popen":                                1,
+			"PreReceiveError":                      2,
+			"Protobuf":                             1,
+			"Rails.logger":                         1,
+			"Rails.logger.error":                   1,
+			"Rake":                                 1,
+			"Ref":                                  1,
+			"Ref.dereference_object":               1,
+			"RefExistsRequest.new":                 1,
+			"RefService":                           1,
+			"ReferenceError":                       2,
+			"RemoteRepository":                     1,
+			"Repository":                           1,
+			"Repository.from_gitaly":               1,
+			"RepositoryExistsRequest.new":          1,
+			"RepositoryService":                    2,
+			"RevList.new":                          1,
+			"Rugged":                               13,
+			"SERIALIZE_KEYS":                       2,
+			"SHA256.new":                           1,
+			"Stack":                                1,
+			"StandardError":                        1,
+			"String":                               1,
+			"StringIO.new":                         1,
+			"StrongMemoize":                        1,
+			"Stub.new":                             4,
+			"TestTask.new":                         1,
+			"Time.at":                              2,
+			"Time.now.to_i.to_s":                   1,
+			"Timestamp.new":                        1,
+			"Tracing":                              1,
+			"Tracing.enabled":                      1,
+			"TreeError":                            1,
+			"URI":                                  3,
+			"URI.parse":                            1,
+			"User.new":                             1,
+			"Utils":                                1,
+			"W":                                    3,
+			"Wiki":                                 1,
+			"X509":                                 1,
+			"[":                                    67,
+			"]":                                    67,
+			"_start_commit":                        1,
+			"_t":                                   1,
+			"a":                                    1,
+			"action":                               3,
+			"addr":                                 4,
+			"addr.sub":                             1,
+			"address":                              5,
+			"alias_method":                         1,
+			"all_pointers":                         1,
+			"apply":                                1,
+			"args":                                 3,
+			"attr_accessor":                        4,
+			"attr_reader":                          8,
+			"attr_writer":                          1,
+			"author":                               5,
+			"author_email":                         2,
+			"author_name":                          2,
+			"author_or_committer":                  4,
+			"authored_date":                        1,
+			"authorization_token":                  1,
+			"batch_lfs_pointers":                   1,
+			"begin":                                6,
+			"binary":                               6,
+			"blob":                                 5,
+			"blob.binary":                          2,
+			"blob.content":                         2,
+			"blob.is_a":                            1,
+			"blob.oid":                             2,
+			"blob.size":                            2,
+			"blob_entry":                           10,
+			"blob_ids":                             1,
+			"blob_ids.lazy":                        1,
+			"body":                                 1,
+			"branch_exists":                        1,
+			"branch_name":                          7,
+			"branch_names":                         2,
+			"branch_names.map":                     1,
+			"branches":                             1,
+			"bytes":                                2,
+			"call":                                 6,
+			"call_receive_hook":                    2,
+			"call_update_hook":                     2,
+			"case":                                 2,
+			"cert":                                 2,
+			"cert_file":                            2,
+			"certs":                                2,
+			"changes":                              1,
+			"changes.lines":                        1,
+			"chdir":                                2,
+			"chmod":                                1,
+			"class":                                15,
+			"cmd":                                  8,
+			"commit":                               13,
+			"commit.author":                        1,
+			"commit.author.date.seconds":           1,
+			"commit.author.email.dup":              1,
+			"commit.author.name.dup":               1,
+			"commit.committer":                     1,
+			"commit.committer.date.seconds":        1,
+			"commit.committer.email.dup":           1,
+			"commit.committer.name.dup":            1,
+			"commit.id":                            1,
+			"commit.message":                       1,
+			"commit.oid":                           1,
+			"commit.parent_ids":                    1,
+			"commit.parents.map":                   1,
+			"commit_id":                            13,
+			"commit_id.include":                    1,
+			"commit_id.is_a":                       3,
+			"committed_date":                       2,
+			"committer":                            5,
+			"committer_email":                      2,
+			"committer_name":                       2,
+			"content":                              1,
+			"create":                               1,
+			"create_dir":                           1,
+			"credentials":                          5,
+			"data":                                 8,
+			"data.match":                           2,
+			"data.start_with":                      1,
+			"date":                                 2,
+			"db":                                   1,
+			"decorate":                             3,
+			"def":                                  100,
+			"def_delegators":                       1,
+			"default":                              1,
+			"delegate":                             1,
+			"delete":                               1,
+			"delete_remote_branches":               1,
+			"desc":                                 1,
+			"diff":                                 1,
+			"diff.find_similar":                    1,
+			"diff.patch":                           1,
+			"do":                                   22,
+			"e":                                    2,
+			"else":                                 6,
+			"email":                                5,
+			"empty":                                3,
+			"encode":                               8,
+			"encoding":                             1,
+			"end":                                  166,
+			"end.compact":                          1,
+			"end.uniq.join":                        1,
+			"ength":                                1,
+			"ensure":                               1,
+			"entry":                                8,
+			"env":                                  6,
+			"env_base_vars":                        3,
+			"environment":                          1,
+			"err_message":                          4,
+			"err_message.blank":                    1,
+			"execute":                              1,
+			"execute_filemode":                     1,
+			"exists":                               4,
+			"exit_message":                         3,
+			"exit_status":                          4,
+			"extend":                               1,
+			"external_size":                        1,
+			"external_storage":                     1,
+			"extra_env":                            1,
+			"f":                                    1,
+			"false":                                5,
+			"fetch_remote":                         1,
+			"fetch_remote_command":                 1,
+			"file_path":                            1,
+			"filemode":                             1,
+			"files":                                3,
+			"files.flat_map":                       1,
+			"files.sort":                           1,
+			"find":                                 2,
+			"find_entry_by_path":                   3,
+			"force":                                4,
+			"get":                                  1,
+			"git_all_pointers":                     2,
+			"git_new_pointers":                     2,
+			"git_user":                             2,
+			"gitaly_client":                        1,
+			"gitaly_client.address":                2,
+			"gitaly_client.token":                  1,
+			"gitaly_commit_author_from_rugged":     3,
+			"gitaly_repository":                    3,
+			"gitaly_repository.relative_path":      1,
+			"gitaly_repository.storage_name":       1,
+			"gitlab_id":                            2,
+			"gl_id":                                9,
+			"gl_username":                          9,
+			"gl_wiki":                              3,
+			"gl_wiki.gollum_wiki":                  1,
+			"gl_wiki.repository":                   1,
+			"global_hooks_path":                    4,
+			"has_lfs_version_key":                  4,
+			"has_visible_content":                  2,
+			"hash":                                 3,
+			"hash.symbolize_keys":                  1,
+			"head":                                 3,
+			"hmac":                                 1,
+			"hook":                                 1,
+			"hook.trigger":                         1,
+			"hook_name":                            2,
+			"i":                                    1,
+			"id":                                   11,
+			"id.to_s":                              1,
+			"if":                                   24,
+			"include":                              6,
+			"infer_content":                        1,
+			"init_from_gitaly":                     2,
+			"init_from_hash":                       2,
+			"init_from_rugged":                     2,
+			"initialize":                           11,
+			"interceptors":                         2,
+			"io":                                   1,
+			"io.read":                              1,
+			"io.rewind":                            1,
+			"issued_at":                            2,
+			"key":                                  9,
+			"key.to_sym":                           1,
+			"len":                                  1,
+			"length":                               1,
+			"lfs":                                  1,
+			"lfs_oid":                              1,
+			"lfs_oid.present":                      1,
+			"lfs_pointer":                          3,
+			"lfs_size":                             2,
+			"lfs_size.present":                     1,
+			"limit":                                5,
+			"limit.zero":                           1,
+			"line":                                 2,
+			"loaded_size":                          2,
+			"logger":                               4,
+			"logger.error":                         3,
+			"logger.info":                          3,
+			"megabytes":                            2,
+			"merge_commit":                         1,
+			"message":                              7,
+			"message_from_gitaly_body":             2,
+			"message_split":                        3,
+			"metadata":                             3,
+			"migrate":                              1,
+			"mode":                                 3,
+			"module":                               25,
+			"move":                                 1,
+			"name":                                 24,
+			"namespace":                            1,
+			"new":                                  3,
+			"new_pointers":                         1,
+			"newrev":                               15,
+			"nil":                                  18,
+			"no_commit_message":                    1,
+			"not_in":                               5,
+			"o100644":                              1,
+			"o100755":                              1,
+			"obj":                                  2,
+			"obj.is_a":                             1,
+			"object_header":                        3,
+			"object_ids":                           5,
+			"object_ids.take":                      1,
+			"object_limit":                         5,
+			"oid":                                  9,
+			"oldrev":                               11,
+			"options":                              12,
+			"options.slice":                        1,
+			"other":                                1,
+			"other.id":                             1,
+			"other.is_a":                           1,
+			"output":                               1,
+			"params":                               9,
+			"params.http_authorization_header.present": 1,
+			"params.present":                 1,
+			"parent_ids":                     2,
+			"parent_ids.map":                 1,
+			"parent_ids.size":                1,
+			"parents":                        1,
+			"patches":                        4,
+			"path":                           17,
+			"path.sub":                       1,
+			"path_parts":                     1,
+			"path_parts.shift":               1,
+			"path_parts.size":                1,
+			"possible_lfs_blob":              2,
+			"pre":                            1,
+			"previous_path":                  1,
+			"private":                        8,
+			"protected":                      1,
+			"prune":                          2,
+			"public_send":                    1,
+			"push":                           2,
+			"push_branches":                  1,
+			"pusher":                         1,
+			"pusher.gl_id":                   1,
+			"pusher.username":                1,
+			"puts":                           1,
+			"r":                              2,
+			"raise":                          7,
+			"raw_commit":                     11,
+			"raw_commit.author":              1,
+			"raw_commit.committer":           1,
+			"raw_commit.is_a":                2,
+			"raw_commit.message.b":           1,
+			"raw_commit.message.split":       1,
+			"raw_commit.oid":                 1,
+			"raw_commit.parent_ids":          1,
+			"raw_index":                      2,
+			"read_tree":                      1,
+			"receive":                        1,
+			"ref":                            14,
+			"remote_name":                    2,
+			"remote_params":                  1,
+			"remote_params.url":              1,
+			"repo":                           6,
+			"repo.rev_parse_target":          1,
+			"repo.rugged.config":             1,
+			"repo.rugged.config.delete":      1,
+			"repo_path":                      5,
+			"repository":                     34,
+			"repository.commit_patches":      1,
+			"repository.find_branch":         1,
+			"repository.gl_repository":       1,
+			"repository.lookup":              4,
+			"repository.path":                1,
+			"repository.root_ref":            1,
+			"repository.rugged":              1,
+			"repository.rugged.index":        1,
+			"repository.rugged.read_header":  1,
+			"repository_absolute_path":       5,
+			"repository_relative_path":       4,
+			"request":                        9,
+			"request.remote_params":          1,
+			"request.repository":             1,
+			"request_kwargs":                 5,
+			"require":                        4,
+			"require_path":                   1,
+			"require_relative":               1,
+			"rescue":                         7,
+			"result":                         2,
+			"retrieve_error_message":         2,
+			"return":                         19,
+			"rev_list":                       1,
+			"rev_list.all_objects":           1,
+			"rev_list.new_objects":           1,
+			"rev_list_params":                3,
+			"reverse":                        1,
+			"revision":                       2,
+			"revision.b":                     1,
+			"root_id":                        2,
+			"root_tree":                      2,
+			"root_tree.find":                 1,
+			"root_tree.oid":                  1,
+			"rugged_commit":                  3,
+			"rugged_commit.diff":             1,
+			"rugged_commit.parents":          1,
+			"rugged_commit.parents.empty":    1,
+			"rugged_commit.tree":             1,
+			"rugged_find":                    2,
+			"rugged_raw":                     2,
+			"run":                            2,
+			"run_hook":                       3,
+			"run_with_timeout":               2,
+			"safe_message":                   1,
+			"seconds":                        1,
+			"self":                           3,
+			"self.__send__":                  1,
+			"self.class.directory":           1,
+			"self.class.find":                1,
+			"self.class.size_could_be_lfs":   1,
+			"self.directory":                 1,
+			"self.from_gitaly":               2,
+			"self.legacy_hooks_directory":    1,
+			"self.validate_remote_params":    1,
+			"send":                           2,
+			"serialize_keys":                 1,
+			"serialize_keys.each":            1,
+			"serialize_keys.map.with_object": 1,
+			"sha":                            17,
+			"sha256":                         1,
+			"shard_path":                     4,
+			"shas":                           1,
+			"shas.select":                    1,
+			"shas_with_signatures":           1,
+			"short_id":                       1,
+			"size":                           15,
+			"size.between":                   1,
+			"size_could_be_lfs":              2,
+			"start_branch_name":              1,
+			"start_point":                    2,
+			"status":                         3,
+			"status.success":                 1,
+			"stderr":                         4,
+			"stderr.presence":                1,
+			"stderr.read":                    1,
+			"stdin":                          1,
+			"stdin.close":                    1,
+			"stdin.puts":                     1,
+			"stdin.sync":                     1,
+			"stdout":                         5,
+			"stdout.read":                    1,
+			"storage":                        3,
+			"storage_path":                   2,
+			"strong_memoize":                 1,
+			"stub":                           4,
+			"stub.find_commit":               1,
+			"stub.has_local_branches":        1,
+			"stub.ref_exists":                1,
+			"stub.repository_exists":         1,
+			"subject":                        1,
+			"submodule_blob":                 2,
+			"success":                        8,
+			"super":                          4,
+			"t":                              1,
+			"t.libs":                         1,
+			"t.pattern":                      1,
+			"tags":                           2,
+			"target":                         2,
+			"target_commit":                  2,
+			"task":                           3,
+			"tcp":                            2,
+			"test":                           2,
+			"text":                           1,
+			"this_channel_is_insecure":       1,
+			"time":                           3,
+			"timeout":                        4,
+			"tls":                            2,
+			"to":                             1,
+			"to_diff":                        1,
+			"to_gitaly_commit":               1,
+			"to_hash":                        1,
+			"token":                          2,
+			"tree":                           1,
+			"trigger":                        1,
+			"true":                           7,
+			"truncated":                      1,
+			"type":                           4,
+			"unless":                         18,
+			"update":                         2,
+			"user":                           4,
+			"user.git_env":                   1,
+			"user_id":                        1,
+			"username":                       1,
+			"validate_action":                1,
+			"validate_remote_params":         1,
+			"vars":                           4,
+			"version":                        3,
+			"w":                              4,
+			"wait_thr":                       1,
+			"wait_thr.value":                 1,
+			"when":                           5,
+			"yield":                          4,
+			"{":                              21,
+			"|":                              41,
+			"||":                             9,
+			"}":                              21,
+		},
+		"Rust": map[string]int{
+			"#":                                 65,
+			"&":                                 70,
+			"&&":                                2,
+			"(":                                 585,
+			")":                                 584,
+			"*":                                 4,
+			"*const":                            1,
+			"*mut":                              2,
+			"*self":                             1,
+			"*self.kind":                        1,
+			"+":                                 7,
+			"-":                                 119,
+			"..len":                             1,
+			"..self.end":                        1,
+			".alphabet_len":                     1,
+			".checked_sub":                      1,
+			".collect":                          11,
+			".contains":                         1,
+			".copied":                           1,
+			".end":                              1,
+			".enumerate":                        2,
+			".expect":                           11,
+			".is_empty":                         1,
+			".len":                              2,
+			".lines":                            1,
+			".map":                              2,
+			".next_power_of_two":                1,
+			".trailing_zeros":                   1,
+			".unwrap":                           4,
+			".unwrap_or":                        1,
+			"/":                                 2,
+			"//":                                4,
+			"///":                               40,
+			";":                                 226,
+			"<":                                 12,
+			"<$ty,>":                            1,
+			"<'_>":                              4,
+			"<'a>":                              7,
+			"<'de>":                             6,
+			"<()>":                              1,
+			"<(u8,>":                            2,
+			"<<":                                5,
+			"<=>":                               1,
+			"<D>":                               1,
+			"<E>":                               4,
+			"<F,>":                              2,
+			"<MatchErrorKind>":                  1,
+			"<R:>":                              1,
+			"<S::Ok,>":                          1,
+			"<S>":                               1,
+			"<Self::Value,>":                    5,
+			"<StateID>":                         1,
+			"<String,>":                         1,
+			"<String>":                          1,
+			"<T>":                               6,
+			"<V>":                               1,
+			"<_>":                               9,
+			"<bool>":                            1,
+			"<f64>":                             1,
+			"<u8>":                              6,
+			"<usize>":                           1,
+			"AtomicMut":                         3,
+			"AtomicPtr":                         4,
+			"AtomicUsize":                       3,
+			"BitSet":                            4,
+			"Box":                               2,
+			"BufRead":                           1,
+			"BufReader":                         2,
+			"Buffer":                            4,
+			"BuildError":                        10,
+			"ByteClassElementRanges":            4,
+			"ByteClassElements":                 5,
+			"ByteClassIter":                     4,
+			"ByteClassSet":                      10,
+			"ByteClasses":                       11,
+			"ByteSet":                           7,
+			"Bytes":                             2,
+			"BytesMut":                          2,
+			"BytesMutVisitor":                   1,
+			"BytesVisitor":                      1,
+			"Clone":                             10,
+			"Copy":                              3,
+			"D":                                 2,
+			"DEFAULT_BUFFER_CAPACITY":           2,
+			"Debug":                             18,
+			"DebugByte":                         2,
+			"Default":                           3,
+			"Deserialize":                       2,
+			"Deserializer":                      2,
+			"Display":                           3,
+			"E":                                 4,
+			"Env":                               4,
+			"Eq":                                4,
+			"Error":                             6,
+			"ErrorKind":                         8,
+			"F":                                 4,
+			"File":                              2,
+			"Fn":                                2,
+			"FnOnce":                            2,
+			"Formatter":                         7,
+			"HashMap":                           3,
+			"I32":                               2,
+			"I64":                               2,
+			"I8":                                2,
+			"Ident":                             2,
+			"IndexMapper":                       4,
+			"InvalidInputAnchored":              3,
+			"InvalidInputUnanchored":            3,
+			"Item":                              3,
+			"Iterator":                          3,
+			"MAX.as_usize":                      1,
+			"MatchError":                        16,
+			"MatchErrorKind":                    13,
+			"MatchKind":                         5,
+			"NFA":                               4,
+			"None":                              4,
+			"Number":                            2,
+			"Ok":                                8,
+			"Op":                                2,
+			"Option":                            5,
+			"Ordering":                          3,
+			"PartialEq":                         5,
+			"PatternID":                         3,
+			"PatternIDOverflow":                 3,
+			"PatternTooLong":                    3,
+			"Pointer":                           2,
+			"R":                                 5,
+			"Range":                             1,
+			"RangeInclusive":                    1,
+			"Remappable":                        5,
+			"Remapper":                          4,
+			"Result":                            16,
+			"S":                                 2,
+			"Self":                              2,
+			"SeqAccess":                         1,
+			"Serialize":                         2,
+			"Serializer":                        2,
+			"SmallIndex":                        2,
+			"Some":                              10,
+			"Special":                           4,
+			"StateID":                           23,
+			"StateIDOverflow":                   3,
+			"String":                            3,
+			"T":                                 3,
+			"Token":                             5,
+			"U16":                               2,
+			"U32":                               2,
+			"U64":                               2,
+			"U8":                                2,
+			"UnsupportedEmpty":                  3,
+			"UnsupportedOverlapping":            3,
+			"UnsupportedStream":                 3,
+			"Usize":                             3,
+			"V":                                 2,
+			"Value":                             1,
+			"Vec":                               19,
+			"Visitor":                           1,
+			"ZERO":                              4,
+			"[":                                 100,
+			"]":                                 100,
+			"add":                               1,
+			"all":                               2,
+			"alloc":                             7,
+			"allow":                             1,
+			"alphabet":                          1,
+			"alphabet_len":                      1,
+			"args":                              2,
+			"args.get":                          1,
+			"as":                                25,
+			"as_u16":                            2,
+			"as_u32":                            2,
+			"as_u64":                            2,
+			"as_u8":                             2,
+			"as_usize":                          16,
+			"ascii":                             1,
+			"assert":                            1,
+			"assert_eq":                         41,
+			"atomic":                            4,
+			"b":                                 58,
+			"b.checked_add":                     1,
+			"bit":                               4,
+			"bits":                              3,
+			"bool":                              2,
+			"boxed":                             2,
+			"break":                             2,
+			"bucket":                            4,
+			"buf":                               2,
+			"buffer":                            2,
+			"byte":                              13,
+			"byte_classes":                      2,
+			"byte_frequencies":                  1,
+			"bytes":                             5,
+			"c":                                 2,
+			"capacity":                          2,
+			"cfg":                               27,
+			"char":                              1,
+			"class":                             14,
+			"class.as_u8":                       1,
+			"class.checked_add":                 1,
+			"classes":                           11,
+			"classes.alphabet_len":              3,
+			"classes.elements":                  9,
+			"classes.get":                       19,
+			"classes.set":                       2,
+			"cmp":                               4,
+			"collections":                       1,
+			"const":                             1,
+			"contains":                          1,
+			"continue":                          1,
+			"copy_from_slice":                   1,
+			"core":                              24,
+			"count":                             3,
+			"crate":                             77,
+			"cur_id":                            3,
+			"de":                                7,
+			"dead_code":                         1,
+			"debug":                             1,
+			"debug_assert":                      1,
+			"debug_assertions":                  18,
+			"default":                           1,
+			"derive":                            16,
+			"deserialize":                       1,
+			"deserializer":                      1,
+			"deserializer.deserialize_byte_buf": 1,
+			"element":                           9,
+			"element_ranges":                    1,
+			"elements":                          25,
+			"elements.len":                      4,
+			"elements_empty":                    1,
+			"elements_singletons":               1,
+			"elements_typical":                  1,
+			"else":                              2,
+			"empty":                             12,
+			"end":                               10,
+			"enum":                              3,
+			"env":                               3,
+			"env.get":                           1,
+			"env.set":                           1,
+			"error":                             3,
+			"escape_default":                    1,
+			"expecting":                         1,
+			"extra_platforms":                   1,
+			"f":                                 30,
+			"f.debug_set":                       1,
+			"f64":                               2,
+			"false":                             1,
+			"feature":                           5,
+			"file":                              2,
+			"fill":                              1,
+			"fmt":                               29,
+			"fmtd":                              1,
+			"fmtd.entry":                        1,
+			"fmtd.finish":                       1,
+			"fn":                                129,
+			"for":                               34,
+			"formatter":                         1,
+			"formatter.write_str":               1,
+			"free_buffer":                       1,
+			"from":                              11,
+			"from_bits":                         6,
+			"from_slice":                        3,
+			"from_utf8":                         1,
+			"from_vec":                          5,
+			"fs":                                1,
+			"full_byte_classes":                 1,
+			"get":                               2,
+			"got":                               10,
+			"high_u16":                          2,
+			"high_u32":                          2,
+			"high_u8":                           2,
+			"i":                                 10,
+			"i32":                               4,
+			"i64":                               4,
+			"i8":                                4,
+			"id":                                4,
+			"id.as_usize":                       1,
+			"id1":                               7,
+			"id2":                               7,
+			"ident":                             4,
+			"idx":                               3,
+			"idx.to_state_id":                   1,
+			"if":                                16,
+			"impl":                              41,
+			"in":                                8,
+			"index":                             2,
+			"inline":                            14,
+			"int":                               2,
+			"invalid_input_anchored":            1,
+			"invalid_input_unanchored":          1,
+			"io":                                3,
+			"is_singleton":                      1,
+			"it":                                2,
+			"iter":                              1,
+			"kind":                              7,
+			"len":                               10,
+			"let":                               53,
+			"line":                              3,
+			"line.trim":                         1,
+			"loom":                              3,
+			"loop":                              4,
+			"low_u16":                           4,
+			"low_u32":                           2,
+			"low_u8":                            6,
+			"macro_rules":                       1,
+			"main":                              1,
+			"map":                               6,
+			"match":                             5,
+			"max":                               12,
+			"max_match_id":                      2,
+			"max_special_id":                    2,
+			"min":                               5,
+			"min_buffer_len":                    3,
+			"mod":                               17,
+			"mut":                               50,
+			"n":                                 11,
+			"name":                              3,
+			"name.to_string":                    1,
+			"new":                               13,
+			"new_id":                            5,
+			"new_unchecked":                     1,
+			"next":                              3,
+			"nfa":                               1,
+			"non_exhaustive":                    1,
+			"noncontiguous":                     5,
+			"not":                               11,
+			"oldmap":                            3,
+			"open":                              1,
+			"ops":                               2,
+			"path":                              2,
+			"pattern":                           4,
+			"pattern.as_usize":                  1,
+			"pattern_id_overflow":               1,
+			"pattern_too_long":                  1,
+			"prefilter":                         1,
+			"primitives":                        4,
+			"println":                           1,
+			"pub":                               88,
+			"r":                                 3,
+			"r.remap":                           1,
+			"r.state_len":                       2,
+			"r.swap_states":                     1,
+			"range":                             2,
+			"rdr":                               1,
+			"rdr.read":                          1,
+			"readany":                           3,
+			"readlen":                           3,
+			"remap":                             4,
+			"remapper":                          1,
+			"requested_max":                     10,
+			"return":                            7,
+			"roll":                              1,
+			"roll_end":                          2,
+			"roll_start":                        2,
+			"roll_start..roll_end":              1,
+			"s":                                 2,
+			"search":                            2,
+			"self":                              138,
+			"self.0":                            6,
+			"self.0.add":                        2,
+			"self.0.contains":                   1,
+			"self.alphabet_len":                 3,
+			"self.bits.0":                       2,
+			"self.buf":                          2,
+			"self.buf.copy_within":              1,
+			"self.buffer":                       1,
+			"self.bytes.next":                   1,
+			"self.class":                        1,
+			"self.classes.get":                  1,
+			"self.element_ranges":               1,
+			"self.elements":                     1,
+			"self.elements.next":                1,
+			"self.end":                          3,
+			"self.end..":                        1,
+			"self.free_buffer":                  1,
+			"self.get_mut":                      1,
+			"self.idx.to_index":                 4,
+			"self.idx.to_state_id":              1,
+			"self.is_singleton":                 1,
+			"self.it.next":                      1,
+			"self.iter":                         1,
+			"self.kind":                         1,
+			"self.map":                          2,
+			"self.map.clone":                    1,
+			"self.map.swap":                     1,
+			"self.min":                          5,
+			"self.range":                        3,
+			"self.range.take":                   2,
+			"self.stride2":                      3,
+			"self.vars.get":                     1,
+			"self.vars.insert":                  1,
+			"seq":                               1,
+			"seq.next_element":                  1,
+			"seq.size_hint":                     1,
+			"serde":                             1,
+			"serde_impl":                        3,
+			"serialize":                         1,
+			"serializer":                        1,
+			"serializer.serialize_bytes":        1,
+			"set":                               6,
+			"set.byte_classes":                  4,
+			"set.set_range":                     7,
+			"set_and_get":                       1,
+			"set_range":                         1,
+			"sid":                               2,
+			"singletons":                        2,
+			"size":                              1,
+			"special":                           1,
+			"start":                             10,
+			"start_anchored_id":                 2,
+			"start_unanchored_id":               2,
+			"state_id_overflow":                 1,
+			"state_len":                         2,
+			"states":                            1,
+			"std":                               8,
+			"str":                               4,
+			"stride":                            1,
+			"stride2":                           4,
+			"string":                            1,
+			"struct":                            16,
+			"super":                             3,
+			"swap":                              1,
+			"swap_states":                       3,
+			"sync":                              4,
+			"test":                              10,
+			"tests":                             2,
+			"to_bits":                           6,
+			"to_index":                          1,
+			"to_state_id":                       1,
+			"trait":                             12,
+			"true":                              2,
+			"try_from":                          10,
+			"ty":                                9,
+			"type":                              4,
+			"u128":                              1,
+			"u16":                               14,
+			"u32":                               16,
+			"u64":                               18,
+			"u8":                                42,
+			"unsupported_empty":                 1,
+			"unsupported_overlapping":           1,
+			"unsupported_stream":                1,
+			"use":                               22,
+			"usize":                             54,
+			"util":                              4,
+			"v":                                 6,
+			"v.as_bytes":                        1,
+			"v.into_bytes":                      1,
+			"value":                             4,
+			"values":                            2,
+			"values.push":                       1,
+			"vars":                              2,
+			"vec":                               12,
+			"visit_byte_buf":                    1,
+			"visit_bytes":                       1,
+			"visit_seq":                         1,
+			"visit_str":                         1,
+			"visit_string":                      1,
+			"visitor_ty":                        4,
+			"where":                             9,
+			"while":                             2,
+			"with_capacity":                     1,
+			"with_mut":                          2,
+			"write":                             21,
+			"zero":                              1,
+			"zeros":                             2,
+			"{":                                 273,
+			"|":                                 7,
+			"}":                                 273,
+		},
+		"Shell": map[string]int{
+			"#":                                 33,
+			"%":                                 6,
+			"&":                                 26,
+			"&&":                                4,
+			"(":                                 22,
+			")":                                 38,
+			"*":                                 2,
+			"*.tar.gz":                          1,
+			"*CYGWIN*":                          1,
+			"*MINGW*":                           1,
+			"*WIN32*":                           1,
+			"+":                                 4,
+			"-":                                 111,
+			".":                                 6,
+			"..":                                3,
+			"../bin/go":                         5,
+			"./cmd/dist":                        1,
+			"./cmd/dist/dist":                   3,
+			"./make.bash":                       4,
+			"./runtime/runtime_defs.go":         1,
+			".git":                              2,
+			".gitignore":                        1,
+			"/backup":                           1,
+			"/bin":                              1,
+			"/dev/null":                         8,
+			"/dist":                             1,
+			"/etc/shells":                       1,
+			"/etc/systemd/system/":              1,
+			"/go":                               1,
+			"/nonexist":                         1,
+			"/selinux":                          1,
+			"/sys/fs/selinux":                   1,
+			"/usr/local/bin/fish":               1,
+			"/usr/sbin/selinuxenabled":          2,
+			";":                                 84,
+			"Bootstrap":                         1,
+			"Building":                          1,
+			"C":                                 2,
+			"CC":                                1,
+			"CDPATH":                            1,
+			"CMD":                               3,
+			"DEST":                              3,
+			"DIR":                               3,
+			"DIR/new.txt":                       3,
+			"DIR/old.txt":                       3,
+			"Darwin":                            1,
+			"E":                                 2,
+			"FAIL":                              1,
+			"FLAGS1":                            1,
+			"FLAGS2":                            1,
+			"GO111MODULE":                       1,
+			"GOARCH":                            3,
+			"GOARM":                             2,
+			"GOENV":                             2,
+			"GOEXPERIMENT":                      1,
+			"GOFLAGS":                           1,
+			"GOHOSTOS":                          1,
+			"GOOS":                              2,
+			"GOPATH":                            1,
+			"GOROOT":                            6,
+			"GOROOT/src/":                       3,
+			"GOROOT_BOOTSTRAP":                  8,
+			"GOROOT_BOOTSTRAP_VERSION":          1,
+			"GO_DISTFLAGS":                      1,
+			"GO_GCFLAGS":                        2,
+			"H":                                 3,
+			"IFS":                               2,
+			"LC_TIME":                           1,
+			"Linux":                             1,
+			"M":                                 1,
+			"PREFIX":                            1,
+			"R":                                 1,
+			"Rp":                                1,
+			"S":                                 3,
+			"SHEBANG#!bash":                     9,
+			"SHEBANG#!sh":                       1,
+			"SRC":                               3,
+			"STAMP":                             1,
+			"T":                                 3,
+			"URL":                               2,
+			"WD/":                               1,
+			"Y":                                 1,
+			"[":                                 49,
+			"]":                                 49,
+			"_":                                 1,
+			"_exec":                             1,
+			"a":                                 3,
+			"ap":                                1,
+			"arm":                               2,
+			"arm5":                              1,
+			"backup.sh":                         2,
+			"banner":                            4,
+			"bash":                              1,
+			"bin":                               2,
+			"bin/*":                             1,
+			"bin/*_*":                           1,
+			"bin/*_*/*":                         1,
+			"bin/go_":                           1,
+			"bootgo":                            3,
+			"bootstrap":                         1,
+			"bootstrapenv":                      4,
+			"brew":                              1,
+			"build":                             4,
+			"bzip2":                             1,
+			"c":                                 2,
+			"case":                              3,
+			"cat":                               1,
+			"cd":                                5,
+			"cf":                                1,
+			"chmod":                             1,
+			"chsh":                              1,
+			"clean":                             4,
+			"cmd":                               3,
+			"cmd/dist":                          1,
+			"cmd/dist/dist":                     4,
+			"cmp":                               1,
+			"command":                           1,
+			"contrib/agent.plist":               1,
+			"contrib/agent.service":             1,
+			"cp":                                4,
+			"czf":                               1,
+			"d":                                 8,
+			"daemon":                            1,
+			"date":                              2,
+			"directory":                         2,
+			"dist":                              6,
+			"do":                                10,
+			"done":                              10,
+			"e":                                 10,
+			"echo":                              78,
+			"else":                              3,
+			"env":                               3,
+			"esac":                              3,
+			"euo":                               1,
+			"eval":                              2,
+			"exec":                              1,
+			"exit":                              22,
+			"export":                            13,
+			"f":                                 14,
+			"failed":                            2,
+			"false":                             4,
+			"fi":                                42,
+			"fish":                              1,
+			"for":                               9,
+			"force":                             1,
+			"forceflag":                         3,
+			"function":                          1,
+			"gcflags":                           2,
+			"get_em":                            1,
+			"gettargets":                        2,
+			"gist_clone":                        1,
+			"git":                               1,
+			"go":                                9,
+			"go_exe":                            1,
+			"goarch":                            2,
+			"gobin":                             1,
+			"gohostarch":                        1,
+			"gohostos":                          1,
+			"goos":                              2,
+			"gopath":                            1,
+			"goroot":                            1,
+			"goroot_bootstrap":                  2,
+			"goroot_bootstrap_set":              1,
+			"grep":                              6,
+			"help":                              1,
+			"i":                                 2,
+			"if":                                42,
+			"in":                                12,
+			"install":                           3,
+			"installed":                         1,
+			"l":                                 1,
+			"ld":                                1,
+			"linux":                             1,
+			"linux_targets":                     1,
+			"list":                              4,
+			"log":                               1,
+			"ls":                                2,
+			"m":                                 3,
+			"make.bash":                         5,
+			"mkdir":                             2,
+			"mv":                                2,
+			"n":                                 1,
+			"no":                                4,
+			"non_linux_targets":                 1,
+			"o":                                 4,
+			"off":                               3,
+			"old":                               1,
+			"p":                                 3,
+			"pattern":                           2,
+			"pipefail":                          1,
+			"pkg":                               10,
+			"pkg/bootstrap":                     1,
+			"pkg/obj":                           1,
+			"printf":                            2,
+			"pwd":                               1,
+			"r":                                 1,
+			"race":                              2,
+			"read":                              1,
+			"rebuild":                           2,
+			"reload":                            1,
+			"rf":                                2,
+			"rm":                                9,
+			"rmdir":                             1,
+			"run.bash":                          4,
+			"s":                                 2,
+			"sdk/go":                            1,
+			"se_mount":                          2,
+			"se_mount/booleans/allow_execstack": 2,
+			"sed":                               4,
+			"selectedtargets":                   3,
+			"set":                               8,
+			"sete":                              3,
+			"sh":                                2,
+			"shift":                             5,
+			"sleep":                             1,
+			"sort":                              2,
+			"src":                               2,
+			"start":                             1,
+			"std":                               7,
+			"sudo":                              1,
+			"systemctl":                         2,
+			"t":                                 1,
+			"tail":                              1,
+			"tar":                               2,
+			"targ":                              2,
+			"target":                            3,
+			"targets":                           2,
+			"tbz.":                              1,
+			"tee":                               1,
+			"test":                              2,
+			"then":                              42,
+			"tiger_em":                          1,
+			"tool":                              6,
+			"toolchain":                         1,
+			"true":                              7,
+			"type":                              1,
+			"ulimit":                            6,
+			"uname":                             1,
+			"unsafeptr":                         1,
+			"unset":                             4,
+			"until":                             1,
+			"usage":                             4,
+			"v":                                 4,
+			"verbose":                           4,
+			"version":                           2,
+			"vet":                               1,
+			"vflag":                             3,
+			"w":                                 1,
+			"while":                             1,
+			"x":                                 3,
+			"z":                                 2,
+			"{":                                 13,
+			"|":                                 18,
+			"||":                                5,
+			"}":                                 13,
+		},
+		"Text": map[string]int{
+			"%":                                 1,
+			"(":                                 37,
+			")":                                 37,
+			"*":                                 13,
+			"-":                                 29,
+			".":                                 2,
+			"//www.apache.org/licenses/":        1,
+			"//www.apache.org/licenses/LICENSE": 1,
+			"//www.pgdp.net.":                   1,
+			";":                                 27,
+			"A":                                 5,
+			"AB":                                2,
+			"ABE":                               1,
+			"AC":                                2,
+			"ACB":                               5,
+			"ACBD":                              2,
+			"ACP":                               2,
+			"AD":                                6,
+			"ADVISED":                           1,
+			"AND":                               7,
+			"ANY":                               6,
+			"APPENDIX":                          1,
+			"ARE":                               1,
+			"ARISING":                           1,
+			"AX.":                               7,
+			"Abraham":                           1,
+			"Accepting":                         1,
+			"Account":                           1,
+			"Additional":                        2,
+			"Advertisement":                     3,
+			"Age":                               1,
+			"Air":                               7,
+			"Anatomists":                        1,
+			"And":                               19,
+			"Angle":                             13,
+			"Angles":                            4,
+			"Apache":                            4,
+			"Appendix":                          1,
+			"April":                             1,
+			"Argument":                          1,
+			"As":                                1,
+			"Author":                            2,
+			"Authors.":                          1,
+			"Axiom":                             4,
+			"Axiom.":                            1,
+			"Axioms.":                           1,
+			"Axis":                              4,
+			"B":                                 2,
+			"BASIS":                             2,
+			"BC":                                1,
+			"BCP":                               1,
+			"BD":                                1,
+			"BE":                                1,
+			"BOOK":                              1,
+			"BUSINESS":                          1,
+			"BUT":                               2,
+			"BY":                                1,
+			"Bisect":                            1,
+			"Bodies":                            1,
+			"Body":                              5,
+			"Book":                              4,
+			"Bookseller.":                       1,
+			"Brain":                             1,
+			"Burning":                           1,
+			"But":                               3,
+			"By":                                1,
+			"C":                                 2,
+			"CA":                                1,
+			"CAUSED":                            1,
+			"CB":                                1,
+			"CB.":                               1,
+			"CD":                                1,
+			"CE":                                3,
+			"CONDITIONS":                        4,
+			"CONSEQUENTIAL":                     1,
+			"CONTRACT":                          1,
+			"CONTRIBUTORS":                      2,
+			"COPYRIGHT":                         2,
+			"CP":                                2,
+			"CPQ":                               1,
+			"C_t_":                              1,
+			"Cambridge":                         1,
+			"Cause":                             1,
+			"Center":                            1,
+			"Centre":                            2,
+			"Centres":                           1,
+			"Chamber":                           1,
+			"Circle":                            2,
+			"Circle.":                           1,
+			"Circumference":                     1,
+			"Circumstances.":                    1,
+			"Citations":                         1,
+			"Civil":                             1,
+			"Coat":                              2,
+			"Coats":                             1,
+			"Colour":                            2,
+			"Colour.":                           1,
+			"Colours":                           3,
+			"Colours.":                          2,
+			"Composition":                       1,
+			"Compound":                          1,
+			"Compound._":                        1,
+			"Concave":                           1,
+			"Conic":                             1,
+			"Consent._":                         1,
+			"Contemporary":                      2,
+			"Contribution":                      6,
+			"Contributions":                     1,
+			"Contributions.":                    2,
+			"Contributor":                       9,
+			"Convex":                            1,
+			"Copyright":                         3,
+			"Cornea_":                           1,
+			"Crowns":                            1,
+			"Curvilinear":                       2,
+			"DAMAGE.":                           1,
+			"DAMAGES":                           1,
+			"DATA":                              1,
+			"DB":                                1,
+			"DE":                                1,
+			"DEFIN.":                            8,
+			"DH":                                2,
+			"DIRECT":                            1,
+			"DISCLAIMED.":                       1,
+			"DISTRIBUTION":                      1,
+			"Death":                             1,
+			"Definitions":                       1,
+			"Definitions.":                      1,
+			"Demonstrations":                    1,
+			"Derivative":                        17,
+			"Design":                            2,
+			"Desire":                            1,
+			"Diameter":                          1,
+			"Disclaimer":                        1,
+			"Discourse":                         1,
+			"Discourse.":                        2,
+			"Disease":                           1,
+			"Disposition":                       3,
+			"Dissimilar._":                      1,
+			"Distributed":                       1,
+			"Don":                               1,
+			"Doors":                             1,
+			"Dr._":                              1,
+			"Draw":                              1,
+			"E":                                 2,
+			"E.":                                2,
+			"EC":                                2,
+			"ECQ":                               1,
+			"EDITION":                           2,
+			"EF":                                4,
+			"EFG":                               1,
+			"END":                               1,
+			"ET":                                2,
+			"EVEN":                              1,
+			"EVENT":                             1,
+			"EXEMPLARY":                         1,
+			"EXPRESS":                           1,
+			"E_t_":                              1,
+			"Eclipses":                          1,
+			"Edition":                           4,
+			"End":                               2,
+			"Enquiry":                           1,
+			"Entity":                            3,
+			"Equal":                             1,
+			"Error":                             1,
+			"Error.":                            1,
+			"Experiment":                        1,
+			"Experiments":                       3,
+			"Experiments._":                     1,
+			"Eye":                               7,
+			"F":                                 1,
+			"FG":                                1,
+			"FIG.":                              7,
+			"FIRST":                             1,
+			"FITNESS":                           2,
+			"FOR":                               4,
+			"FOURTH":                            1,
+			"F_f_":                              1,
+			"Fibres":                            1,
+			"Figures":                           3,
+			"Foci":                              2,
+			"Focus":                             11,
+			"For":                               13,
+			"Four":                              1,
+			"Fourth":                            1,
+			"Friends":                           2,
+			"GOODS":                             1,
+			"Gentlemen":                         1,
+			"Gettysburg":                        1,
+			"Glass":                             12,
+			"Go":                                6,
+			"Go.":                               1,
+			"God":                               1,
+			"Google":                            5,
+			"Grant":                             3,
+			"Gravity":                           1,
+			"Greek":                             1,
+			"H":                                 1,
+			"HE":                                1,
+			"HOLDERS":                           1,
+			"HOWEVER":                           1,
+			"Hands":                             1,
+			"Heterogeneal":                      3,
+			"Homogeneal":                        5,
+			"How":                               1,
+			"However":                           1,
+			"Humour_":                           1,
+			"Humours":                           1,
+			"Hypotheses":                        1,
+			"I":                                 35,
+			"I.":                                2,
+			"I._":                               1,
+			"IF":                                1,
+			"II":                                1,
+			"II.":                               2,
+			"III.":                              2,
+			"IMPLIED":                           2,
+			"IN":                                3,
+			"INCIDENTAL":                        1,
+			"INCLUDING":                         3,
+			"INDIRECT":                          1,
+			"INFRINGEMENT":                      1,
+			"INNYS":                             1,
+			"INTERRUPTION":                      1,
+			"IP":                                1,
+			"IS":                                1,
+			"ISAAC":                             1,
+			"IV.":                               2,
+			"If":                                7,
+			"Illustration":                      7,
+			"Importunity":                       1,
+			"In":                                6,
+			"Incidence":                         21,
+			"Incidence._":                       5,
+			"Incidences":                        2,
+			"Incident":                          1,
+			"Inclination":                       1,
+			"Introduction":                      1,
+			"Isaac":                             2,
+			"It":                                3,
+			"January":                           1,
+			"Josephine":                         1,
+			"July":                              1,
+			"KIND":                              2,
+			"Kind":                              1,
+			"Knt.":                              1,
+			"LIABILITY":                         2,
+			"LIABLE":                            1,
+			"LIGHT.":                            1,
+			"LIMITED":                           2,
+			"LLC":                               1,
+			"LONDON":                            1,
+			"LOSS":                              1,
+			"Language":                          1,
+			"Laws":                              1,
+			"Lectiones":                         1,
+			"Legal":                             3,
+			"Leibnitz":                          1,
+			"Lens":                              8,
+			"Let":                               7,
+			"Letter":                            1,
+			"Liability.":                        2,
+			"Liberty":                           1,
+			"License":                           17,
+			"License.":                          11,
+			"Licensed":                          1,
+			"Licensor":                          9,
+			"Light":                             27,
+			"Light.":                            1,
+			"Lights":                            3,
+			"Limitation":                        1,
+			"Lincoln":                           1,
+			"Line":                              11,
+			"Lines":                             4,
+			"Lines._":                           1,
+			"Lybarger":                          1,
+			"M":                                 2,
+			"MERCHANTABILITY":                   2,
+			"MN":                                1,
+			"Man":                               1,
+			"Manuscript":                        1,
+			"Mater_":                            1,
+			"Mathematical":                      1,
+			"Mathematicians":                    1,
+			"Matter":                            1,
+			"Matters":                           2,
+			"Meaning":                           1,
+			"Medium":                            5,
+			"Medium._":                          1,
+			"Meetings":                          1,
+			"Method.":                           1,
+			"Minutes":                           1,
+			"Moon":                              1,
+			"Motion":                            1,
+			"Mr._":                              1,
+			"Much":                              1,
+			"My":                                1,
+			"N":                                 1,
+			"N._":                               2,
+			"NEGLIGENCE":                        1,
+			"NEWTON":                            1,
+			"NEWTON_":                           1,
+			"NO":                                1,
+			"NON":                               1,
+			"NOT":                               2,
+			"NOTICE":                            5,
+			"N_q_":                              1,
+			"Neither":                           1,
+			"Nerves":                            1,
+			"Newton":                            1,
+			"Notwithstanding":                   1,
+			"November":                          1,
+			"Now":                               1,
+			"OF":                                15,
+			"ON":                                1,
+			"OPTICKS":                           2,
+			"OR":                                11,
+			"OTHERWISE":                         1,
+			"OUT":                               1,
+			"OWNER":                             1,
+			"Object":                            18,
+			"Objects":                           3,
+			"Observations":                      1,
+			"Occasion":                          1,
+			"Online":                            1,
+			"Operations":                        1,
+			"Optic":                             1,
+			"Optick":                            1,
+			"Opticks":                           1,
+			"Opticks.":                          1,
+			"Or":                                1,
+			"P":                                 1,
+			"PAGE":                              1,
+			"PARTICULAR":                        2,
+			"POSSIBILITY":                       1,
+			"PQ":                                1,
+			"PQR":                               1,
+			"PR":                                2,
+			"PROCUREMENT":                       1,
+			"PROFITS":                           1,
+			"PROVIDED":                          1,
+			"PURPOSE":                           1,
+			"PURPOSE.":                          1,
+			"Pages":                             1,
+			"Paolucci":                          1,
+			"Paper":                             3,
+			"Papers":                            1,
+			"Papers._":                          1,
+			"Parallel":                          3,
+			"Parts":                             2,
+			"Patent":                            1,
+			"Patents":                           1,
+			"Pennsylvania":                      1,
+			"Perpendicular":                     9,
+			"Picture":                           6,
+			"Pictures":                          4,
+			"Place":                             1,
+			"Plane":                             10,
+			"Plane.":                            1,
+			"Point":                             26,
+			"Point.":                            1,
+			"Points":                            12,
+			"Primary":                           1,
+			"Printed":                           1,
+			"Prism":                             4,
+			"Produced":                          1,
+			"Proofreading":                      1,
+			"Properties":                        2,
+			"Property":                          1,
+			"Proportion":                        7,
+			"Proportionals":                     1,
+			"Proportions":                       1,
+			"Proposition":                       1,
+			"Pupil":                             1,
+			"Q":                                 16,
+			"QC":                                3,
+			"QE":                                1,
+			"QM":                                1,
+			"Q_q_C":                             1,
+			"Question":                          2,
+			"Questions.":                        1,
+			"R":                                 1,
+			"REPRODUCTION":                      1,
+			"RS":                                1,
+			"Radius":                            5,
+			"Ratio":                             1,
+			"Ray":                               20,
+			"Ray.":                              2,
+			"Ray._":                             1,
+			"Rays":                              37,
+			"Rays.":                             2,
+			"Reason":                            2,
+			"Redistribution":                    1,
+			"Redistribution.":                   1,
+			"Redistributions":                   2,
+			"Reflection":                        1,
+			"Reflexion":                         9,
+			"Reflexions":                        1,
+			"Refraction":                        23,
+			"Refraction._":                      2,
+			"Refractions":                       4,
+			"Refrangibility":                    2,
+			"Refrangible":                       2,
+			"Retina_":                           1,
+			"Rights":                            1,
+			"Room.":                             1,
+			"Royal":                             1,
+			"Rules.":                            2,
+			"SERVICES":                          1,
+			"SHALL":                             1,
+			"SIR":                               1,
+			"SOFTWARE":                          2,
+			"SPECIAL":                           1,
+			"STRICT":                            1,
+			"SUBSTITUTE":                        1,
+			"SUCH":                              1,
+			"Scholium":                          1,
+			"Second":                            3,
+			"Secretary":                         1,
+			"Sections":                          2,
+			"See":                               1,
+			"Shape":                             1,
+			"Sheet":                             2,
+			"Sides":                             1,
+			"Similar":                           1,
+			"Simple":                            2,
+			"Since":                             1,
+			"Sine":                              22,
+			"Sines":                             7,
+			"Sines.":                            1,
+			"Sir":                               1,
+			"Sir_":                              2,
+			"So":                                2,
+			"Society":                           1,
+			"Source":                            8,
+			"Species":                           1,
+			"Spectacle":                         1,
+			"Sphere":                            2,
+			"Spheres":                           1,
+			"Spherical":                         2,
+			"Square":                            1,
+			"St.":                               1,
+			"Subject":                           4,
+			"Subject.":                          1,
+			"Submission":                        1,
+			"Successive":                        2,
+			"Sun":                               2,
+			"Suppose":                           2,
+			"Surface":                           13,
+			"Surface.":                          1,
+			"Surfaces":                          2,
+			"Surfaces.":                         1,
+			"Surfaces._":                        1,
+			"Suzanne":                           1,
+			"T":                                 5,
+			"TE":                                2,
+			"TERMS":                             2,
+			"THE":                               8,
+			"THEORY":                            1,
+			"THIS":                              2,
+			"TITLE":                             2,
+			"TO":                                2,
+			"TORT":                              1,
+			"TQ":                                4,
+			"TQ.":                               1,
+			"TREATISE":                          1,
+			"T_q_":                              1,
+			"Team":                              1,
+			"Telescope":                         1,
+			"The":                               9,
+			"Then":                              1,
+			"Theorems":                          2,
+			"Theory":                            1,
+			"They":                              1,
+			"Things":                            1,
+			"Third":                             3,
+			"This":                              2,
+			"Thus":                              1,
+			"To":                                2,
+			"Tract":                             1,
+			"Tracts":                            1,
+			"Trademarks.":                       1,
+			"Transcriber":                       1,
+			"Triangular":                        1,
+			"USE":                               3,
+			"University":                        1,
+			"Unless":                            3,
+			"V.":                                2,
+			"VI.":                               2,
+			"VII":                               1,
+			"VII.":                              1,
+			"VIII.":                             1,
+			"Version":                           2,
+			"Vision.":                           1,
+			"WARRANTIES":                        4,
+			"WAY":                               1,
+			"WHETHER":                           1,
+			"WILLIAM":                           1,
+			"WITHOUT":                           2,
+			"Wall":                              1,
+			"Wallis":                            1,
+			"War":                               1,
+			"Warranty":                          1,
+			"Warranty.":                         1,
+			"Water":                             3,
+			"Way":                               2,
+			"We":                                3,
+			"West":                              1,
+			"Whence":                            1,
+			"Whether":                           1,
+			"While":                             1,
+			"Window":                            1,
+			"Work":                              24,
+			"Work.":                             1,
+			"Works":                             17,
+			"Year":                              1,
+			"Years":                             4,
+			"You":                               24,
+			"Your":                              8,
+			"[":                                 21,
+			"]":                                 21,
+			"_AXIOMS._":                         1,
+			"_By":                               1,
+			"_Cas._":                            4,
+			"_Colours_":                         1,
+			"_Cornea_":                          1,
+			"_Crystalline":                      1,
+			"_DEFINITIONS_":                     1,
+			"_Dura":                             1,
+			"_Fig._":                            9,
+			"_Focus_.":                          1,
+			"_Homogeneal":                       1,
+			"_I":                                1,
+			"_I.":                               2,
+			"_ISAAC":                            1,
+			"_If":                               1,
+			"_In":                               2,
+			"_Inflections_":                     1,
+			"_Jaundice_":                        1,
+			"_Jupiter":                          1,
+			"_Lens_":                            1,
+			"_PART":                             1,
+			"_Part":                             1,
+			"_Paul":                             1,
+			"_Reflections_":                     1,
+			"_Reflexibility":                    1,
+			"_Refraction":                       1,
+			"_Refractions_":                     1,
+			"_Refrangibility":                   1,
+			"_The":                              9,
+			"_The_":                             1,
+			"_This":                             1,
+			"_Tunica":                           2,
+			"_Wherever":                         1,
+			"_and":                              1,
+			"_concerning":                       1,
+			"_corrected_.":                      1,
+			"_f_":                               1,
+			"_in":                               3,
+			"_mk_":                              1,
+			"_p_":                               1,
+			"_q_":                               17,
+			"_q_C":                              2,
+			"_r_":                               1,
+			"_t_":                               3,
+			"_t_E":                              2,
+			"_tq_":                              4,
+			"_which":                            1,
+			"a":                                 63,
+			"a_":                                1,
+			"about":                             10,
+			"above":                             6,
+			"abroad":                            2,
+			"acceptance":                        1,
+			"accepting":                         2,
+			"accordingly":                       1,
+			"accurately":                        2,
+			"acquired":                          1,
+			"act":                               1,
+			"acting":                            1,
+			"acts":                              1,
+			"add":                               3,
+			"added":                             3,
+			"addendum":                          1,
+			"additional":                        4,
+			"additions":                         1,
+			"advanced.":                         1,
+			"advised":                           1,
+			"affirm":                            1,
+			"after":                             9,
+			"afterwards":                        1,
+			"again":                             3,
+			"against":                           3,
+			"agent":                             1,
+			"ago":                               3,
+			"agree":                             5,
+			"agreed":                            3,
+			"agreement":                         1,
+			"alike":                             1,
+			"all":                               14,
+			"alleging":                          2,
+			"almost":                            1,
+			"alone":                             2,
+			"along":                             2,
+			"alongside":                         1,
+			"also":                              3,
+			"altogether":                        1,
+			"always":                            1,
+			"am":                                1,
+			"an":                                12,
+			"an_":                               1,
+			"and":                               162,
+			"and/or":                            2,
+			"annotations":                       1,
+			"another":                           3,
+			"another.":                          2,
+			"any":                               64,
+			"appear":                            4,
+			"appear.":                           1,
+			"applicable":                        3,
+			"applies":                           2,
+			"apply":                             2,
+			"appropriate":                       1,
+			"appropriateness":                   1,
+			"archives.":                         1,
+			"are":                               31,
+			"arising":                           1,
+			"as":                                50,
+			"asserted":                          1,
+			"associated":                        1,
+			"assume":                            1,
+			"at":                                16,
+			"attach":                            1,
+			"attached":                          1,
+			"attribution":                       4,
+			"authorized":                        2,
+			"authorship":                        2,
+			"authorship.":                       1,
+			"available":                         1,
+			"b":                                 1,
+			"back":                              3,
+			"based":                             1,
+			"battle":                            1,
+			"be":                                82,
+			"because":                           4,
+			"been":                              4,
+			"before":                            7,
+			"begin":                             1,
+			"begins":                            1,
+			"behalf":                            5,
+			"being":                             4,
+			"below":                             1,
+			"bending":                           1,
+			"beneficial":                        1,
+			"beyond":                            1,
+			"binary":                            2,
+			"bind":                              1,
+			"birth":                             1,
+			"bisected":                          1,
+			"boilerplate":                       1,
+			"both":                              8,
+			"bottom":                            5,
+			"bounded":                           1,
+			"brackets":                          2,
+			"brave":                             1,
+			"breaking":                          1,
+			"broad":                             1,
+			"brought":                           1,
+			"but":                               10,
+			"by":                                66,
+			"c":                                 1,
+			"call":                              5,
+			"called":                            5,
+			"can":                               6,
+			"cannot":                            1,
+			"carry":                             1,
+			"cases":                             1,
+			"cases.":                            1,
+			"casting":                           1,
+			"cause":                             4,
+			"changed":                           1,
+			"character":                         1,
+			"charge":                            4,
+			"choose":                            1,
+			"chosen":                            1,
+			"chusing":                           1,
+			"claim":                             2,
+			"claims":                            4,
+			"class":                             1,
+			"coat":                              1,
+			"code":                              5,
+			"colour":                            1,
+			"colours":                           1,
+			"combination":                       1,
+			"come":                              4,
+			"comes":                             5,
+			"coming":                            1,
+			"comment":                           1,
+			"commercial":                        1,
+			"common":                            2,
+			"communicate":                       1,
+			"communication":                     3,
+			"compared.":                         1,
+			"comparing":                         1,
+			"compiled":                          1,
+			"complete":                          1,
+			"compliance":                        1,
+			"complies":                          1,
+			"compounded":                        1,
+			"computer":                          1,
+			"concave":                           2,
+			"conceived":                         2,
+			"concerning":                        2,
+			"conclude":                          1,
+			"conditions":                        11,
+			"conditions.":                       1,
+			"configuration":                     1,
+			"consecrate":                        1,
+			"consecrated":                       1,
+			"consequence":                       1,
+			"consequential":                     1,
+			"consequently":                      1,
+			"consider":                          2,
+			"considered":                        1,
+			"considered.":                       1,
+			"consistent":                        1,
+			"consists":                          1,
+			"conspicuously":                     1,
+			"constitutes":                       2,
+			"construed":                         1,
+			"contained":                         1,
+			"containeth":                        1,
+			"containing":                        1,
+			"contains":                          1,
+			"content":                           1,
+			"contents":                          2,
+			"continent":                         1,
+			"continual":                         1,
+			"contract":                          2,
+			"contrary":                          4,
+			"contributors":                      1,
+			"contributory":                      2,
+			"control":                           3,
+			"controlled":                        2,
+			"converge":                          5,
+			"conversions":                       1,
+			"convex":                            2,
+			"copied":                            1,
+			"copies":                            1,
+			"copiously":                         1,
+			"copy":                              3,
+			"copyright":                         13,
+			"copyrightable":                     1,
+			"corrected":                         1,
+			"correspondent":                     2,
+			"counterclaim":                      2,
+			"covered.":                          1,
+			"created":                           1,
+			"cross":                             3,
+			"crystalline":                       1,
+			"currently":                         1,
+			"customary":                         1,
+			"cuts":                              1,
+			"cutting":                           3,
+			"d":                                 1,
+			"damages":                           4,
+			"damages.":                          1,
+			"dark":                              2,
+			"date":                              2,
+			"dead":                              3,
+			"decay":                             1,
+			"dedicate":                          2,
+			"dedicated":                         4,
+			"defend":                            1,
+			"define":                            1,
+			"defined":                           1,
+			"definition":                        2,
+			"delayed":                           2,
+			"deliberate":                        1,
+			"denser":                            1,
+			"derived":                           2,
+			"describe":                          1,
+			"described":                         3,
+			"describing":                        2,
+			"description":                       1,
+			"descriptions":                      1,
+			"designated":                        1,
+			"desired":                           3,
+			"determined.":                       1,
+			"determining":                       1,
+			"detract.":                          1,
+			"devotion":                          2,
+			"did":                               2,
+			"died":                              1,
+			"difference":                        2,
+			"different":                         1,
+			"direct":                            4,
+			"direction":                         1,
+			"directly":                          1,
+			"disclaimer":                        1,
+			"disclaimer.":                       1,
+			"discussing":                        1,
+			"display":                           2,
+			"distribute":                        5,
+			"distributed":                       4,
+			"distribution":                      4,
+			"distribution.":                     1,
+			"diverge":                           2,
+			"do":                                5,
+			"document.":                         1,
+			"documentation":                     4,
+			"does":                              2,
+			"doth":                              2,
+			"down":                              1,
+			"downwards":                         1,
+			"draw":                              2,
+			"each":                              4,
+			"earth.":                            1,
+			"easier":                            1,
+			"easily":                            1,
+			"easily._":                          1,
+			"edges":                             1,
+			"editorial":                         1,
+			"either":                            5,
+			"elaborations":                      1,
+			"electronic":                        2,
+			"enclosed":                          2,
+			"end":                               2,
+			"endeavoured":                       1,
+			"endorse":                           1,
+			"ends":                              1,
+			"endure.":                           1,
+			"engaged":                           1,
+			"ensuing":                           1,
+			"entities":                          1,
+			"entity":                            5,
+			"entity.":                           2,
+			"equal":                             6,
+			"equal.":                            1,
+			"equation.":                         1,
+			"erect":                             1,
+			"erecting":                          1,
+			"essential":                         1,
+			"even":                              1,
+			"event":                             1,
+			"every":                             1,
+			"examined.":                         1,
+			"example":                           1,
+			"except":                            5,
+			"excepted":                          1,
+			"excluding":                         3,
+			"exclusive":                         4,
+			"executed":                          1,
+			"exercise":                          1,
+			"exercising":                        1,
+			"explain":                           1,
+			"explicitly":                        1,
+			"express":                           2,
+			"failure":                           1,
+			"fall":                              6,
+			"fall.":                             1,
+			"fall._":                            1,
+			"falling":                           2,
+			"far":                               2,
+			"farther":                           2,
+			"fathers":                           1,
+			"fee":                               1,
+			"field":                             2,
+			"fields":                            1,
+			"fifty":                             1,
+			"file":                              7,
+			"file.":                             1,
+			"filed.":                            2,
+			"files":                             2,
+			"files.":                            1,
+			"final":                             1,
+			"find":                              5,
+			"finding":                           1,
+			"first":                             5,
+			"fitting":                           1,
+			"flatter":                           1,
+			"flow":                              11,
+			"following":                         9,
+			"for":                               32,
+			"foregoing":                         1,
+			"forget":                            1,
+			"form":                              13,
+			"form.":                             1,
+			"format.":                           1,
+			"formed":                            1,
+			"former":                            1,
+			"forms":                             1,
+			"forth":                             1,
+			"fought":                            1,
+			"found":                             8,
+			"found.":                            1,
+			"free":                              3,
+			"freedom":                           1,
+			"from":                              42,
+			"full":                              1,
+			"fully":                             1,
+			"further":                           1,
+			"future":                            1,
+			"gave":                              2,
+			"general":                           1,
+			"generated":                         2,
+			"give":                              2,
+			"given":                             3,
+			"glass":                             3,
+			"go":                                2,
+			"goes":                              3,
+			"going":                             1,
+			"goodwill":                          1,
+			"got":                               1,
+			"governing":                         1,
+			"government":                        1,
+			"grant":                             2,
+			"granted":                           3,
+			"granting":                          1,
+			"grants":                            3,
+			"great":                             3,
+			"greater":                           1,
+			"grossly":                           1,
+			"ground.":                           1,
+			"grow":                              1,
+			"had":                               3,
+			"hallow":                            1,
+			"happen":                            1,
+			"happens":                           1,
+			"harmless":                          1,
+			"harris":                            1,
+			"has":                               3,
+			"hath":                              2,
+			"have":                              24,
+			"having":                            2,
+			"he":                                1,
+			"held":                              1,
+			"here":                              9,
+			"here.":                             1,
+			"hereby":                            3,
+			"herein":                            1,
+			"highly":                            1,
+			"his":                               1,
+			"hitherto":                          1,
+			"hold":                              1,
+			"hole":                              1,
+			"honored":                           1,
+			"how":                               1,
+			"http":                              3,
+			"humour":                            1,
+			"humours":                           1,
+			"i":                                 1,
+			"identification":                    1,
+			"identifying":                       1,
+			"if":                                28,
+			"ii":                                1,
+			"iii":                               1,
+			"illuminate":                        1,
+			"illuminated":                       1,
+			"illustrations.":                    1,
+			"imperfect":                         3,
+			"imperfectly.":                      1,
+			"implementation":                    5,
+			"implementation.":                   1,
+			"implied":                           1,
+			"implied.":                          1,
+			"import":                            2,
+			"improving":                         1,
+			"in":                                98,
+			"inability":                         1,
+			"incident":                          14,
+			"incidental":                        1,
+			"inclined":                          1,
+			"inclining":                         1,
+			"include":                           3,
+			"included":                          2,
+			"includes":                          1,
+			"including":                         10,
+			"inclusion":                         2,
+			"incorporated":                      3,
+			"increased":                         1,
+			"incurred":                          1,
+			"indemnify":                         1,
+			"indemnity":                         1,
+			"indicated":                         1,
+			"indirect":                          2,
+			"individual":                        3,
+			"inducement":                        1,
+			"information.":                      1,
+			"informational":                     1,
+			"infringed":                         3,
+			"infringement":                      3,
+			"instant.":                          1,
+			"institute":                         2,
+			"institution":                       1,
+			"intended":                          1,
+			"intentionally":                     2,
+			"interfaces":                        1,
+			"into":                              13,
+			"inverted.":                         1,
+			"irrevocable":                       3,
+			"is":                                48,
+			"issue":                             1,
+			"it":                                25,
+			"it.":                               1,
+			"its":                               14,
+			"joined":                            1,
+			"joining":                           1,
+			"know":                              2,
+			"known":                             3,
+			"language":                          1,
+			"larger":                            1,
+			"last":                              2,
+			"lately":                            1,
+			"law":                               3,
+			"lawsuit":                           2,
+			"least":                             4,
+			"leave":                             2,
+			"left":                              2,
+			"legal":                             1,
+			"length":                            1,
+			"lent":                              1,
+			"less":                              5,
+			"lesser":                            1,
+			"let":                               9,
+			"letter":                            1,
+			"letters":                           1,
+			"liability":                         2,
+			"liability.":                        1,
+			"liable":                            1,
+			"licensable":                        2,
+			"license":                           7,
+			"licensee":                          1,
+			"licenses":                          1,
+			"lie":                               2,
+			"lieth":                             1,
+			"like":                              6,
+			"limitation":                        1,
+			"limitations":                       1,
+			"limited":                           4,
+			"line":                              1,
+			"lines":                             2,
+			"link":                              1,
+			"list":                              2,
+			"lists":                             1,
+			"litigation":                        4,
+			"little":                            2,
+			"live.":                             1,
+			"lively":                            1,
+			"lives":                             1,
+			"living":                            2,
+			"long":                              2,
+			"loss":                              1,
+			"losses":                            1,
+			"lucid":                             1,
+			"luminous":                          1,
+			"made":                              9,
+			"mailing":                           1,
+			"make":                              8,
+			"making":                            2,
+			"malfunction":                       1,
+			"managed":                           1,
+			"management":                        1,
+			"manifest":                          2,
+			"manner":                            4,
+			"many":                              8,
+			"marked":                            1,
+			"marks":                             1,
+			"materials":                         1,
+			"may":                               25,
+			"me.":                               1,
+			"mean":                              10,
+			"means":                             4,
+			"measure":                           1,
+			"mechanical":                        1,
+			"media":                             1,
+			"medium":                            1,
+			"meet":                              5,
+			"men":                               2,
+			"mention":                           1,
+			"merely":                            1,
+			"met":                               3,
+			"might":                             1,
+			"modification":                      2,
+			"modifications":                     6,
+			"modified":                          1,
+			"modify":                            3,
+			"modifying":                         1,
+			"moment":                            1,
+			"more":                              10,
+			"most":                              3,
+			"must":                              7,
+			"my":                                5,
+			"name":                              4,
+			"names":                             3,
+			"nation":                            5,
+			"nearly":                            1,
+			"necessarily":                       2,
+			"need":                              1,
+			"negligence":                        1,
+			"negligent":                         1,
+			"never":                             1,
+			"new":                               3,
+			"next":                              1,
+			"no":                                5,
+			"nobly":                             1,
+			"non":                               3,
+			"nor":                               4,
+			"normally":                          1,
+			"not":                               27,
+			"note":                              1,
+			"noted":                             1,
+			"nothing":                           1,
+			"notice":                            5,
+			"notices":                           8,
+			"now":                               1,
+			"object":                            1,
+			"obligations":                       2,
+			"obliquely":                         1,
+			"obtain":                            1,
+			"of":                                259,
+			"of_":                               1,
+			"off":                               1,
+			"offer":                             3,
+			"old":                               1,
+			"omitted":                           2,
+			"on":                                32,
+			"one":                               11,
+			"ones":                              1,
+			"ones.":                             2,
+			"only":                              7,
+			"or":                                141,
+			"order":                             2,
+			"origin":                            1,
+			"original":                          2,
+			"other":                             23,
+			"other.":                            1,
+			"others":                            2,
+			"otherwise":                         7,
+			"ought":                             1,
+			"our":                               2,
+			"out":                               17,
+			"outstanding":                       1,
+			"outward":                           2,
+			"own":                               4,
+			"owned":                             1,
+			"owner":                             5,
+			"owner.":                            1,
+			"ownership":                         2,
+			"paint":                             1,
+			"painted":                           1,
+			"parallel":                          3,
+			"part":                              7,
+			"party":                             2,
+			"pass":                              3,
+			"pass.":                             1,
+			"passage":                           1,
+			"passes":                            1,
+			"passeth":                           1,
+			"passing":                           3,
+			"patent":                            12,
+			"people":                            3,
+			"percent":                           1,
+			"perfect":                           1,
+			"perfectly":                         1,
+			"perform":                           1,
+			"perhaps":                           1,
+			"perish":                            1,
+			"permission":                        1,
+			"permission.":                       1,
+			"permissions":                       3,
+			"permitted":                         1,
+			"perpendicularly":                   4,
+			"perpetual":                         3,
+			"pertain":                           2,
+			"place":                             3,
+			"placed":                            1,
+			"places":                            1,
+			"plain":                             1,
+			"plane":                             1,
+			"please.":                           1,
+			"point":                             5,
+			"points":                            1,
+			"polished":                          1,
+			"poor":                              1,
+			"portion":                           1,
+			"possibility":                       1,
+			"power":                             2,
+			"preferred":                         1,
+			"prefixing":                         1,
+			"premise":                           1,
+			"prepare":                           1,
+			"presently":                         1,
+			"prevailed":                         1,
+			"printed":                           1,
+			"printing":                          1,
+			"prior":                             1,
+			"produce":                           3,
+			"produced":                          3,
+			"produced.":                         1,
+			"product":                           1,
+			"products":                          1,
+			"project.":                          1,
+			"prominent":                         1,
+			"promote":                           1,
+			"propagate":                         1,
+			"propagated":                        3,
+			"proper":                            4,
+			"proportion":                        2,
+			"propose":                           2,
+			"proposition":                       1,
+			"prove":                             1,
+			"provide":                           1,
+			"provided":                          8,
+			"provides":                          2,
+			"publick":                           1,
+			"publick._":                         1,
+			"publickly":                         1,
+			"publicly":                          2,
+			"publish":                           2,
+			"published":                         1,
+			"publishing":                        1,
+			"purpose":                           2,
+			"purposes":                          4,
+			"put":                               1,
+			"putting":                           2,
+			"quations":                          1,
+			"rarer":                             1,
+			"rather":                            2,
+			"reaching":                          1,
+			"read":                              2,
+			"readable":                          1,
+			"readily":                           1,
+			"reason":                            1,
+			"reasonable":                        1,
+			"received":                          1,
+			"recipients":                        1,
+			"recommend":                         1,
+			"red":                               2,
+			"redistributing":                    2,
+			"reflected":                         16,
+			"reflecting":                        7,
+			"reflexible":                        1,
+			"reflexible.":                       1,
+			"refracted":                         23,
+			"refracting":                        10,
+			"refraction":                        1,
+			"regarding":                         1,
+			"remain":                            1,
+			"remaining":                         1,
+			"remember":                          1,
+			"remote":                            1,
+			"repeated":                          1,
+			"replaced":                          1,
+			"represent":                         5,
+			"representatives":                   1,
+			"represents":                        1,
+			"reproduce":                         3,
+			"reproducing":                       1,
+			"reproduction":                      3,
+			"required":                          5,
+			"resolve":                           1,
+			"respects":                          1,
+			"responsibility":                    1,
+			"responsible":                       1,
+			"rest":                              3,
+			"resting":                           1,
+			"result":                            1,
+			"resulting":                         1,
+			"retain":                            2,
+			"returned":                          1,
+			"revisions":                         1,
+			"rights":                            2,
+			"risks":                             1,
+			"roots":                             1,
+			"royalty":                           3,
+			"run":                               1,
+			"running":                           1,
+			"s":                                 3,
+			"said":                              1,
+			"same":                              19,
+			"satisfied":                         3,
+			"say":                               1,
+			"scatter":                           1,
+			"score":                             1,
+			"second":                            3,
+			"section":                           2,
+			"see":                               1,
+			"seen":                              1,
+			"seldom":                            1,
+			"self":                              2,
+			"sell":                              4,
+			"sense":                             1,
+			"sensible":                          2,
+			"sent":                              2,
+			"separable":                         1,
+			"separate":                          1,
+			"service":                           1,
+			"set":                               1,
+			"seven":                             2,
+			"several":                           5,
+			"severally":                         1,
+			"shall":                             38,
+			"shape":                             1,
+			"shares":                            1,
+			"shew":                              1,
+			"should":                            3,
+			"shrinking":                         1,
+			"shut":                              1,
+			"side":                              8,
+			"sides":                             2,
+			"sides.":                            1,
+			"signified":                         1,
+			"simplest":                          1,
+			"since":                             2,
+			"situation":                         1,
+			"sixth":                             1,
+			"skin":                              1,
+			"skins":                             1,
+			"small":                             1,
+			"so":                                25,
+			"software":                          3,
+			"sole":                              1,
+			"solely":                            1,
+			"solicited":                         1,
+			"some":                              7,
+			"sometimes":                         1,
+			"somewhere":                         1,
+			"soonest":                           1,
+			"sorts":                             1,
+			"source":                            5,
+			"special":                           1,
+			"specific":                          2,
+			"spending":                          1,
+			"spherical":                         2,
+			"spherically":                       2,
+			"sqrt":                              1,
+			"squaring":                          1,
+			"stagnating":                        1,
+			"state":                             1,
+			"stated":                            3,
+			"statement":                         1,
+			"stating":                           1,
+			"steve":                             1,
+			"still":                             1,
+			"stop":                              2,
+			"stopp":                             2,
+			"stoppage":                          1,
+			"struggled":                         1,
+			"subjoining":                        1,
+			"sublicense":                        1,
+			"submit":                            1,
+			"submitted":                         2,
+			"submitted.":                        1,
+			"subsequently":                      1,
+			"successively":                      1,
+			"such":                              25,
+			"suffer":                            1,
+			"suffers":                           1,
+			"sufficient":                        1,
+			"supersede":                         1,
+			"support":                           1,
+			"suppose":                           1,
+			"syntax":                            1,
+			"systems":                           2,
+			"t":                                 1,
+			"take":                              5,
+			"taken":                             3,
+			"taking":                            2,
+			"task":                              1,
+			"terminate":                         2,
+			"terms":                             8,
+			"testing":                           1,
+			"text":                              4,
+			"than":                              3,
+			"that":                              85,
+			"the":                               448,
+			"the_":                              1,
+			"their":                             15,
+			"them":                              2,
+			"then":                              9,
+			"thence":                            1,
+			"theory":                            1,
+			"there":                             5,
+			"thereby":                           2,
+			"therefore":                         3,
+			"therein":                           1,
+			"thereof":                           5,
+			"thereof.":                          1,
+			"thereon.":                          1,
+			"these":                             8,
+			"these_":                            1,
+			"they":                              13,
+			"thick":                             1,
+			"thing":                             2,
+			"think":                             1,
+			"thinner":                           1,
+			"third":                             4,
+			"this":                              49,
+			"this.":                             1,
+			"those":                             13,
+			"thought":                           1,
+			"three":                             6,
+			"through":                           4,
+			"thus":                              2,
+			"thus.":                             1,
+			"time":                              3,
+			"times":                             1,
+			"tinge":                             1,
+			"tinged":                            2,
+			"to":                                143,
+			"together":                          1,
+			"too":                               1,
+			"tort":                              1,
+			"totally":                           2,
+			"towards":                           10,
+			"tracking":                          1,
+			"trade":                             1,
+			"trademark":                         1,
+			"trademarks":                        1,
+			"transfer":                          2,
+			"transformation":                    1,
+			"translated":                        1,
+			"translation":                       1,
+			"transparent":                       2,
+			"transversly":                       1,
+			"tried":                             3,
+			"try":                               1,
+			"turned":                            4,
+			"twelve":                            1,
+			"two":                               6,
+			"types.":                            1,
+			"under":                             11,
+			"understand":                        1,
+			"unfinished":                        1,
+			"union":                             1,
+			"unless":                            1,
+			"until":                             1,
+			"upon":                              16,
+			"us":                                4,
+			"use":                               11,
+			"used":                              1,
+			"using":                             1,
+			"usually":                           2,
+			"vain":                              1,
+			"verbal":                            1,
+			"version":                           1,
+			"very":                              1,
+			"views":                             1,
+			"vulgar":                            1,
+			"want":                              2,
+			"war.":                              1,
+			"warranties":                        1,
+			"warranty":                          2,
+			"was":                               6,
+			"way":                               3,
+			"ways":                              1,
+			"we":                                8,
+			"well":                              2,
+			"were":                              2,
+			"what":                              4,
+			"when":                              7,
+			"where":                             6,
+			"whereby":                           1,
+			"wherever":                          1,
+			"whether":                           5,
+			"which":                             40,
+			"white":                             3,
+			"whither":                           1,
+			"who":                               4,
+			"whole":                             2,
+			"whom":                              1,
+			"whose":                             5,
+			"will":                              6,
+			"wishing":                           1,
+			"with":                              29,
+			"within":                            9,
+			"without":                           9,
+			"work":                              8,
+			"work.":                             1,
+			"works":                             2,
+			"world":                             1,
+			"worldwide":                         3,
+			"would":                             3,
+			"writ":                              1,
+			"writing":                           4,
+			"written":                           6,
+			"year":                              1,
+			"years":                             1,
+			"yet":                               1,
+			"you":                               9,
+			"your":                              5,
+			"yyyy":                              1,
+		},
+	},
+	LanguageTokens: map[string]int{
+		"C":           1632,
+		"C#":          1112,
+		"C++":         1281,
+		"CSV":         673,
+		"Go":          7771,
+		"Haskell":     294,
+		"INI":         834,
+		"Java":        316,
+		"JavaScript":  8414,
+		"Lua":         15755,
+		"Matlab":      180,
+		"Objective-C": 222,
+		"PHP":         117,
+		"Perl":        7031,
+		"Python":      8243,
+		"Ruby":        2565,
+		"Rust":        4997,
+		"Shell":       1283,
+		"Text":        5920,
+	},
+	TokensTotal: 68640,
+}
@@ -0,0 +1,86 @@
+package linguist
+
+import (
+	"bytes"
+	"path"
+	"regexp"
+	"strings"
+)
+
+var (
+	// "python2.7" is looked up as "python2"
+	interpreterVersion = regexp.MustCompile(`\.\d+$`)
+
+	// Scripts that start as sh, then re-execute themselves with another
+	// interpreter: `exec ruby -S "$0" "$@"`
+	execHack = regexp.MustCompile(`exec (\w+).+\$0.+\$@`)
+)
+
+// Interpreter returns the name of the interpreter of a script from its `#!`
+// line, e.g. "python3" for `#!/usr/bin/env python3.8`, or "" if there is
+// none. Variables and flags passed to /usr/bin/env are skipped.
+func Interpreter(blob []byte) string {
+	if !bytes.HasPrefix(blob, []byte("#!")) {
+		return ""
+	}
+
+	line := firstLines(blob, 1)[0]
+	fields := strings.Fields(strings.TrimPrefix(line, "#!"))
+	if len(fields) == 0 {
+		return ""
+	}
+
+	script := path.Base(fields[0])
+	if script == "env" {
+		script = ""
+		for _, arg := range fields[1:] {
+			if strings.HasPrefix(arg, "-") || strings.Contains(arg, "=") {
+				continue
+			}
+
+			script = path.Base(arg)
+			break
+		}
+	}
+
+	script = interpreterVersion.ReplaceAllString(script, "")
+
+	if script == "sh" {
+		for _, line := range firstLines(blob, 5) {
+			if match := execHack.FindStringSubmatch(line); match != nil {
+				return match[1]
+			}
+		}
+	}
+
+	return script
+}
+
+// DetectLanguageByShebang returns the languages whose interpreter the script
+// names in its `#!` line
+func DetectLanguageByShebang(blob []byte) []*Language {
+	interpreter := Interpreter(blob)
+	if interpreter == "" {
+		return nil
+	}
+
+	return languagesByInterpreter[interpreter]
+}
+
+// firstLines returns up to n lines from the start of blob, without line
+// endings
+func firstLines(blob []byte, n int) []string {
+	var lines []string
+	for len(lines) < n && len(blob) > 0 {
+		line := blob
+		if end := bytes.IndexByte(blob, '\n'); end >= 0 {
+			line, blob = blob[:end], blob[end+1:]
+		} else {
+			blob = nil
+		}
+
+		lines = append(lines, strings.TrimSuffix(string(line), "\r"))
+	}
+
+	return lines
+}
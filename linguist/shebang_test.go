@@ -0,0 +1,51 @@
+package linguist_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/gitlab-elasticsearch-indexer/linguist"
+)
+
+func TestInterpreter(t *testing.T) {
+	for _, tc := range []struct {
+		script      string
+		interpreter string
+	}{
+		{"", ""},
+		{"#!", ""},
+		{"echo '#!/bin/bash'", ""},
+		{"#!/usr/bin/env", ""},
+		{"#!/bin/bash\necho hi", "bash"},
+		{"#!/bin/bash\r\necho hi", "bash"},
+		{"#! perl -w", "perl"},
+		{"#!/usr/bin/python2.7", "python2"},
+		{"#!/usr/bin/env python3.8", "python3"},
+		{"#!/usr/bin/env ruby -w", "ruby"},
+		{"#!/usr/bin/env -S node --harmony", "node"},
+		{"#!/usr/bin/env -i PATH=/usr/bin:/bin RUBYOPT=-w ruby", "ruby"},
+		{"#!/bin/sh\n# A comment\nexec ruby -S \"$0\" \"$@\"\n", "ruby"},
+		{"#!/bin/sh\necho hi\n", "sh"},
+	} {
+		require.Equal(t, tc.interpreter, linguist.Interpreter([]byte(tc.script)), tc.script)
+	}
+}
+
+func TestDetectLanguageByShebang(t *testing.T) {
+	for _, tc := range []struct {
+		script string
+		lang   string
+	}{
+		{"#!/bin/bash\n", "Shell"},
+		{"#!/usr/bin/env python3\n", "Python"},
+		{"#!/usr/bin/env node\n", "JavaScript"},
+		{"#!/usr/bin/env ruby\n", "Ruby"},
+	} {
+		langs := linguist.DetectLanguageByShebang([]byte(tc.script))
+		require.Len(t, langs, 1, tc.script)
+		require.Equal(t, tc.lang, langs[0].Name, tc.script)
+	}
+
+	require.Empty(t, linguist.DetectLanguageByShebang([]byte("#!/usr/bin/env nobody-will-make-this-interpreter\n")))
+}
@@ -0,0 +1,9 @@
+using System;
+
+namespace Hello
+{
+    class Program
+    {
+        static void Main() => Console.WriteLine("Hello");
+    }
+}
@@ -0,0 +1,16 @@
+#pragma once
+
+#include <string>
+#include <vector>
+
+namespace ui {
+
+class Widget {
+public:
+	explicit Widget(std::string name);
+
+private:
+	std::vector<Widget> children_;
+};
+
+}
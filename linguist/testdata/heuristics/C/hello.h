@@ -0,0 +1,12 @@
+#ifndef HELLO_H
+#define HELLO_H
+
+#include <stdio.h>
+
+struct greeting {
+	const char *text;
+};
+
+void hello(const struct greeting *g);
+
+#endif
@@ -0,0 +1,5 @@
+module Program
+
+open System
+
+let greet name = printfn "Hello %s" name
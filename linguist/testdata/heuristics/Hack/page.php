@@ -0,0 +1,5 @@
+<?hh // strict
+
+function hello(): string {
+  return "Hello";
+}
@@ -0,0 +1,4 @@
+routine ; A MUMPS routine
+ ; Writes a greeting
+ write "Hello",!
+ quit
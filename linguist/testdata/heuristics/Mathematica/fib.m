@@ -0,0 +1,4 @@
+(* Fibonacci numbers *)
+fib[0] = 0;
+fib[1] = 1;
+fib[n_] := fib[n] = fib[n - 1] + fib[n - 2]
@@ -0,0 +1,4 @@
+function y = rms(x)
+% RMS Root mean square of a vector
+y = sqrt(mean(x .^ 2));
+end
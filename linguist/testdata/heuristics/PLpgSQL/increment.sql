@@ -0,0 +1,5 @@
+CREATE FUNCTION increment(i integer) RETURNS integer AS $$
+BEGIN
+    RETURN i + 1;
+END;
+$$ LANGUAGE plpgsql;
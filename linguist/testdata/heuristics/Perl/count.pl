@@ -0,0 +1,7 @@
+#!/usr/bin/perl
+use strict;
+use warnings;
+
+my %count;
+$count{$_}++ for split //, "hello";
+print "$_: $count{$_}\n" for sort keys %count;
@@ -0,0 +1,8 @@
+use v6;
+
+grammar Greeting {
+    token TOP { 'hello' \s+ <name> }
+    token name { \w+ }
+}
+
+say Greeting.parse('hello world');
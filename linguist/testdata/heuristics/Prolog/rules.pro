@@ -0,0 +1,2 @@
+likes(mary, wine).
+happy(X) :- likes(X, wine).
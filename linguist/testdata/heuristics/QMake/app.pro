@@ -0,0 +1,3 @@
+TEMPLATE = app
+HEADERS += mainwindow.h
+SOURCES += main.cpp mainwindow.cpp
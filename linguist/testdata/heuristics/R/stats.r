@@ -0,0 +1,3 @@
+# Summary statistics
+x <- c(1, 2, 3)
+print(mean(x))
@@ -0,0 +1,2 @@
+REBOL [Title: "Hello"]
+print "Hello"
@@ -0,0 +1,6 @@
+#pragma version(1)
+#pragma rs java_package_name(com.example.mono)
+
+uchar4 __attribute__((kernel)) invert(uchar4 in) {
+    return 255 - in;
+}
@@ -0,0 +1,4 @@
+module counter (input clk, output reg [3:0] count);
+  always @(posedge clk)
+    count <= count + 1;
+endmodule
@@ -0,0 +1,2 @@
+<!ENTITY % inline "#PCDATA | em | strong">
+<!ENTITY copy "&#169;">
@@ -0,0 +1,35 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+
+namespace Shop
+{
+    public class Item
+    {
+        public string Name { get; set; }
+        public decimal Price { get; set; }
+        public int Quantity { get; set; }
+    }
+
+    public class Inventory
+    {
+        private readonly List<Item> _items = new List<Item>();
+
+        public void Add(Item item)
+        {
+            if (item == null)
+            {
+                throw new ArgumentNullException(nameof(item));
+            }
+
+            _items.Add(item);
+        }
+
+        public decimal TotalValue()
+        {
+            return _items.Sum(i => i.Price * i.Quantity);
+        }
+
+        public IEnumerable<Item> InStock() => _items.Where(i => i.Quantity > 0);
+    }
+}
@@ -0,0 +1,22 @@
+using System;
+using System.Threading.Tasks;
+
+namespace Shop
+{
+    internal static class Program
+    {
+        private static async Task Main(string[] args)
+        {
+            var inventory = new Inventory();
+            inventory.Add(new Item { Name = "Pen", Price = 1.5m, Quantity = 10 });
+
+            foreach (var item in inventory.InStock())
+            {
+                Console.WriteLine($"{item.Name}: {item.Quantity}");
+            }
+
+            await Task.Delay(100);
+            Console.WriteLine(inventory.TotalValue());
+        }
+    }
+}
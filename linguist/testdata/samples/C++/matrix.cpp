@@ -0,0 +1,44 @@
+#include <iostream>
+#include <vector>
+#include <stdexcept>
+
+namespace linalg {
+
+template <typename T>
+class Matrix {
+public:
+    Matrix(std::size_t rows, std::size_t cols)
+        : rows_(rows), cols_(cols), data_(rows * cols) {}
+
+    T& operator()(std::size_t r, std::size_t c) { return data_[r * cols_ + c]; }
+    const T& operator()(std::size_t r, std::size_t c) const { return data_[r * cols_ + c]; }
+
+    Matrix operator*(const Matrix& other) const {
+        if (cols_ != other.rows_) {
+            throw std::invalid_argument("dimension mismatch");
+        }
+
+        Matrix result(rows_, other.cols_);
+        for (std::size_t i = 0; i < rows_; ++i)
+            for (std::size_t j = 0; j < other.cols_; ++j)
+                for (std::size_t k = 0; k < cols_; ++k)
+                    result(i, j) += (*this)(i, k) * other(k, j);
+        return result;
+    }
+
+private:
+    std::size_t rows_;
+    std::size_t cols_;
+    std::vector<T> data_;
+};
+
+} // namespace linalg
+
+int main() {
+    linalg::Matrix<double> m(2, 2);
+    m(0, 0) = 1.0;
+    m(1, 1) = 1.0;
+    auto p = m * m;
+    std::cout << p(0, 0) << std::endl;
+    return 0;
+}
@@ -0,0 +1,25 @@
+#pragma once
+
+#include <memory>
+#include <string>
+
+class Shape {
+public:
+    virtual ~Shape() = default;
+    virtual double area() const = 0;
+    virtual std::string name() const { return "shape"; }
+};
+
+class Circle : public Shape {
+public:
+    explicit Circle(double radius) : radius_(radius) {}
+    double area() const override { return 3.14159 * radius_ * radius_; }
+    std::string name() const override { return "circle"; }
+
+private:
+    double radius_;
+};
+
+std::unique_ptr<Shape> make_circle(double radius) {
+    return std::make_unique<Circle>(radius);
+}
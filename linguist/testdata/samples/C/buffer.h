@@ -0,0 +1,24 @@
+#ifndef BUFFER_H
+#define BUFFER_H
+
+#include <stddef.h>
+#include <stdint.h>
+
+typedef struct {
+	uint8_t *data;
+	size_t len;
+	size_t cap;
+} buffer_t;
+
+int buffer_init(buffer_t *buf, size_t cap);
+int buffer_append(buffer_t *buf, const void *data, size_t len);
+void buffer_free(buffer_t *buf);
+
+static inline size_t buffer_len(const buffer_t *buf)
+{
+	return buf->len;
+}
+
+#define BUFFER_EMPTY(buf) ((buf)->len == 0)
+
+#endif /* BUFFER_H */
@@ -0,0 +1,45 @@
+#include <stdio.h>
+#include <stdlib.h>
+#include <string.h>
+
+struct node {
+	int value;
+	struct node *next;
+};
+
+static struct node *push(struct node *head, int value)
+{
+	struct node *n = malloc(sizeof(*n));
+	if (n == NULL) {
+		perror("malloc");
+		exit(EXIT_FAILURE);
+	}
+
+	n->value = value;
+	n->next = head;
+	return n;
+}
+
+static void free_list(struct node *head)
+{
+	while (head != NULL) {
+		struct node *next = head->next;
+		free(head);
+		head = next;
+	}
+}
+
+int main(int argc, char **argv)
+{
+	struct node *head = NULL;
+	int i;
+
+	for (i = 1; i < argc; i++)
+		head = push(head, atoi(argv[i]));
+
+	for (struct node *n = head; n != NULL; n = n->next)
+		printf("%d\n", n->value);
+
+	free_list(head);
+	return 0;
+}
@@ -0,0 +1,41 @@
+package queue
+
+import (
+	"context"
+	"errors"
+)
+
+var ErrClosed = errors.New("queue closed")
+
+type Queue struct {
+	ch chan interface{}
+}
+
+func New(size int) *Queue {
+	return &Queue{ch: make(chan interface{}, size)}
+}
+
+func (q *Queue) Push(ctx context.Context, v interface{}) error {
+	select {
+	case q.ch <- v:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (q *Queue) Pop(ctx context.Context) (interface{}, error) {
+	select {
+	case v, ok := <-q.ch:
+		if !ok {
+			return nil, ErrClosed
+		}
+		return v, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (q *Queue) Close() {
+	close(q.ch)
+}
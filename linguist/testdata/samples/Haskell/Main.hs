@@ -0,0 +1,19 @@
+module Main where
+
+import qualified Data.Map.Strict as Map
+import Data.Char (toLower, isAlpha)
+import Data.List (sortBy)
+import Data.Ord (comparing, Down(..))
+
+wordFreq :: String -> Map.Map String Int
+wordFreq = foldr (\w -> Map.insertWith (+) w 1) Map.empty . words . map normalise
+  where
+    normalise c
+      | isAlpha c = toLower c
+      | otherwise = ' '
+
+main :: IO ()
+main = do
+  contents <- getContents
+  let freq = sortBy (comparing (Down . snd)) (Map.toList (wordFreq contents))
+  mapM_ (\(w, n) -> putStrLn (show n ++ " " ++ w)) (take 10 freq)
@@ -0,0 +1,31 @@
+module Tree
+  ( Tree(..)
+  , insert
+  , member
+  , toList
+  ) where
+
+data Tree a = Leaf | Node (Tree a) a (Tree a)
+  deriving (Show, Eq)
+
+instance Functor Tree where
+  fmap _ Leaf = Leaf
+  fmap f (Node l x r) = Node (fmap f l) (f x) (fmap f r)
+
+insert :: Ord a => a -> Tree a -> Tree a
+insert x Leaf = Node Leaf x Leaf
+insert x t@(Node l y r)
+  | x < y = Node (insert x l) y r
+  | x > y = Node l y (insert x r)
+  | otherwise = t
+
+member :: Ord a => a -> Tree a -> Bool
+member _ Leaf = False
+member x (Node l y r) = case compare x y of
+  LT -> member x l
+  GT -> member x r
+  EQ -> True
+
+toList :: Tree a -> [a]
+toList Leaf = []
+toList (Node l x r) = toList l ++ [x] ++ toList r
@@ -0,0 +1,43 @@
+1_000_000
+1__000000
+1000000_
+_1000000
+
+0.0001
+0.000_1
+0_.0001
+_0.0001
+0._0001
+0.0001_
+
+1e+23
+1_e+23
+1__e+23
+1e_+23
+
+1e+23
+1e+_23
+1e+23_
+
+0x
+0xffff
+0xff_ff
+0x_ffff
+0x__ffff
+_0xffff
+
+0b
+0b01_0000__0000
+0b01_
+0b_01
+0b__01
+
+3.141_592_653_589_793
+96_485.332_89
+6.022_140_857e+23
+
+0o
+0o7_77
+0o700_
+0o_700
+0o__700
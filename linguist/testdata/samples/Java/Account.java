@@ -0,0 +1,40 @@
+package com.example.bank;
+
+import java.math.BigDecimal;
+import java.util.ArrayList;
+import java.util.List;
+
+public class Account {
+    private final String owner;
+    private BigDecimal balance = BigDecimal.ZERO;
+    private final List<String> history = new ArrayList<>();
+
+    public Account(String owner) {
+        this.owner = owner;
+    }
+
+    public synchronized void deposit(BigDecimal amount) {
+        if (amount.signum() <= 0) {
+            throw new IllegalArgumentException("amount must be positive");
+        }
+        balance = balance.add(amount);
+        history.add("deposit " + amount);
+    }
+
+    public synchronized void withdraw(BigDecimal amount) throws InsufficientFundsException {
+        if (balance.compareTo(amount) < 0) {
+            throw new InsufficientFundsException(owner);
+        }
+        balance = balance.subtract(amount);
+        history.add("withdraw " + amount);
+    }
+
+    public BigDecimal getBalance() {
+        return balance;
+    }
+
+    @Override
+    public String toString() {
+        return owner + ": " + balance;
+    }
+}
@@ -0,0 +1,21 @@
+package com.example.bank;
+
+import java.math.BigDecimal;
+
+public final class Main {
+    private Main() {
+    }
+
+    public static void main(String[] args) {
+        Account account = new Account("alice");
+        account.deposit(new BigDecimal("100.00"));
+
+        try {
+            account.withdraw(new BigDecimal("250.00"));
+        } catch (InsufficientFundsException e) {
+            System.err.println(e.getMessage());
+        }
+
+        System.out.println(account);
+    }
+}
@@ -0,0 +1,28 @@
+'use strict';
+
+const express = require('express');
+const path = require('path');
+
+const app = express();
+const port = process.env.PORT || 3000;
+
+app.use(express.json());
+app.use(express.static(path.join(__dirname, 'public')));
+
+const todos = [];
+
+app.get('/todos', (req, res) => {
+  res.json(todos);
+});
+
+app.post('/todos', (req, res) => {
+  const todo = { id: todos.length + 1, title: req.body.title, done: false };
+  todos.push(todo);
+  res.status(201).json(todo);
+});
+
+app.listen(port, () => {
+  console.log(`Listening on ${port}`);
+});
+
+module.exports = app;
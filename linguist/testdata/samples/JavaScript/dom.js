@@ -0,0 +1,22 @@
+document.addEventListener('DOMContentLoaded', function () {
+  var button = document.getElementById('load');
+  var list = document.querySelector('.items');
+
+  button.addEventListener('click', function (event) {
+    event.preventDefault();
+
+    fetch('/todos')
+      .then(function (response) { return response.json(); })
+      .then(function (items) {
+        list.innerHTML = '';
+        items.forEach(function (item) {
+          var li = document.createElement('li');
+          li.textContent = item.title;
+          list.appendChild(li);
+        });
+      })
+      .catch(function (err) {
+        console.error(err);
+      });
+  });
+});
@@ -0,0 +1,26 @@
+local Inventory = {}
+Inventory.__index = Inventory
+
+function Inventory.new()
+  local self = setmetatable({}, Inventory)
+  self.items = {}
+  return self
+end
+
+function Inventory:add(name, count)
+  self.items[name] = (self.items[name] or 0) + (count or 1)
+end
+
+function Inventory:remove(name)
+  if not self.items[name] then
+    return nil, "no such item"
+  end
+  self.items[name] = nil
+  return true
+end
+
+function Inventory:each()
+  return pairs(self.items)
+end
+
+return Inventory
@@ -0,0 +1,14 @@
+local Inventory = require("inventory")
+
+local inv = Inventory.new()
+inv:add("sword")
+inv:add("potion", 3)
+
+for name, count in inv:each() do
+  print(string.format("%s x%d", name, count))
+end
+
+local ok, err = inv:remove("shield")
+if not ok then
+  io.stderr:write(err .. "\n")
+end
@@ -0,0 +1,11 @@
+% Plot noisy samples and their moving average
+t = linspace(0, 2*pi, 200);
+x = sin(t) + 0.2 * randn(size(t));
+y = smooth(x, 9);
+
+figure;
+plot(t, x, '.', t, y, '-');
+xlabel('t');
+ylabel('amplitude');
+legend('samples', 'smoothed');
+disp(sprintf('RMS error: %f', sqrt(mean((y - sin(t)).^2))));
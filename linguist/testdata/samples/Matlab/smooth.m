@@ -0,0 +1,15 @@
+function y = smooth(x, window)
+%SMOOTH Moving average of a vector
+%   Y = SMOOTH(X, WINDOW) averages X over WINDOW samples.
+if nargin < 2
+    window = 5;
+end
+
+n = numel(x);
+y = zeros(size(x));
+for k = 1:n
+    lo = max(1, k - floor(window / 2));
+    hi = min(n, k + floor(window / 2));
+    y(k) = mean(x(lo:hi));
+end
+end
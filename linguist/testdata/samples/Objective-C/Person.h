@@ -0,0 +1,15 @@
+#import <Foundation/Foundation.h>
+
+NS_ASSUME_NONNULL_BEGIN
+
+@interface Person : NSObject <NSCopying>
+
+@property (nonatomic, copy) NSString *name;
+@property (nonatomic, assign) NSUInteger age;
+
+- (instancetype)initWithName:(NSString *)name age:(NSUInteger)age;
++ (instancetype)personWithName:(NSString *)name;
+
+@end
+
+NS_ASSUME_NONNULL_END
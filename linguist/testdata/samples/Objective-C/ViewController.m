@@ -0,0 +1,26 @@
+#import "ViewController.h"
+#import <Foundation/Foundation.h>
+
+@interface ViewController ()
+@property (nonatomic, strong) NSArray<NSString *> *items;
+@end
+
+@implementation ViewController
+
+- (void)viewDidLoad {
+    [super viewDidLoad];
+    self.items = @[@"One", @"Two", @"Three"];
+    [self.tableView reloadData];
+}
+
+- (NSInteger)tableView:(UITableView *)tableView numberOfRowsInSection:(NSInteger)section {
+    return [self.items count];
+}
+
+- (UITableViewCell *)tableView:(UITableView *)tableView cellForRowAtIndexPath:(NSIndexPath *)indexPath {
+    UITableViewCell *cell = [tableView dequeueReusableCellWithIdentifier:@"Cell" forIndexPath:indexPath];
+    cell.textLabel.text = self.items[indexPath.row];
+    return cell;
+}
+
+@end
@@ -0,0 +1,21 @@
+<?php
+
+function slugify($text)
+{
+    $text = preg_replace('~[^\pL\d]+~u', '-', $text);
+    $text = strtolower(trim($text, '-'));
+
+    if (empty($text)) {
+        return 'n-a';
+    }
+
+    return $text;
+}
+
+$posts = array();
+foreach ($_POST['titles'] as $key => $title) {
+    $posts[$key] = slugify($title);
+}
+
+echo json_encode($posts);
+?>
@@ -0,0 +1,23 @@
+package Counter;
+
+use strict;
+use warnings;
+
+sub new {
+    my ($class, %args) = @_;
+    my $self = { count => $args{start} // 0 };
+    return bless $self, $class;
+}
+
+sub increment {
+    my $self = shift;
+    $self->{count}++;
+    return $self;
+}
+
+sub value {
+    my $self = shift;
+    return $self->{count};
+}
+
+1;
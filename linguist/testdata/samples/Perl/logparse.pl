@@ -0,0 +1,19 @@
+#!/usr/bin/perl
+use strict;
+use warnings;
+use Getopt::Long;
+
+my $min = 1;
+GetOptions('min=i' => \$min) or die "usage: $0 [--min N] file...\n";
+
+my %hits;
+while (my $line = <>) {
+    chomp $line;
+    next unless $line =~ m{^(\S+) \S+ \S+ \[[^\]]+\] "(?:GET|POST) (\S+)};
+    $hits{$2}++;
+}
+
+foreach my $path (sort { $hits{$b} <=> $hits{$a} } keys %hits) {
+    last if $hits{$path} < $min;
+    printf "%6d %s\n", $hits{$path}, $path;
+}
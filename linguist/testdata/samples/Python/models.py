@@ -0,0 +1,28 @@
+from dataclasses import dataclass, field
+from typing import List, Optional
+
+
+class ValidationError(Exception):
+    pass
+
+
+@dataclass
+class User:
+    name: str
+    email: str
+    roles: List[str] = field(default_factory=list)
+    manager: Optional["User"] = None
+
+    def __post_init__(self):
+        if "@" not in self.email:
+            raise ValidationError(self.email)
+
+    def is_admin(self) -> bool:
+        return "admin" in self.roles
+
+    @property
+    def display_name(self):
+        return self.name.title()
+
+    def __repr__(self):
+        return f"User({self.name!r})"
@@ -0,0 +1,33 @@
+#!/usr/bin/env python3
+import argparse
+import collections
+import sys
+
+
+def count_words(lines):
+    counter = collections.Counter()
+    for line in lines:
+        for word in line.split():
+            counter[word.lower()] += 1
+    return counter
+
+
+def main(argv=None):
+    parser = argparse.ArgumentParser(description=__doc__)
+    parser.add_argument("files", nargs="*")
+    parser.add_argument("-n", type=int, default=10)
+    args = parser.parse_args(argv)
+
+    counter = collections.Counter()
+    if not args.files:
+        counter.update(count_words(sys.stdin))
+    for name in args.files:
+        with open(name) as f:
+            counter.update(count_words(f))
+
+    for word, count in counter.most_common(args.n):
+        print(f"{count:6d} {word}")
+
+
+if __name__ == "__main__":
+    main()
@@ -0,0 +1,19 @@
+require 'rake/testtask'
+
+Rake::TestTask.new(:test) do |t|
+  t.libs << 'test'
+  t.pattern = 'test/**/*_test.rb'
+end
+
+desc 'Print the version'
+task :version do
+  puts File.read('VERSION').strip
+end
+
+namespace :db do
+  task :migrate, [:version] => :environment do |_t, args|
+    ActiveRecord::Migrator.migrate('db/migrate', args[:version]&.to_i)
+  end
+end
+
+task default: :test
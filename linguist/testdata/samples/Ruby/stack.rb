@@ -0,0 +1,34 @@
+require 'forwardable'
+
+module Collections
+  class Stack
+    extend Forwardable
+    include Enumerable
+
+    def_delegators :@items, :size, :empty?
+
+    def initialize(*items)
+      @items = items
+    end
+
+    def push(item)
+      @items.push(item)
+      self
+    end
+    alias << push
+
+    def pop
+      raise IndexError, 'stack is empty' if empty?
+
+      @items.pop
+    end
+
+    def each(&block)
+      @items.reverse.each(&block)
+    end
+
+    def to_s
+      "#<Stack #{@items.inspect}>"
+    end
+  end
+end
@@ -0,0 +1,49 @@
+use std::collections::HashMap;
+use std::fmt;
+
+#[derive(Debug, Clone, PartialEq)]
+pub enum Token {
+    Number(f64),
+    Ident(String),
+    Op(char),
+}
+
+impl fmt::Display for Token {
+    fn fmt(&self, f: &mut fmt::Formatter<'_>) -> fmt::Result {
+        match self {
+            Token::Number(n) => write!(f, "{}", n),
+            Token::Ident(s) => write!(f, "{}", s),
+            Token::Op(c) => write!(f, "{}", c),
+        }
+    }
+}
+
+pub struct Env {
+    vars: HashMap<String, f64>,
+}
+
+impl Env {
+    pub fn new() -> Self {
+        Env { vars: HashMap::new() }
+    }
+
+    pub fn get(&self, name: &str) -> Option<f64> {
+        self.vars.get(name).copied()
+    }
+
+    pub fn set(&mut self, name: &str, value: f64) {
+        self.vars.insert(name.to_string(), value);
+    }
+}
+
+#[cfg(test)]
+mod tests {
+    use super::*;
+
+    #[test]
+    fn set_and_get() {
+        let mut env = Env::new();
+        env.set("x", 1.0);
+        assert_eq!(env.get("x"), Some(1.0));
+    }
+}
@@ -0,0 +1,20 @@
+use std::env;
+use std::fs::File;
+use std::io::{self, BufRead, BufReader};
+
+fn main() -> io::Result<()> {
+    let args: Vec<String> = env::args().collect();
+    let path = args.get(1).expect("usage: lines FILE");
+
+    let file = File::open(path)?;
+    let mut count = 0usize;
+    for line in BufReader::new(file).lines() {
+        let line = line?;
+        if !line.trim().is_empty() {
+            count += 1;
+        }
+    }
+
+    println!("{} non-empty lines", count);
+    Ok(())
+}
@@ -0,0 +1,21 @@
+#!/bin/bash
+set -euo pipefail
+
+SRC=${1:?usage: backup.sh SRC DEST}
+DEST=${2:?usage: backup.sh SRC DEST}
+STAMP=$(date +%Y%m%d-%H%M%S)
+
+if [ ! -d "$SRC" ]; then
+  echo "no such directory: $SRC" >&2
+  exit 1
+fi
+
+mkdir -p "$DEST"
+tar -czf "$DEST/backup-$STAMP.tar.gz" -C "$SRC" .
+
+# Keep the last 7 backups
+ls -1t "$DEST"/backup-*.tar.gz | tail -n +8 | while read -r old; do
+  rm -f "$old"
+done
+
+echo "done: $DEST/backup-$STAMP.tar.gz"
@@ -0,0 +1,23 @@
+#!/bin/sh
+
+PREFIX="${PREFIX:-/usr/local}"
+
+for bin in bin/*; do
+  install -m 0755 "$bin" "$PREFIX/bin/"
+done
+
+case "$(uname -s)" in
+  Darwin)
+    echo "installing launchd agent"
+    cp contrib/agent.plist "$HOME/Library/LaunchAgents/"
+    ;;
+  Linux)
+    if command -v systemctl >/dev/null 2>&1; then
+      cp contrib/agent.service /etc/systemd/system/
+      systemctl daemon-reload
+    fi
+    ;;
+  *)
+    echo "unsupported system" >&2
+    ;;
+esac
@@ -0,0 +1,170 @@
+package linguist
+
+import (
+	"bytes"
+	"regexp"
+)
+
+// Only the start of large blobs is tokenized
+const tokenizerLimit = 100000
+
+var (
+	// Comments and string literals are skipped, as they are mostly natural
+	// language, as are number literals
+	singleLineComment = regexp.MustCompile(`^[ \t]*(?://[/!]?|--|#|%|")[ \t]`)
+	numberLiteral     = regexp.MustCompile(`^(?:0x[0-9a-fA-F][0-9a-fA-F.]*|\d[\d.]*)(?:[uU][lL]{0,2}|(?:[eE][-+]?\d*)?[fFlL]*)`)
+
+	// Perl's POD and Ruby's =begin blocks are documentation
+	docBlock = regexp.MustCompile(`^=[a-z]\w*(?:.|\n)*?(?:\n=(?:cut|end)\b.*|\z)`)
+
+	preprocessorToken = regexp.MustCompile(`^#\w+`)
+	sgmlTag           = regexp.MustCompile(`^<[^\s<>][^<>]*>`)
+	sgmlAttribute     = regexp.MustCompile(`(\w[\w:-]*)=`)
+	wordToken         = regexp.MustCompile(`^[\w.@#/*]+`)
+	operatorToken     = regexp.MustCompile(`^(?:<<?|\+|-|\*|/|%|&&?|\|\|?)`)
+	punctuationToken  = regexp.MustCompile(`^[;{}()\[\]]`)
+)
+
+var multiLineComments = []struct{ start, end []byte }{
+	{[]byte("/*"), []byte("*/")},
+	{[]byte("<!--"), []byte("-->")},
+	{[]byte("{-"), []byte("-}")},
+	{[]byte("(*"), []byte("*)")},
+	{[]byte(`"""`), []byte(`"""`)},
+	{[]byte("'''"), []byte("'''")},
+}
+
+// Tokenize splits source code into the tokens the classifier is trained on,
+// as github-linguist does: identifiers, keywords, operators and punctuation,
+// `SHEBANG#!ruby` for a `#!` line, and `<tag>` and `attr=` for SGML tags.
+func Tokenize(blob []byte) []string {
+	if len(blob) > tokenizerLimit {
+		blob = blob[:tokenizerLimit]
+	}
+
+	var tokens []string
+
+	if interpreter := Interpreter(blob); interpreter != "" {
+		tokens = append(tokens, "SHEBANG#!"+interpreter)
+		blob = skipLine(blob)
+	}
+
+	lineStart := true
+	for len(blob) > 0 {
+		if lineStart {
+			if loc := docBlock.FindIndex(blob); loc != nil {
+				blob = blob[loc[1]:]
+				continue
+			}
+
+			if loc := preprocessorToken.FindIndex(blob); loc != nil {
+				tokens = append(tokens, string(blob[:loc[1]]))
+				blob, lineStart = blob[loc[1]:], false
+				continue
+			}
+		}
+
+		if singleLineComment.Match(blob) {
+			blob, lineStart = skipLine(blob), true
+			continue
+		}
+
+		if rest, ok := skipMultiLineComment(blob); ok {
+			blob, lineStart = rest, false
+			continue
+		}
+
+		if blob[0] == '"' || blob[0] == '\'' {
+			blob, lineStart = skipString(blob), false
+			continue
+		}
+
+		if loc := numberLiteral.FindIndex(blob); loc != nil {
+			blob, lineStart = blob[loc[1]:], false
+			continue
+		}
+
+		if loc := sgmlTag.FindIndex(blob); loc != nil {
+			tokens = append(tokens, sgmlTokens(blob[:loc[1]])...)
+			blob, lineStart = blob[loc[1]:], false
+			continue
+		}
+
+		matched := false
+		for _, re := range []*regexp.Regexp{wordToken, operatorToken, punctuationToken} {
+			if loc := re.FindIndex(blob); loc != nil {
+				tokens = append(tokens, string(blob[:loc[1]]))
+				blob, matched = blob[loc[1]:], true
+				break
+			}
+		}
+
+		if matched {
+			lineStart = false
+			continue
+		}
+
+		lineStart = blob[0] == '\n'
+		blob = blob[1:]
+	}
+
+	return tokens
+}
+
+func skipLine(blob []byte) []byte {
+	if end := bytes.IndexByte(blob, '\n'); end >= 0 {
+		return blob[end+1:]
+	}
+
+	return nil
+}
+
+func skipMultiLineComment(blob []byte) ([]byte, bool) {
+	for _, comment := range multiLineComments {
+		if !bytes.HasPrefix(blob, comment.start) {
+			continue
+		}
+
+		rest := blob[len(comment.start):]
+		if end := bytes.Index(rest, comment.end); end >= 0 {
+			return rest[end+len(comment.end):], true
+		}
+
+		return nil, true
+	}
+
+	return nil, false
+}
+
+// skipString skips to after the closing quote, which can't be escaped. An
+// unterminated string runs to the end of the line.
+func skipString(blob []byte) []byte {
+	quote := blob[0]
+	for i := 1; i < len(blob); i++ {
+		switch blob[i] {
+		case '\\':
+			i++
+		case quote:
+			return blob[i+1:]
+		case '\n':
+			return blob[i:]
+		}
+	}
+
+	return nil
+}
+
+// sgmlTokens turns `<a href="/">` into "<a>" and "href="
+func sgmlTokens(tag []byte) []string {
+	name := tag[1:]
+	if end := bytes.IndexAny(name, " \t\r\n>"); end >= 0 {
+		name = name[:end]
+	}
+
+	tokens := []string{"<" + string(name) + ">"}
+	for _, match := range sgmlAttribute.FindAllSubmatch(tag[1+len(name):], -1) {
+		tokens = append(tokens, string(match[1])+"=")
+	}
+
+	return tokens
+}
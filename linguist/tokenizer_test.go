@@ -0,0 +1,39 @@
+package linguist_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/gitlab-elasticsearch-indexer/linguist"
+)
+
+func TestTokenize(t *testing.T) {
+	for _, tc := range []struct {
+		blob   string
+		tokens []string
+	}{
+		{"", nil},
+		{"#!/usr/bin/env ruby\nputs 1\n", []string{"SHEBANG#!ruby", "puts"}},
+		{"#include <stdio.h>\nint x;\n", []string{"#include", "<stdio.h>", "int", "x", ";"}},
+
+		// Comments, strings and numbers are skipped
+		{"a // one\nb # two\nc", []string{"a", "b", "c"}},
+		{"a /* one\ntwo */ b <!-- three --> c", []string{"a", "b", "c"}},
+		{`x = "a \" b" + 'c' + 0x1F + 1.5e3f`, []string{"x", "+", "+", "+"}},
+		{`"""doc""" def`, []string{"def"}},
+		{"a\n/// one\n//! two\nb", []string{"a", "b"}},
+
+		// So is documentation in POD and =begin blocks
+		{"sub a;\n\n=head1 NAME\n\nsub x;\n\n=cut\n\nsub b;", []string{"sub", "a", ";", "sub", "b", ";"}},
+		{"=begin\nputs x\n=end\nputs y\n", []string{"puts", "y"}},
+
+		// Operators and punctuation
+		{"if (a && b || c) { d << e; }", []string{"if", "(", "a", "&&", "b", "||", "c", ")", "{", "d", "<<", "e", ";", "}"}},
+
+		// SGML tags and their attributes
+		{`<a href="/" class='x'>link</a>`, []string{"<a>", "href=", "class=", "link", "</a>"}},
+	} {
+		require.Equal(t, tc.tokens, linguist.Tokenize([]byte(tc.blob)), tc.blob)
+	}
+}
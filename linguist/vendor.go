@@ -0,0 +1,208 @@
+package linguist
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Paths of third-party code, from vendor.yml of github-linguist
+var vendorPatterns = []string{
+	// Vendor conventions
+	`(^|/)cache/`,
+	`^[Dd]ependencies/`,
+	`(^|/)dist/`,
+	`^deps/`,
+	`^tools/`,
+	`(^|/)configure$`,
+	`(^|/)config\.guess$`,
+	`(^|/)config\.sub$`,
+	`(^|/)aclocal\.m4`,
+	`(^|/)libtool\.m4`,
+	`(^|/)ltoptions\.m4`,
+	`(^|/)ltsugar\.m4`,
+	`(^|/)ltversion\.m4`,
+	`(^|/)lt~obsolete\.m4`,
+	`cpplint\.py`,
+	`node_modules/`,
+	`bower_components/`,
+	`^rebar$`,
+	`erlang\.mk`,
+	`Godeps/_workspace/`,
+	`third[-_]?party/`,
+	`3rd[-_]?party/`,
+	`vendors?/`,
+	`extern(al)?/`,
+	`(^|/)[Vv]+endor/`,
+	`^debian/`,
+	`run\.n$`,
+
+	// Minified and bundled stylesheets and frameworks
+	`(\.|-)min\.(js|css)$`,
+	`([^\s]*)import\.(css|less|scss|styl)$`,
+	`(^|/)bootstrap([^.]*)\.(js|css|less|scss|styl)$`,
+	`(^|/)custom\.bootstrap([^\s]*)(js|css|less|scss|styl)$`,
+	`(^|/)font-awesome\.(css|less|scss|styl)$`,
+	`(^|/)foundation\.(css|less|scss|styl)$`,
+	`(^|/)normalize\.(css|less|scss|styl)$`,
+	`(^|/)[Bb]ourbon/.*\.(css|less|scss|styl)$`,
+	`(^|/)animate\.(css|less|scss|styl)$`,
+
+	// JavaScript libraries
+	`bootstrap-datepicker/`,
+	`(^|/)jquery([^.]*)\.js$`,
+	`(^|/)jquery-\d\.\d+(\.\d+)?\.js$`,
+	`(^|/)jquery-ui(-\d\.\d+(\.\d+)?)?(\.\w+)?\.(js|css)$`,
+	`(^|/)jquery\.(ui|effects)\.([^.]*)\.(js|css)$`,
+	`jquery\.fn\.gantt\.js`,
+	`jquery\.fancybox\.(js|css)`,
+	`fuelux\.js`,
+	`(^|/)jquery\.fileupload(-\w+)?\.js$`,
+	`(^|/)slick\.\w+\.js$`,
+	`(^|/)Leaflet\.Coordinates-\d+\.\d+\.\d+\.src\.js$`,
+	`leaflet\.draw-src\.js`,
+	`leaflet\.draw\.css`,
+	`Control\.FullScreen\.(css|js)`,
+	`leaflet\.spin\.js`,
+	`wicket-leaflet\.js`,
+	`(^|/)prototype(.*)\.js$`,
+	`(^|/)effects\.js$`,
+	`(^|/)controls\.js$`,
+	`(^|/)dragdrop\.js$`,
+	`(.*?)\.d\.ts$`,
+	`(^|/)mootools([^.]*)\d+\.\d+\.\d+([^.]*)\.js$`,
+	`(^|/)dojo\.js$`,
+	`(^|/)MochiKit\.js$`,
+	`(^|/)yahoo-([^.]*)\.js$`,
+	`(^|/)yui([^.]*)\.js$`,
+	`(^|/)ckeditor\.js$`,
+	`(^|/)tiny_mce([^.]*)\.js$`,
+	`(^|/)tiny_mce/(langs|plugins|themes|utils)`,
+	`(^|/)MathJax/`,
+	`(^|/)Chart\.js$`,
+	`(^|/)[Cc]ode[Mm]irror/(\d+\.\d+/)?(lib|mode|theme|addon|keymap|demo)`,
+	`(^|/)shBrush([^.]*)\.js$`,
+	`(^|/)shCore\.js$`,
+	`(^|/)shLegacy\.js$`,
+	`(^|/)angular([^.]*)\.js$`,
+	`(^|/)d3(\.v\d+)?([^.]*)\.js$`,
+	`(^|/)react(-[^.]*)?\.js$`,
+	`(^|/)modernizr-\d\.\d+(\.\d+)?\.js$`,
+	`(^|/)modernizr\.custom\.\d+\.js$`,
+	`(^|/)knockout-(\d+\.){3}(debug\.)?js$`,
+
+	// Generated documentation and Python environments
+	`(^|/)docs?/_?(build|themes?|templates?|static)/`,
+	`(^|/)admin_media/`,
+	`(^|/)env/`,
+	`^fabfile\.py$`,
+	`^waf$`,
+	`^\.osx$`,
+
+	// Xcode, CocoaPods and Carthage
+	`\.xctemplate/`,
+	`\.imageset/`,
+	`^Carthage/`,
+	`^Pods/`,
+	`(^|/)Sparkle/`,
+	`Crashlytics\.framework/`,
+	`Fabric\.framework/`,
+	`BuddyBuildSDK\.framework/`,
+	`Realm\.framework`,
+	`RealmSwift\.framework`,
+
+	// Git and editor configuration
+	`gitattributes$`,
+	`gitignore$`,
+	`gitmodules$`,
+	`\.sublime-project`,
+	`\.sublime-workspace`,
+
+	// Gradle wrapper
+	`(^|/)gradlew$`,
+	`(^|/)gradlew\.bat$`,
+	`(^|/)gradle/wrapper/`,
+
+	// .NET
+	`-vsdoc\.js$`,
+	`\.intellisense\.js$`,
+	`(^|/)jquery([^.]*)\.validate(\.unobtrusive)?\.js$`,
+	`(^|/)jquery([^.]*)\.unobtrusive-ajax\.js$`,
+	`(^|/)[Mm]icrosoft([Mm]vc)?([Aa]jax|[Vv]alidation)(\.debug)?\.js$`,
+	`^[Pp]ackages/.+\.\d+/`,
+	`(^|/)extjs/.*?\.js$`,
+	`(^|/)extjs/.*?\.xml$`,
+	`(^|/)extjs/.*?\.txt$`,
+	`(^|/)extjs/.*?\.html$`,
+	`(^|/)extjs/.*?\.properties$`,
+	`(^|/)extjs/\.sencha/`,
+	`(^|/)extjs/docs/`,
+	`(^|/)extjs/builds/`,
+	`(^|/)extjs/cmd/`,
+	`(^|/)extjs/examples/`,
+	`(^|/)extjs/locale/`,
+	`(^|/)extjs/packages/`,
+	`(^|/)extjs/plugins/`,
+	`(^|/)extjs/resources/`,
+	`(^|/)extjs/src/`,
+	`(^|/)extjs/welcome/`,
+
+	// Other libraries, test fixtures and tooling
+	`(^|/)html5shiv\.js$`,
+	`^[Tt]ests?/fixtures/`,
+	`^[Ss]pecs?/fixtures/`,
+	`(^|/)cordova([^.]*)\.js$`,
+	`(^|/)cordova-\d\.\d(\.\d)?\.js$`,
+	`foundation(\..*)?\.js$`,
+	`^Vagrantfile$`,
+	`\.[Dd][Ss]_[Ss]tore$`,
+	`^vignettes/`,
+	`^inst/extdata/`,
+	`octicons\.css`,
+	`sprockets-octicons\.scss`,
+	`(^|/)activator$`,
+	`(^|/)activator\.bat$`,
+	`proguard\.pro`,
+	`proguard-rules\.pro`,
+	`^puphpet/`,
+	`(^|/)\.google_apis/`,
+	`^Jenkinsfile$`,
+}
+
+// Paths of documentation, from documentation.yml of github-linguist
+var documentationPatterns = []string{
+	`^[Dd]ocs?/`,
+	`(^|/)[Dd]ocumentation/`,
+	`(^|/)[Jj]avadoc/`,
+	`^[Mm]an/`,
+	`^[Ee]xamples/`,
+	`(^|/)CHANGE(S|LOG)?(\.|$)`,
+	`(^|/)CONTRIBUTING(\.|$)`,
+	`(^|/)COPYING(\.|$)`,
+	`(^|/)INSTALL(\.|$)`,
+	`(^|/)LICEN[CS]E(\.|$)`,
+	`(^|/)[Ll]icen[cs]e(\.|$)`,
+	`(^|/)README(\.|$)`,
+	`(^|/)[Rr]eadme(\.|$)`,
+	`^[Ss]amples/`,
+}
+
+var (
+	vendorRegexp        = unionRegexp(vendorPatterns)
+	documentationRegexp = unionRegexp(documentationPatterns)
+)
+
+// IsVendored tells whether the file at path is third-party code, e.g. in
+// `vendor/` or `node_modules/`, or a copy of a well-known library
+func IsVendored(path string) bool {
+	return vendorRegexp.MatchString(path)
+}
+
+// IsDocumentation tells whether the file at path is documentation, e.g. a
+// README or in `docs/`
+func IsDocumentation(path string) bool {
+	return documentationRegexp.MatchString(path)
+}
+
+func unionRegexp(patterns []string) *regexp.Regexp {
+	return regexp.MustCompile("(?:" + strings.Join(patterns, ")|(?:") + ")")
+}
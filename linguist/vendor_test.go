@@ -0,0 +1,62 @@
+package linguist_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/gitlab-elasticsearch-indexer/linguist"
+)
+
+func TestIsVendored(t *testing.T) {
+	for _, path := range []string{
+		"vendor/github.com/pkg/errors/errors.go",
+		"app/assets/javascripts/vendor/foo.js",
+		"node_modules/left-pad/index.js",
+		"third_party/zlib/zlib.h",
+		"public/jquery-3.1.1.js",
+		"public/app.min.js",
+		"types/index.d.ts",
+		"Pods/AFNetworking/AFNetworking.h",
+		"gradlew",
+		"configure",
+	} {
+		require.True(t, linguist.IsVendored(path), path)
+	}
+
+	for _, path := range []string{
+		"main.go",
+		"app/models/user.rb",
+		"lib/vendorize.rb",
+		"src/configure.c",
+		"public/app.js",
+	} {
+		require.False(t, linguist.IsVendored(path), path)
+	}
+}
+
+func TestIsDocumentation(t *testing.T) {
+	for _, path := range []string{
+		"README",
+		"README.md",
+		"lib/README.txt",
+		"docs/index.md",
+		"doc/api.md",
+		"app/Documentation/setup.md",
+		"CHANGELOG.md",
+		"CONTRIBUTING.md",
+		"LICENSE",
+		"examples/hello.go",
+	} {
+		require.True(t, linguist.IsDocumentation(path), path)
+	}
+
+	for _, path := range []string{
+		"main.go",
+		"app/docs.rb",
+		"src/examples/hello.go",
+		"READMEFIRST.go",
+	} {
+		require.False(t, linguist.IsDocumentation(path), path)
+	}
+}
@@ -0,0 +1,94 @@
+// Package logging configures the logrus standard logger, which every package
+// of the indexer logs to, and the fields added to all of its entries, so that
+// a log pipeline can tell which run an entry belongs to.
+package logging
+
+import (
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Names of the fields entries are logged with
+const (
+	ProjectID       = "project_id"
+	FromSHA         = "from_sha"
+	ToSHA           = "to_sha"
+	Path            = "path"
+	OID             = "oid"
+	CommitSHA       = "commit_sha"
+	DocumentID      = "document_id"
+	BulkExecutionID = "bulk_execution_id"
+)
+
+var (
+	mu     sync.RWMutex
+	fields = logrus.Fields{}
+)
+
+// Configure sets the output, format ("json" or "text") and level of the
+// standard logger
+func Configure(out io.Writer, format, level string) error {
+	lvl, err := logrus.ParseLevel(level)
+	if err != nil {
+		return fmt.Errorf("Invalid log level %q", level)
+	}
+
+	var formatter logrus.Formatter
+	switch format {
+	case "json":
+		formatter = &logrus.JSONFormatter{}
+	case "text":
+		formatter = &logrus.TextFormatter{}
+	default:
+		return fmt.Errorf("Invalid log format %q: must be 'json' or 'text'", format)
+	}
+
+	logrus.SetOutput(out)
+	logrus.SetLevel(lvl)
+	logrus.SetFormatter(&runFormatter{formatter})
+
+	return nil
+}
+
+// SetFields adds fields to every entry logged from then on, e.g. the project
+// and commits of the run. Fields of the entry itself take precedence.
+func SetFields(f logrus.Fields) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	for k, v := range f {
+		fields[k] = v
+	}
+}
+
+// runFormatter adds the fields of SetFields to an entry before formatting
+// it. The entry is copied, as its fields may be shared with other entries.
+type runFormatter struct {
+	logrus.Formatter
+}
+
+func (f *runFormatter) Format(entry *logrus.Entry) ([]byte, error) {
+	mu.RLock()
+	if len(fields) == 0 {
+		mu.RUnlock()
+		return f.Formatter.Format(entry)
+	}
+
+	data := make(logrus.Fields, len(fields)+len(entry.Data))
+	for k, v := range fields {
+		data[k] = v
+	}
+	mu.RUnlock()
+
+	for k, v := range entry.Data {
+		data[k] = v
+	}
+
+	withFields := *entry
+	withFields.Data = data
+
+	return f.Formatter.Format(&withFields)
+}
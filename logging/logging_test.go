@@ -0,0 +1,62 @@
+package logging_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/gitlab-elasticsearch-indexer/logging"
+)
+
+func configure(t *testing.T, format, level string) (*bytes.Buffer, func()) {
+	var out bytes.Buffer
+	require.NoError(t, logging.Configure(&out, format, level))
+
+	return &out, func() {
+		require.NoError(t, logging.Configure(os.Stderr, "text", "info"))
+	}
+}
+
+func TestJSONEntriesHaveRunFields(t *testing.T) {
+	out, restore := configure(t, "json", "info")
+	defer restore()
+
+	logging.SetFields(logrus.Fields{logging.ProjectID: 667, logging.ToSHA: "b83d6e3"})
+
+	// Fields of the entry win, and an entry shared by goroutines isn't
+	// changed
+	entry := logrus.WithField(logging.ToSHA, "overridden")
+	entry.WithField(logging.Path, "foo/bar").Info("Indexing blob")
+	require.Equal(t, logrus.Fields{logging.ToSHA: "overridden"}, entry.Data)
+
+	var logged map[string]interface{}
+	require.NoError(t, json.Unmarshal(out.Bytes(), &logged))
+
+	require.Equal(t, "Indexing blob", logged["msg"])
+	require.Equal(t, "info", logged["level"])
+	require.Equal(t, float64(667), logged[logging.ProjectID])
+	require.Equal(t, "overridden", logged[logging.ToSHA])
+	require.Equal(t, "foo/bar", logged[logging.Path])
+}
+
+func TestLevel(t *testing.T) {
+	out, restore := configure(t, "text", "warn")
+	defer restore()
+
+	logrus.Info("Hidden")
+	require.Empty(t, out.String())
+
+	logrus.Warn("Shown")
+	require.Contains(t, out.String(), `msg=Shown`)
+}
+
+func TestInvalidConfiguration(t *testing.T) {
+	var out bytes.Buffer
+
+	require.EqualError(t, logging.Configure(&out, "json", "loud"), `Invalid log level "loud"`)
+	require.EqualError(t, logging.Configure(&out, "xml", "info"), `Invalid log format "xml": must be 'json' or 'text'`)
+}
@@ -1,20 +1,58 @@
 package main
 
 import (
+	"context"
 	"flag"
+	"fmt"
+	"net"
+	"net/http"
 	"os"
+	"os/signal"
+	"runtime"
 	"strconv"
+	"strings"
+	"syscall"
 
 	log "github.com/sirupsen/logrus"
+	"gitlab.com/gitlab-org/gitlab-elasticsearch-indexer/checkpoint"
 	"gitlab.com/gitlab-org/gitlab-elasticsearch-indexer/elastic"
 	"gitlab.com/gitlab-org/gitlab-elasticsearch-indexer/git"
 	"gitlab.com/gitlab-org/gitlab-elasticsearch-indexer/indexer"
+	"gitlab.com/gitlab-org/gitlab-elasticsearch-indexer/logging"
+	"gitlab.com/gitlab-org/gitlab-elasticsearch-indexer/metrics"
+)
+
+// log.Fatal exits with 1, so these let callers tell an interrupted run apart
+// from a failed one
+const (
+	exitCodeCancelled = 3
+	exitCodeTimedOut  = 4
+
+	// The mapping of the index doesn't match the one the indexer expects
+	exitCodeMappingDrift = 5
 )
 
 var (
 	versionFlag     = flag.Bool("version", false, "Print the version and exit")
 	skipCommitsFlag = flag.Bool("skip-commits", false, "Skips indexing commits for the repo")
 	blobTypeFlag    = flag.String("blob-type", "blob", "The type of blobs to index. Accepted values: 'blob', 'wiki_blob'")
+	gitDirFlag      = flag.String("git-dir", "", "Read the repository from this local path instead of Gitaly")
+	workersFlag     = flag.Int("workers", runtime.NumCPU(), "The number of blobs to process concurrently")
+	fullFlag        = flag.Bool("full", false, "Reindex the whole tree at TO_SHA and remove documents of blobs that no longer exist")
+	checkpointFlag  = flag.String("checkpoint-dir", "", "Record progress in this directory, so an interrupted run can be resumed")
+	resumeFlag      = flag.Bool("resume", false, "Skip the work recorded in --checkpoint-dir by an earlier run over the same range")
+	dryRunFlag      = flag.Bool("dry-run", false, "Write the bulk requests to stdout as NDJSON instead of sending them to Elasticsearch")
+	outputFlag      = flag.String("output", "", "Write the bulk requests of a dry run to this file instead of stdout. Implies --dry-run")
+	fromNDJSONFlag  = flag.String("from-ndjson", "", "Send the bulk requests in this NDJSON file, e.g. the output of --dry-run, to Elasticsearch and exit")
+	deadLetterFlag  = flag.String("dead-letter", "", "Write the bulk requests of failed operations to this file as NDJSON, to retry them with --from-ndjson")
+	timeoutFlag     = flag.Duration("timeout", 0, "Abort indexing if it takes longer than this, e.g. '30m'. Disabled by default")
+	indexNameFlag   = flag.String("index-name", "", "Write to this index instead of the configured one, e.g. a new index created by 'index reindex'")
+	docVersionFlag  = flag.String("document-version", "", "Write documents with this external version, e.g. a push sequence number, or 'commit-time' for the commit time of TO_SHA, so older runs can't overwrite newer documents")
+	metricsAddrFlag = flag.String("metrics-addr", "", "Serve Prometheus metrics on this address, e.g. ':9236', at /metrics")
+	metricsFileFlag = flag.String("metrics-textfile", "", "Write Prometheus metrics to this file when exiting, e.g. for the textfile collector of the node exporter")
+	logFormatFlag   = flag.String("log-format", "text", "The format of log entries, written to stderr. Accepted values: 'text', 'json'")
+	logLevelFlag    = flag.String("log-level", "", "Log entries at this level and above, e.g. 'warn'. Defaults to 'info', or 'debug' if DEBUG is set")
+	skipBlobsFlag   = flag.String("skip-blobs", "", "Don't index blobs of these comma-separated kinds. Accepted values: 'vendored', 'generated', 'documentation'")
 
 	// Overriden in the makefile
 	Version   = "dev"
@@ -32,8 +70,38 @@ func main() {
 	configureLogger()
 	args := flag.Args()
 
+	// Commands that fail without a fatal error set the exit code, which is
+	// only used once the other deferred calls are done
+	exitCode := 0
+	defer func() {
+		if exitCode != 0 {
+			os.Exit(exitCode)
+		}
+	}()
+
+	startMetrics()
+	defer writeMetrics()
+
+	ctx, cancel := runContext()
+	defer cancel()
+
+	if *fromNDJSONFlag != "" {
+		replayNDJSON(ctx, *fromNDJSONFlag)
+		return
+	}
+
+	if len(args) > 0 && args[0] == "index" {
+		runIndexCommand(ctx, args[1:])
+		return
+	}
+
+	if len(args) > 0 && args[0] == "mapping" {
+		exitCode = runMappingCommand(ctx, args[1:])
+		return
+	}
+
 	if len(args) != 2 {
-		log.Fatalf("Usage: %s [ --version | [--blob-type=(blob|wiki_blob)] [--skip-comits] <project-id> <project-path> ]", os.Args[0])
+		log.Fatalf("Usage: %s [ --version | --from-ndjson=<path> | %s | %s | [--blob-type=(blob|wiki_blob)] [--skip-comits] [--git-dir=<path>] [--workers=<n>] [--full] [--checkpoint-dir=<path> [--resume]] [--dry-run] [--output=<path>] [--dead-letter=<path>] [--timeout=<duration>] [--index-name=<index>] [--document-version=(<n>|commit-time)] [--metrics-addr=<addr>] [--metrics-textfile=<path>] [--log-format=(text|json)] [--log-level=<level>] [--skip-blobs=<kinds>] <project-id> <project-path> ]", os.Args[0], indexUsage, mappingUsage)
 	}
 
 	projectID, err := strconv.ParseInt(args[0], 10, 64)
@@ -42,50 +110,394 @@ func main() {
 		log.Fatal(err)
 	}
 
+	logging.SetFields(log.Fields{logging.ProjectID: projectID})
+
 	projectPath := args[1]
 	fromSHA := os.Getenv("FROM_SHA")
 	toSHA := os.Getenv("TO_SHA")
 	blobType := *blobTypeFlag
 	skipCommits := *skipCommitsFlag
 
-	repo, err := git.NewGitalyClientFromEnv(projectPath, fromSHA, toSHA)
+	// A full reindex diffs the whole tree at TO_SHA against the empty tree
+	if *fullFlag {
+		fromSHA = ""
+	}
+
+	if *resumeFlag && *checkpointFlag == "" {
+		log.Fatal("--resume requires --checkpoint-dir")
+	}
+
+	repo, fromHash, toHash, err := openRepository(ctx, projectPath, fromSHA, toSHA)
 	if err != nil {
-		log.Fatal(err)
+		fatal(ctx, err)
 	}
 
-	esClient, err := elastic.FromEnv(projectID)
+	logging.SetFields(log.Fields{logging.FromSHA: fromHash, logging.ToSHA: toHash})
+
+	submitter, closeSubmitter, err := openSubmitter(projectID)
 	if err != nil {
 		log.Fatal(err)
 	}
 
+	if *docVersionFlag != "" {
+		version, err := documentVersion(ctx, repo, toHash)
+		if err != nil {
+			fatal(ctx, err)
+		}
+
+		log.Debugf("Document version: %d", version)
+		setDocumentVersion(submitter, version)
+	}
+
 	idx := &indexer.Indexer{
-		Submitter:  esClient,
+		Submitter:  submitter,
 		Repository: repo,
+		Workers:    *workersFlag,
+		Full:       *fullFlag,
+		Resume:     *resumeFlag,
 	}
 
-	log.Debugf("Indexing from %s to %s", repo.FromHash, repo.ToHash)
-	log.Debugf("Index: %s, Project ID: %v, blob_type: %s, skip_commits?: %t", esClient.IndexName, esClient.ParentID(), blobType, skipCommits)
+	if err := skipBlobs(idx, *skipBlobsFlag); err != nil {
+		log.Fatal(err)
+	}
 
-	if err := idx.IndexBlobs(blobType); err != nil {
-		log.Fatalln("Indexing error: ", err)
+	if *checkpointFlag != "" {
+		store, err := checkpoint.NewFileStore(*checkpointFlag)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		idx.Checkpoints = store
+		idx.CheckpointKey = checkpoint.Key{ProjectID: projectID, BlobType: blobType, FromSHA: fromHash, ToSHA: toHash}
+	}
+
+	log.Debugf("Project ID: %v, blob_type: %s, skip_commits?: %t, full?: %t", submitter.ParentID(), blobType, skipCommits, *fullFlag)
+
+	if err := idx.IndexBlobs(ctx, blobType); err != nil {
+		fatal(ctx, "Indexing error: ", err)
 	}
 
 	if !skipCommits && blobType == "blob" {
-		if err := idx.IndexCommits(); err != nil {
-			log.Fatalln("Indexing error: ", err)
+		if err := idx.IndexCommits(ctx); err != nil {
+			fatal(ctx, "Indexing error: ", err)
+		}
+	}
+
+	err = idx.Flush(ctx)
+	logBulkSummary(submitter)
+	if err != nil {
+		fatal(ctx, "Flushing error: ", err)
+	}
+
+	if err := closeSubmitter(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// skipBlobs sets which kinds of blobs idx leaves out from --skip-blobs
+func skipBlobs(idx *indexer.Indexer, kinds string) error {
+	for _, kind := range strings.Split(kinds, ",") {
+		switch strings.TrimSpace(kind) {
+		case "":
+		case "vendored":
+			idx.SkipVendored = true
+		case "generated":
+			idx.SkipGenerated = true
+		case "documentation":
+			idx.SkipDocumentation = true
+		default:
+			return fmt.Errorf("Invalid --skip-blobs kind %q: must be 'vendored', 'generated' or 'documentation'", kind)
+		}
+	}
+
+	return nil
+}
+
+func dryRun() bool {
+	return *dryRunFlag || *outputFlag != ""
+}
+
+// openSubmitter returns the Elasticsearch client, or an NDJSON writer for a
+// dry run, and a function to call once everything is flushed
+func openSubmitter(projectID int64) (indexer.Submitter, func() error, error) {
+	if !dryRun() {
+		esClient, closeDeadLetter, err := openClient(projectID)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		log.Debugf("Index: %s, server: %s", esClient.IndexName, esClient.ServerVersion())
+		return esClient, closeDeadLetter, nil
+	}
+
+	// A dry run doesn't need a cluster, so the configuration is optional
+	indexName := elastic.DefaultIndexName()
+	if _, ok := os.LookupEnv("ELASTIC_CONNECTION_INFO"); ok {
+		config, err := elastic.ConfigFromEnv(projectID)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		indexName = config.IndexName
+		if config.Aliases {
+			indexName = elastic.WriteAlias(config.IndexName)
+		}
+	}
+
+	if *indexNameFlag != "" {
+		indexName = *indexNameFlag
+	}
+
+	log.Debugf("Dry run, index: %s", indexName)
+
+	if *outputFlag == "" {
+		return elastic.NewNDJSONWriter(os.Stdout, indexName, projectID), func() error { return nil }, nil
+	}
+
+	file, err := os.Create(*outputFlag)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return elastic.NewNDJSONWriter(file, indexName, projectID), file.Close, nil
+}
+
+// documentVersion resolves --document-version to a number
+func documentVersion(ctx context.Context, repo git.Repository, toHash string) (int64, error) {
+	if *docVersionFlag != "commit-time" {
+		version, err := strconv.ParseInt(*docVersionFlag, 10, 64)
+		if err != nil || version <= 0 {
+			return 0, fmt.Errorf("Invalid --document-version %q: must be a positive number or 'commit-time'", *docVersionFlag)
+		}
+
+		return version, nil
+	}
+
+	timer, ok := repo.(git.CommitTimer)
+	if !ok {
+		return 0, fmt.Errorf("The repository can't look up commit times")
+	}
+
+	committed, err := timer.CommitTime(ctx, toHash)
+	if err != nil {
+		return 0, err
+	}
+
+	return committed.Unix(), nil
+}
+
+func setDocumentVersion(submitter indexer.Submitter, version int64) {
+	switch s := submitter.(type) {
+	case *elastic.Client:
+		s.SetDocumentVersion(version)
+	case *elastic.NDJSONWriter:
+		s.Version = version
+	}
+}
+
+// openClient returns an Elasticsearch client which writes failed operations
+// to --dead-letter, and a function to close that file
+func openClient(projectID int64) (*elastic.Client, func() error, error) {
+	config, err := elastic.ConfigFromEnv(projectID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// The index is written to directly rather than through the write alias
+	if *indexNameFlag != "" {
+		config.IndexName = *indexNameFlag
+		config.Aliases = false
+	}
+
+	log.Debugf("Elasticsearch connection: %v", config)
+
+	esClient, err := elastic.NewClient(config)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if *deadLetterFlag == "" {
+		return esClient, func() error { return nil }, nil
+	}
+
+	file, err := os.Create(*deadLetterFlag)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	esClient.SetDeadLetter(file)
+
+	return esClient, file.Close, nil
+}
+
+// logBulkSummary reports every operation Elasticsearch rejected, and how many
+// succeeded
+func logBulkSummary(submitter indexer.Submitter) {
+	esClient, ok := submitter.(*elastic.Client)
+	if !ok {
+		return
+	}
+
+	summary := esClient.Summary()
+	for _, failure := range summary.Failures {
+		log.WithFields(log.Fields{
+			logging.DocumentID:      failure.ID,
+			logging.BulkExecutionID: failure.ExecutionID,
+			"op":                    failure.Op,
+			"status":                failure.Status,
+			"error_type":            failure.Type,
+			"reason":                failure.Reason,
+		}).Error("Bulk operation failed")
+	}
+
+	log.WithFields(log.Fields{
+		"succeeded":             summary.Succeeded,
+		"succeeded_after_retry": summary.Retried,
+		"skipped_stale":         summary.Skipped,
+		"failed":                summary.Failed,
+	}).Info("Bulk operations summary")
+}
+
+// replayNDJSON sends the bulk requests written by a dry run to Elasticsearch
+func replayNDJSON(ctx context.Context, path string) {
+	file, err := os.Open(path)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer file.Close()
+
+	esClient, closeDeadLetter, err := openClient(0)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := esClient.Replay(ctx, file); err != nil {
+		fatal(ctx, "Replay error: ", err)
+	}
+
+	err = esClient.Flush(ctx)
+	logBulkSummary(esClient)
+	if err != nil {
+		fatal(ctx, "Flushing error: ", err)
+	}
+
+	if err := closeDeadLetter(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// runContext returns a context that is cancelled on SIGINT or SIGTERM, or
+// when --timeout is reached
+func runContext() (context.Context, context.CancelFunc) {
+	cancelCtx, cancelRun := context.WithCancel(context.Background())
+
+	ctx, cancel := cancelCtx, context.CancelFunc(func() {})
+	if *timeoutFlag > 0 {
+		ctx, cancel = context.WithTimeout(cancelCtx, *timeoutFlag)
+	}
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+
+	go func() {
+		select {
+		case sig := <-signals:
+			log.Warnf("Received %v, cancelling", sig)
+			cancelRun()
+		case <-ctx.Done():
+		}
+	}()
+
+	return ctx, func() {
+		signal.Stop(signals)
+		cancel()
+		cancelRun()
+	}
+}
+
+// fatal exits with a distinct exit code if the run was interrupted, as the
+// error is then only a consequence of the interruption. Operations that were
+// not flushed yet are abandoned. log.Exit writes the metrics, as log.Fatal
+// does.
+func fatal(ctx context.Context, args ...interface{}) {
+	switch ctx.Err() {
+	case context.Canceled:
+		log.Errorln(args...)
+		log.Error("Indexing cancelled")
+		log.Exit(exitCodeCancelled)
+	case context.DeadlineExceeded:
+		log.Errorln(args...)
+		log.Errorf("Indexing timed out after %v", *timeoutFlag)
+		log.Exit(exitCodeTimedOut)
+	}
+
+	log.Fatalln(args...)
+}
+
+// openRepository also returns the commits FROM_SHA and TO_SHA resolved to
+func openRepository(ctx context.Context, projectPath, fromSHA, toSHA string) (git.Repository, string, string, error) {
+	if *gitDirFlag != "" {
+		repo, err := git.NewLocalClient(ctx, *gitDirFlag, fromSHA, toSHA)
+		if err != nil {
+			return nil, "", "", err
 		}
+
+		log.Debugf("Indexing %s from %s to %s", *gitDirFlag, repo.FromHash, repo.ToHash)
+		return repo, repo.FromHash, repo.ToHash, nil
 	}
 
-	if err := idx.Flush(); err != nil {
-		log.Fatalln("Flushing error: ", err)
+	repo, err := git.NewGitalyClientFromEnv(ctx, projectPath, fromSHA, toSHA)
+	if err != nil {
+		return nil, "", "", err
 	}
+
+	log.Debugf("Indexing from %s to %s", repo.FromHash, repo.ToHash)
+	return repo, repo.FromHash, repo.ToHash, nil
 }
 
+// startMetrics serves the metrics on --metrics-addr, and makes log.Fatal and
+// log.Exit write them to --metrics-textfile
+func startMetrics() {
+	if *metricsAddrFlag != "" {
+		mux := http.NewServeMux()
+		mux.Handle("/metrics", metrics.Handler())
+
+		listener, err := net.Listen("tcp", *metricsAddrFlag)
+		if err != nil {
+			log.Fatalf("Couldn't serve metrics: %s", err)
+		}
+
+		log.Debugf("Serving metrics on %s", listener.Addr())
+		go http.Serve(listener, mux)
+	}
+
+	log.RegisterExitHandler(writeMetrics)
+}
+
+// writeMetrics writes the metrics to --metrics-textfile, if set. A failure is
+// only logged, so it doesn't hide the outcome of the run.
+func writeMetrics() {
+	if *metricsFileFlag == "" {
+		return
+	}
+
+	if err := metrics.WriteTextfile(*metricsFileFlag); err != nil {
+		log.Error(err)
+	}
+}
+
+// configureLogger logs to stderr, which keeps the output of dry runs and
+// `mapping print` clean
 func configureLogger() {
-	log.SetOutput(os.Stdout)
-	_, debug := os.LookupEnv("DEBUG")
+	level := *logLevelFlag
+	if level == "" {
+		level = "info"
+		if _, debug := os.LookupEnv("DEBUG"); debug {
+			level = "debug"
+		}
+	}
 
-	if debug {
-		log.SetLevel(log.DebugLevel)
+	if err := logging.Configure(os.Stderr, *logFormatFlag, level); err != nil {
+		log.Fatal(err)
 	}
 }
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+
+	log "github.com/sirupsen/logrus"
+	"gitlab.com/gitlab-org/gitlab-elasticsearch-indexer/elastic"
+)
+
+const mappingUsage = "mapping (print | create | diff)"
+
+// runMappingCommand prints the mapping the indexer expects, creates the
+// configured index with it, or compares it with the index's. It returns the
+// exit code of the command.
+func runMappingCommand(ctx context.Context, args []string) int {
+	if len(args) != 1 {
+		log.Fatalf("Usage: %s %s", os.Args[0], mappingUsage)
+	}
+
+	switch args[0] {
+	case "print":
+		printMapping()
+	case "create":
+		esClient, _, err := openClient(0)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer esClient.Close()
+
+		name, err := esClient.CreateConfiguredIndex(ctx)
+		if err != nil {
+			fatal(ctx, err)
+		}
+
+		log.Infof("Created %s", name)
+	case "diff":
+		return diffMapping(ctx)
+	default:
+		log.Fatalf("Usage: %s %s", os.Args[0], mappingUsage)
+	}
+
+	return 0
+}
+
+// printMapping writes the body of the create index request to stdout. It
+// doesn't need a cluster, so the mapping type of Elasticsearch 6 is kept.
+func printMapping() {
+	var out bytes.Buffer
+	if err := json.Indent(&out, []byte(elastic.RenderedMapping()), "", "  "); err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Println(out.String())
+}
+
+// diffMapping logs every difference between the mapping of the index and the
+// expected one, and returns exitCodeMappingDrift if there are any
+func diffMapping(ctx context.Context) int {
+	esClient, _, err := openClient(0)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer esClient.Close()
+
+	drift, err := esClient.MappingDrift(ctx)
+	if err != nil {
+		fatal(ctx, err)
+	}
+
+	if len(drift) == 0 {
+		log.Infof("The mapping of %s is up to date", esClient.IndexName)
+		return 0
+	}
+
+	var indices []string
+	for index := range drift {
+		indices = append(indices, index)
+	}
+	sort.Strings(indices)
+
+	for _, index := range indices {
+		for _, difference := range drift[index] {
+			log.WithField("index", index).Error(difference)
+		}
+	}
+
+	return exitCodeMappingDrift
+}
@@ -0,0 +1,35 @@
+// Package metrics holds the Prometheus registry of indexing runs, and writes
+// it in the text format, either over HTTP for long runs or to a file for the
+// textfile collector of the node exporter.
+package metrics
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Namespace prefixes the name of every metric of the indexer
+const Namespace = "gitlab_elasticsearch_indexer"
+
+// Registry holds the metrics of the indexer's packages, which register them
+// when they are initialized
+var Registry = prometheus.NewRegistry()
+
+// Handler serves the metrics of Registry, e.g. on /metrics
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{})
+}
+
+// WriteTextfile writes the metrics of Registry to path through a temporary
+// file in the same directory, so the textfile collector never reads a
+// partial file
+func WriteTextfile(path string) error {
+	if err := prometheus.WriteToTextfile(path, Registry); err != nil {
+		return fmt.Errorf("Couldn't write metrics: %s", err)
+	}
+
+	return nil
+}
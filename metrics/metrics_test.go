@@ -0,0 +1,50 @@
+package metrics_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/gitlab-elasticsearch-indexer/metrics"
+)
+
+func TestWriteTextfile(t *testing.T) {
+	counter := prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: metrics.Namespace,
+		Name:      "test_textfile_total",
+		Help:      "Test",
+	})
+	metrics.Registry.MustRegister(counter)
+	defer metrics.Registry.Unregister(counter)
+
+	counter.Add(2)
+
+	dir, err := ioutil.TempDir("", "metrics")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "indexer.prom")
+	require.NoError(t, ioutil.WriteFile(path, []byte("stale"), 0644))
+
+	require.NoError(t, metrics.WriteTextfile(path))
+
+	written, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	require.Contains(t, string(written), "# TYPE gitlab_elasticsearch_indexer_test_textfile_total counter\ngitlab_elasticsearch_indexer_test_textfile_total 2\n")
+
+	// The temporary file was renamed, and the collector can read it
+	entries, err := ioutil.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, os.FileMode(0644), entries[0].Mode().Perm())
+}
+
+func TestWriteTextfileFails(t *testing.T) {
+	err := metrics.WriteTextfile("/nonexistent/indexer.prom")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Couldn't write metrics")
+}